@@ -2,19 +2,28 @@ package main
 
 import (
 	"context"
-	"fmt"
-	"log"
+	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/felipemalacarne/mesa/internal/application"
+	"github.com/felipemalacarne/mesa/internal/application/alerts"
+	"github.com/felipemalacarne/mesa/internal/application/health"
+	"github.com/felipemalacarne/mesa/internal/application/jobs"
+	"github.com/felipemalacarne/mesa/internal/application/metrics"
+	"github.com/felipemalacarne/mesa/internal/application/recording"
 	"github.com/felipemalacarne/mesa/internal/config"
+	"github.com/felipemalacarne/mesa/internal/infrastructure/archive"
 	"github.com/felipemalacarne/mesa/internal/infrastructure/crypto"
 	"github.com/felipemalacarne/mesa/internal/infrastructure/gateway"
+	"github.com/felipemalacarne/mesa/internal/infrastructure/notify"
 	"github.com/felipemalacarne/mesa/internal/infrastructure/persistence"
+	"github.com/felipemalacarne/mesa/internal/infrastructure/sshtunnel"
+	"github.com/felipemalacarne/mesa/internal/infrastructure/telemetry"
 	"github.com/felipemalacarne/mesa/internal/transport/rest"
 )
 
@@ -22,32 +31,102 @@ func main() {
 	cfg := config.Load()
 	ctx := context.Background()
 
+	slog.SetDefault(telemetry.NewLogger(os.Stderr, cfg.LogFormat, cfg.LogLevel))
+
+	shutdownTracing, err := telemetry.SetupTracing(ctx, cfg.OTLPEndpoint)
+	if err != nil {
+		fatal("failed to initialize tracing", err)
+	}
+	if cfg.OTLPEndpoint != "" {
+		slog.Info("exporting traces over OTLP", "endpoint", cfg.OTLPEndpoint)
+	}
+
 	store, err := persistence.New(ctx, cfg)
 	if err != nil {
-		log.Fatalf("Failed to initialize persistence: %v", err)
+		fatal("failed to initialize persistence", err)
 	}
 	defer store.Close()
 
-	repos := application.Repositories{
-		Connection: store.ConnectionRepo,
-		Gateways:   gateway.NewFactory(),
+	backups, err := archive.NewStore(cfg.BackupDir)
+	if err != nil {
+		fatal("failed to initialize backup store", err)
 	}
-	log.Println("Repositories initialized.")
 
 	crypto, err := crypto.NewAESManager(cfg.AppKey)
 	if err != nil {
-		log.Fatal(fmt.Errorf("failed to initialize crypto manager: %w", err))
+		fatal("failed to initialize crypto manager", err)
+	}
+	slog.Info("crypto manager initialized")
+
+	tunnels := sshtunnel.NewManager(crypto)
+	defer func() {
+		if err := tunnels.Close(); err != nil {
+			slog.Warn("closing ssh tunnels", "error", err)
+		}
+	}()
+
+	telemetryMetrics := telemetry.NewMetrics()
+
+	repos := application.Repositories{
+		Connection: store.ConnectionRepo,
+		Gateways:   telemetry.InstrumentFactory(gateway.NewFactory(tunnels), telemetryMetrics),
+		Metrics:    store.MetricRepo,
+		Alerts:     store.AlertRepo,
+		Notifier:   notify.NewDispatcher(),
+		Health:     store.HealthRepo,
+		Backups:    backups,
+		Library:    store.SavedQueryRepo,
+		History:    store.HistoryRepo,
+	}
+	slog.Info("repositories initialized")
+
+	pool := jobs.NewPool(store.JobRepo, cfg.JobWorkers, cfg.JobRetention)
+
+	recorder := recording.NewRecorder(store.HistoryRepo, cfg.HistoryRetention, cfg.HistoryMaxEntries)
+
+	app := application.NewApp(repos, crypto, pool, recorder)
+	slog.Info("application initialized")
+
+	workersCtx, stopWorkers := context.WithCancel(ctx)
+	defer stopWorkers()
+
+	var workers sync.WaitGroup
+	startWorker := func(run func(context.Context)) {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			run(workersCtx)
+		}()
 	}
-	log.Println("Crypto manager initialized.")
 
-	app := application.NewApp(repos, crypto)
-	log.Println("Application initialized.")
+	startWorker(pool.Run)
+	slog.Info("job pool started", "workers", cfg.JobWorkers, "retention", cfg.JobRetention)
+
+	startWorker(recorder.Run)
+	slog.Info("query history recorder started", "retention", cfg.HistoryRetention, "max_entries", cfg.HistoryMaxEntries)
+
+	sampler := metrics.NewSampler(repos.Connection, crypto, repos.Gateways, repos.Metrics, cfg.MetricsInterval)
+	startWorker(sampler.Run)
+	slog.Info("metrics sampler started", "interval", cfg.MetricsInterval)
 
-	srv := rest.NewServer(*app)
+	checker := health.NewChecker(repos.Connection, crypto, repos.Gateways, repos.Health, cfg.HealthInterval)
+	startWorker(checker.Run)
+	slog.Info("health checker started", "interval", cfg.HealthInterval)
+
+	evaluator := alerts.NewEvaluator(repos.Connection, crypto, repos.Gateways, repos.Alerts, repos.Notifier, cfg.AlertsInterval)
+	startWorker(evaluator.Run)
+	slog.Info("alert evaluator started", "interval", cfg.AlertsInterval)
+
+	if cfg.MetricsConnectionGauges {
+		telemetryMetrics.MustRegister(telemetry.NewConnectionCollector(repos.Connection, crypto, repos.Gateways))
+		slog.Info("per-connection gauges enabled on /metrics")
+	}
+
+	srv := rest.NewServer(*app, telemetryMetrics)
 
 	go func() {
 		if err := srv.Start(cfg.Port); err != nil && err != http.ErrServerClosed {
-			log.Fatalf("listen: %s\n", err)
+			fatal("listen", err)
 		}
 	}()
 
@@ -56,12 +135,41 @@ func main() {
 
 	<-stop
 
+	// The HTTP server stops first: the requests it drains still submit jobs and record history.
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
 	if err := srv.Stop(ctx); err != nil {
-		log.Fatalf("Server forced to shutdown: %v", err)
+		slog.Error("server forced to shutdown", "error", err)
 	}
 
-	log.Println("Server exiting")
+	// Stopping the workers cancels the running jobs; the pool then saves their final state and
+	// the recorder the entries still queued, before the store is closed.
+	stopWorkers()
+
+	workersDone := make(chan struct{})
+	go func() {
+		workers.Wait()
+		close(workersDone)
+	}()
+	select {
+	case <-workersDone:
+	case <-time.After(10 * time.Second):
+		slog.Warn("background workers did not stop in time")
+	}
+
+	// The traces of the workers are flushed too, with a budget of their own.
+	flushCtx, cancelFlush := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelFlush()
+
+	if err := shutdownTracing(flushCtx); err != nil {
+		slog.Warn("flushing traces", "error", err)
+	}
+
+	slog.Info("server exiting")
+}
+
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
 }
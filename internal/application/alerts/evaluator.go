@@ -0,0 +1,280 @@
+// Package alerts runs the background loop that evaluates alert rules and sends notifications.
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/alert"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/google/uuid"
+)
+
+const (
+	// maxConcurrentChecks limita quantas conexões são avaliadas ao mesmo tempo.
+	maxConcurrentChecks = 4
+	checkTimeout        = 10 * time.Second
+	notifyTimeout       = 15 * time.Second
+	// longRunningSampleSize limita quantas sessões lentas são lidas para achar a mais longa.
+	longRunningSampleSize = 100
+)
+
+type stateKey struct {
+	ruleID       uuid.UUID
+	connectionID uuid.UUID
+}
+
+// Evaluator avalia periodicamente as regras habilitadas contra cada conexão.
+type Evaluator struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+	alerts   alert.Repository
+	notifier alert.Notifier
+	interval time.Duration
+}
+
+func NewEvaluator(
+	repo connection.Repository,
+	crypto domain.Cryptographer,
+	gateways connection.GatewayFactory,
+	alerts alert.Repository,
+	notifier alert.Notifier,
+	interval time.Duration,
+) *Evaluator {
+	return &Evaluator{
+		repo:     repo,
+		crypto:   crypto,
+		gateways: gateways,
+		alerts:   alerts,
+		notifier: notifier,
+		interval: interval,
+	}
+}
+
+// Run avalia as regras a cada intervalo até o contexto ser cancelado.
+func (e *Evaluator) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		e.tick(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *Evaluator) tick(ctx context.Context) {
+	ctx = tracing.WithAttrs(ctx, slog.String(tracing.OperationKey, "EvaluateAlerts"))
+
+	rules, err := e.alerts.ListRules(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "alert evaluator: listing rules", "error", err)
+		return
+	}
+
+	enabled := make([]alert.Rule, 0, len(rules))
+	for _, rule := range rules {
+		if rule.Enabled {
+			enabled = append(enabled, *rule)
+		}
+	}
+	if len(enabled) == 0 {
+		return
+	}
+
+	conns, err := e.repo.ListAll(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "alert evaluator: listing connections", "error", err)
+		return
+	}
+
+	saved, err := e.alerts.ListStates(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "alert evaluator: listing states", "error", err)
+		return
+	}
+	states := make(map[stateKey]alert.State, len(saved))
+	for _, state := range saved {
+		states[stateKey{state.RuleID, state.ConnectionID}] = state
+	}
+
+	sem := make(chan struct{}, maxConcurrentChecks)
+	var wg sync.WaitGroup
+	for _, conn := range conns {
+		var applicable []alert.Rule
+		for _, rule := range enabled {
+			if rule.AppliesTo(conn.ID) {
+				applicable = append(applicable, rule)
+			}
+		}
+		if len(applicable) == 0 {
+			continue
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(conn connection.Connection, rules []alert.Rule) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			e.evaluate(tracing.WithConnectionID(ctx, conn.ID), conn, rules, states)
+		}(*conn, applicable)
+	}
+	wg.Wait()
+}
+
+// evaluate checks every rule of a single connection. The states map is only read here.
+func (e *Evaluator) evaluate(ctx context.Context, conn connection.Connection, rules []alert.Rule, states map[stateKey]alert.State) {
+	probe, err := e.newProbe(conn)
+	if err != nil {
+		slog.WarnContext(ctx, "alert evaluator: preparing connection", "error", err)
+		return
+	}
+
+	for _, rule := range rules {
+		value, message, err := probe.observe(ctx, rule)
+		if err != nil {
+			// The connection being down is the job of unreachable rules; other conditions
+			// keep their previous state instead of resolving on missing data.
+			slog.WarnContext(ctx, "alert evaluator: observing rule", "rule_id", rule.ID, "error", err)
+			continue
+		}
+
+		key := stateKey{rule.ID, conn.ID}
+		state, ok := states[key]
+		if !ok {
+			state = alert.NewState(rule.ID, conn.ID)
+		}
+
+		now := time.Now().UTC()
+		if kind, notify := state.Observe(rule, value, message, now); notify {
+			event := alert.Event{
+				Kind:           kind,
+				Rule:           rule,
+				ConnectionID:   conn.ID,
+				ConnectionName: conn.Name,
+				Value:          value,
+				Message:        message,
+				At:             now,
+			}
+			if state.FiredAt != nil {
+				event.FiredAt = *state.FiredAt
+			}
+
+			if err := e.send(ctx, event); err != nil {
+				// Keep the previous state so the transition, and its notification, is retried.
+				slog.WarnContext(ctx, "alert evaluator: notifying", "event", kind, "rule_id", rule.ID, "error", err)
+				continue
+			}
+		}
+
+		if err := e.alerts.SaveState(ctx, state); err != nil {
+			slog.WarnContext(ctx, "alert evaluator: saving state", "rule_id", rule.ID, "error", err)
+		}
+	}
+}
+
+func (e *Evaluator) send(ctx context.Context, event alert.Event) error {
+	timedCtx, cancel := context.WithTimeout(ctx, notifyTimeout)
+	defer cancel()
+
+	return e.notifier.Notify(timedCtx, event)
+}
+
+// probe lê as métricas de uma conexão sob demanda, reaproveitando-as entre regras.
+type probe struct {
+	conn     connection.Connection
+	password string
+	gateway  connection.Gateway
+
+	stats   *connection.ServerStats
+	pingErr *error
+}
+
+func (e *Evaluator) newProbe(conn connection.Connection) (*probe, error) {
+	gateway, err := e.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := e.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	return &probe{conn: conn, password: password, gateway: gateway}, nil
+}
+
+func (p *probe) observe(ctx context.Context, rule alert.Rule) (float64, string, error) {
+	timedCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	switch rule.Condition {
+	case alert.ConditionUnreachable:
+		if p.pingErr == nil {
+			err := p.gateway.Ping(timedCtx, p.conn, p.password)
+			p.pingErr = &err
+		}
+		if *p.pingErr != nil {
+			return 1, fmt.Sprintf("connection unreachable: %v", *p.pingErr), nil
+		}
+		return 0, "connection reachable", nil
+
+	case alert.ConditionConnectionUsage:
+		if p.stats == nil {
+			stats, err := p.gateway.GetServerStats(timedCtx, p.conn, p.password)
+			if err != nil {
+				return 0, "", err
+			}
+			p.stats = stats
+		}
+		if p.stats.MaxConnections <= 0 {
+			return 0, "max_connections is not reported", nil
+		}
+		usage := float64(p.stats.TotalSessions) / float64(p.stats.MaxConnections) * 100
+		return usage, fmt.Sprintf("%d of %d connections in use (%.1f%%, threshold %.1f%%)",
+			p.stats.TotalSessions, p.stats.MaxConnections, usage, rule.Threshold), nil
+
+	case alert.ConditionLongRunningQuery:
+		minDuration := time.Duration(rule.Threshold * float64(time.Second))
+		page, err := p.gateway.ListSessions(timedCtx, p.conn, p.password, connection.SessionFilter{
+			States:      []connection.SessionState{connection.SessionActive},
+			MinDuration: minDuration,
+		}, longRunningSampleSize, 0)
+		if err != nil {
+			return 0, "", err
+		}
+
+		var longest *connection.Session
+		for i := range page.Sessions {
+			if longest == nil || page.Sessions[i].Duration > longest.Duration {
+				longest = &page.Sessions[i]
+			}
+		}
+		if longest == nil {
+			return 0, fmt.Sprintf("no query running longer than %s", minDuration), nil
+		}
+		return longest.Duration.Seconds(), fmt.Sprintf("%d queries running longer than %s; longest is pid %d (%s, %s@%s): %s",
+			page.Total, minDuration, longest.PID, longest.Duration.Round(time.Second), longest.User, longest.Database, truncate(longest.Query, 200)), nil
+	}
+
+	return 0, "", alert.ErrInvalidCondition
+}
+
+func truncate(s string, limit int) string {
+	runes := []rune(s)
+	if len(runes) <= limit {
+		return s
+	}
+	return string(runes[:limit]) + "…"
+}
@@ -6,37 +6,103 @@ package application
 
 import (
 	"github.com/felipemalacarne/mesa/internal/application/commands"
+	"github.com/felipemalacarne/mesa/internal/application/jobs"
 	"github.com/felipemalacarne/mesa/internal/application/queries"
+	"github.com/felipemalacarne/mesa/internal/application/recording"
 	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/alert"
+	"github.com/felipemalacarne/mesa/internal/domain/backup"
 	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/history"
+	"github.com/felipemalacarne/mesa/internal/domain/metric"
+	"github.com/felipemalacarne/mesa/internal/domain/savedquery"
 )
 
 type Repositories struct {
 	Connection connection.Repository
 	Gateways   connection.GatewayFactory
+	Metrics    metric.Repository
+	Alerts     alert.Repository
+	Notifier   alert.Notifier
+	Health     connection.HealthRepository
+	Backups    backup.Store
+	Library    savedquery.Repository
+	History    history.Repository
 }
 
 type Queries struct {
-	FindConnection  *queries.FindConnectionHandler
-	ListConnections *queries.ListConnectionsHandler
-	ListDatabases   *queries.ListDatabasesHandler
-	ListTables      *queries.ListTablesHandler
-	GetOverview     *queries.GetOverviewHandler
-	ListSessions    *queries.ListSessionsHandler
-	ListUsers       *queries.ListUsersHandler
-	PingConnection  *queries.PingConnectionHandler
-	ListColumns     *queries.ListColumnsHandler
-	ListIndexes     *queries.ListIndexesHandler
-	QueryTableRows  *queries.QueryTableRowsHandler
+	FindConnection         *queries.FindConnectionHandler
+	ListConnections        *queries.ListConnectionsHandler
+	ListDatabases          *queries.ListDatabasesHandler
+	ListTables             *queries.ListTablesHandler
+	GetOverview            *queries.GetOverviewHandler
+	ListSessions           *queries.ListSessionsHandler
+	ListUsers              *queries.ListUsersHandler
+	ListPrivileges         *queries.ListPrivilegesHandler
+	GetEffectivePrivileges *queries.GetEffectivePrivilegesHandler
+	PingConnection         *queries.PingConnectionHandler
+	ListColumns            *queries.ListColumnsHandler
+	ListIndexes            *queries.ListIndexesHandler
+	QueryTableRows         *queries.QueryTableRowsHandler
+	GetObjectDDL           *queries.GetObjectDDLHandler
+	GetSchemaDDL           *queries.GetSchemaDDLHandler
+	ListViews              *queries.ListViewsHandler
+	ListMaterializedViews  *queries.ListMaterializedViewsHandler
+	ListFunctions          *queries.ListFunctionsHandler
+	ListTriggers           *queries.ListTriggersHandler
+	ListSequences          *queries.ListSequencesHandler
+	ListTypes              *queries.ListTypesHandler
+	GetTableHealth         *queries.GetTableHealthHandler
+	ExplainQuery           *queries.ExplainQueryHandler
+	ListTopStatements      *queries.ListTopStatementsHandler
+	GetBlockingTree        *queries.GetBlockingTreeHandler
+	GetReplication         *queries.GetReplicationHandler
+	ListSettings           *queries.ListSettingsHandler
+	ListBackups            *queries.ListBackupsHandler
+	GetJob                 *queries.GetJobHandler
+	ListJobs               *queries.ListJobsHandler
+	WatchJob               *queries.WatchJobHandler
+	FindSavedQuery         *queries.FindSavedQueryHandler
+	ListSavedQueries       *queries.ListSavedQueriesHandler
+	SearchHistory          *queries.SearchHistoryHandler
+	QueryMetrics           *queries.QueryMetricsHandler
+	ListAlertRules         *queries.ListAlertRulesHandler
+	ListAlerts             *queries.ListAlertsHandler
 }
 
 type Commands struct {
-	CreateConnection *commands.CreateConnectionHandler
-	KillSession      *commands.KillSessionHandler
-	CreateUser       *commands.CreateUserHandler
-	CreateDatabase   *commands.CreateDatabaseHandler
-	CreateTable      *commands.CreateTableHandler
-	UpdateTableRow   *commands.UpdateTableRowHandler
+	CreateConnection    *commands.CreateConnectionHandler
+	UpdateConnection    *commands.UpdateConnectionHandler
+	SetSSHTunnel        *commands.SetSSHTunnelHandler
+	KillSession         *commands.KillSessionHandler
+	CancelSession       *commands.CancelSessionHandler
+	SignalSessions      *commands.SignalSessionsHandler
+	CreateUser          *commands.CreateUserHandler
+	AlterUser           *commands.AlterUserHandler
+	DropUser            *commands.DropUserHandler
+	GrantRole           *commands.GrantRoleHandler
+	RevokeRole          *commands.RevokeRoleHandler
+	GrantPrivileges     *commands.GrantPrivilegesHandler
+	RevokePrivileges    *commands.RevokePrivilegesHandler
+	CreateDatabase      *commands.CreateDatabaseHandler
+	DropDatabase        *commands.DropDatabaseHandler
+	RenameDatabase      *commands.RenameDatabaseHandler
+	CreateTable         *commands.CreateTableHandler
+	UpdateTableRow      *commands.UpdateTableRowHandler
+	RunMaintenance      *commands.RunMaintenanceHandler
+	CreateBackup        *commands.CreateBackupHandler
+	RestoreBackup       *commands.RestoreBackupHandler
+	DeleteBackup        *commands.DeleteBackupHandler
+	SubmitJob           *commands.SubmitJobHandler
+	CancelJob           *commands.CancelJobHandler
+	SaveSavedQuery      *commands.SaveSavedQueryHandler
+	DeleteSavedQuery    *commands.DeleteSavedQueryHandler
+	ExecuteSavedQuery   *commands.ExecuteSavedQueryHandler
+	ResetStatementStats *commands.ResetStatementStatsHandler
+	AlterSystem         *commands.AlterSystemHandler
+	SaveAlertRule       *commands.SaveAlertRuleHandler
+	DeleteAlertRule     *commands.DeleteAlertRuleHandler
+	TestAlertRule       *commands.TestAlertRuleHandler
 }
 
 type App struct {
@@ -44,30 +110,89 @@ type App struct {
 	Commands Commands
 }
 
-func NewApp(repos Repositories, crypto domain.Cryptographer) *App {
+// NewApp wires the handlers; commands that run in the background are submitted to pool and
+// the statements run on behalf of the user are kept in the history by recorder.
+func NewApp(repos Repositories, crypto domain.Cryptographer, pool *jobs.Pool, recorder *recording.Recorder) *App {
 	app := &App{
 		Queries: Queries{
-			FindConnection:  queries.NewFindConnectionHandler(repos.Connection),
-			ListConnections: queries.NewListConnectionsHandler(repos.Connection),
-			ListDatabases:   queries.NewListDatabasesHandler(repos.Connection, crypto, repos.Gateways),
-			ListTables:      queries.NewListTablesHandler(repos.Connection, crypto, repos.Gateways),
-			GetOverview:     queries.NewGetOverviewHandler(repos.Connection, crypto, repos.Gateways),
-			ListSessions:    queries.NewListSessionsHandler(repos.Connection, crypto, repos.Gateways),
-			ListUsers:       queries.NewListUsersHandler(repos.Connection, crypto, repos.Gateways),
-			PingConnection:  queries.NewPingConnectionHandler(repos.Connection, crypto, repos.Gateways),
-			ListColumns:    queries.NewListColumnsHandler(repos.Connection, crypto, repos.Gateways),
-			ListIndexes:    queries.NewListIndexesHandler(repos.Connection, crypto, repos.Gateways),
-			QueryTableRows: queries.NewQueryTableRowsHandler(repos.Connection, crypto, repos.Gateways),
+			FindConnection:         queries.NewFindConnectionHandler(repos.Connection, repos.Health),
+			ListConnections:        queries.NewListConnectionsHandler(repos.Connection, repos.Health),
+			ListDatabases:          queries.NewListDatabasesHandler(repos.Connection, crypto, repos.Gateways),
+			ListTables:             queries.NewListTablesHandler(repos.Connection, crypto, repos.Gateways),
+			GetOverview:            queries.NewGetOverviewHandler(repos.Connection, crypto, repos.Gateways),
+			ListSessions:           queries.NewListSessionsHandler(repos.Connection, crypto, repos.Gateways),
+			ListUsers:              queries.NewListUsersHandler(repos.Connection, crypto, repos.Gateways),
+			ListPrivileges:         queries.NewListPrivilegesHandler(repos.Connection, crypto, repos.Gateways),
+			GetEffectivePrivileges: queries.NewGetEffectivePrivilegesHandler(repos.Connection, crypto, repos.Gateways),
+			PingConnection:         queries.NewPingConnectionHandler(repos.Connection, crypto, repos.Gateways),
+			ListColumns:            queries.NewListColumnsHandler(repos.Connection, crypto, repos.Gateways),
+			ListIndexes:            queries.NewListIndexesHandler(repos.Connection, crypto, repos.Gateways),
+			QueryTableRows:         queries.NewQueryTableRowsHandler(repos.Connection, crypto, repos.Gateways, recorder),
+			GetObjectDDL:           queries.NewGetObjectDDLHandler(repos.Connection, crypto, repos.Gateways),
+			GetSchemaDDL:           queries.NewGetSchemaDDLHandler(repos.Connection, crypto, repos.Gateways),
+			ListViews:              queries.NewListViewsHandler(repos.Connection, crypto, repos.Gateways),
+			ListMaterializedViews:  queries.NewListMaterializedViewsHandler(repos.Connection, crypto, repos.Gateways),
+			ListFunctions:          queries.NewListFunctionsHandler(repos.Connection, crypto, repos.Gateways),
+			ListTriggers:           queries.NewListTriggersHandler(repos.Connection, crypto, repos.Gateways),
+			ListSequences:          queries.NewListSequencesHandler(repos.Connection, crypto, repos.Gateways),
+			ListTypes:              queries.NewListTypesHandler(repos.Connection, crypto, repos.Gateways),
+			GetTableHealth:         queries.NewGetTableHealthHandler(repos.Connection, crypto, repos.Gateways),
+			ExplainQuery:           queries.NewExplainQueryHandler(repos.Connection, crypto, repos.Gateways, recorder),
+			ListTopStatements:      queries.NewListTopStatementsHandler(repos.Connection, crypto, repos.Gateways),
+			GetBlockingTree:        queries.NewGetBlockingTreeHandler(repos.Connection, crypto, repos.Gateways),
+			GetReplication:         queries.NewGetReplicationHandler(repos.Connection, crypto, repos.Gateways),
+			ListSettings:           queries.NewListSettingsHandler(repos.Connection, crypto, repos.Gateways),
+			ListBackups:            queries.NewListBackupsHandler(repos.Connection, repos.Backups),
+			GetJob:                 queries.NewGetJobHandler(pool),
+			ListJobs:               queries.NewListJobsHandler(pool),
+			WatchJob:               queries.NewWatchJobHandler(pool),
+			FindSavedQuery:         queries.NewFindSavedQueryHandler(repos.Library),
+			ListSavedQueries:       queries.NewListSavedQueriesHandler(repos.Connection, repos.Library),
+			SearchHistory:          queries.NewSearchHistoryHandler(repos.History),
+			QueryMetrics:           queries.NewQueryMetricsHandler(repos.Connection, repos.Metrics),
+			ListAlertRules:         queries.NewListAlertRulesHandler(repos.Alerts),
+			ListAlerts:             queries.NewListAlertsHandler(repos.Alerts),
 		},
 		Commands: Commands{
-			CreateConnection: commands.NewCreateConnectionHandler(repos.Connection, crypto),
-			KillSession:      commands.NewKillSessionHandler(repos.Connection, crypto, repos.Gateways),
-			CreateUser:       commands.NewCreateUserHandler(repos.Connection, crypto, repos.Gateways),
-			CreateDatabase:   commands.NewCreateDatabaseHandler(repos.Connection, crypto, repos.Gateways),
-			CreateTable:      commands.NewCreateTableHandler(repos.Connection, crypto, repos.Gateways),
-			UpdateTableRow:   commands.NewUpdateTableRowHandler(repos.Connection, crypto, repos.Gateways),
+			CreateConnection:    commands.NewCreateConnectionHandler(repos.Connection, crypto),
+			UpdateConnection:    commands.NewUpdateConnectionHandler(repos.Connection),
+			SetSSHTunnel:        commands.NewSetSSHTunnelHandler(repos.Connection, crypto),
+			KillSession:         commands.NewKillSessionHandler(repos.Connection, crypto, repos.Gateways, recorder),
+			CancelSession:       commands.NewCancelSessionHandler(repos.Connection, crypto, repos.Gateways, recorder),
+			SignalSessions:      commands.NewSignalSessionsHandler(repos.Connection, crypto, repos.Gateways, recorder),
+			CreateUser:          commands.NewCreateUserHandler(repos.Connection, crypto, repos.Gateways, recorder),
+			AlterUser:           commands.NewAlterUserHandler(repos.Connection, crypto, repos.Gateways, recorder),
+			DropUser:            commands.NewDropUserHandler(repos.Connection, crypto, repos.Gateways, recorder),
+			GrantRole:           commands.NewGrantRoleHandler(repos.Connection, crypto, repos.Gateways, recorder),
+			RevokeRole:          commands.NewRevokeRoleHandler(repos.Connection, crypto, repos.Gateways, recorder),
+			GrantPrivileges:     commands.NewGrantPrivilegesHandler(repos.Connection, crypto, repos.Gateways, recorder),
+			RevokePrivileges:    commands.NewRevokePrivilegesHandler(repos.Connection, crypto, repos.Gateways, recorder),
+			CreateDatabase:      commands.NewCreateDatabaseHandler(repos.Connection, crypto, repos.Gateways, recorder),
+			DropDatabase:        commands.NewDropDatabaseHandler(repos.Connection, crypto, repos.Gateways, recorder),
+			RenameDatabase:      commands.NewRenameDatabaseHandler(repos.Connection, crypto, repos.Gateways, recorder),
+			CreateTable:         commands.NewCreateTableHandler(repos.Connection, crypto, repos.Gateways, recorder),
+			UpdateTableRow:      commands.NewUpdateTableRowHandler(repos.Connection, crypto, repos.Gateways, recorder),
+			RunMaintenance:      commands.NewRunMaintenanceHandler(repos.Connection, crypto, repos.Gateways, pool, recorder),
+			CreateBackup:        commands.NewCreateBackupHandler(repos.Connection, crypto, repos.Gateways, repos.Backups, pool),
+			RestoreBackup:       commands.NewRestoreBackupHandler(repos.Connection, crypto, repos.Gateways, repos.Backups, pool, recorder),
+			DeleteBackup:        commands.NewDeleteBackupHandler(repos.Backups),
+			CancelJob:           commands.NewCancelJobHandler(pool),
+			SaveSavedQuery:      commands.NewSaveSavedQueryHandler(repos.Connection, repos.Library),
+			DeleteSavedQuery:    commands.NewDeleteSavedQueryHandler(repos.Library),
+			ExecuteSavedQuery:   commands.NewExecuteSavedQueryHandler(repos.Connection, crypto, repos.Gateways, repos.Library, recorder),
+			ResetStatementStats: commands.NewResetStatementStatsHandler(repos.Connection, crypto, repos.Gateways, recorder),
+			AlterSystem:         commands.NewAlterSystemHandler(repos.Connection, crypto, repos.Gateways, recorder),
+			SaveAlertRule:       commands.NewSaveAlertRuleHandler(repos.Connection, repos.Alerts),
+			DeleteAlertRule:     commands.NewDeleteAlertRuleHandler(repos.Alerts),
+			TestAlertRule:       commands.NewTestAlertRuleHandler(repos.Alerts, repos.Notifier),
 		},
 	}
 
+	app.Commands.SubmitJob = commands.NewSubmitJobHandler(map[string]commands.JobSubmitter{
+		"run_maintenance": commands.Submitter(app.Commands.RunMaintenance.Handle),
+		"create_backup":   commands.Submitter(app.Commands.CreateBackup.Handle),
+		"restore_backup":  commands.Submitter(app.Commands.RestoreBackup.Handle),
+	})
+
 	return app
 }
@@ -0,0 +1,107 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/protection"
+	"github.com/felipemalacarne/mesa/internal/application/recording"
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/history"
+	"github.com/google/uuid"
+)
+
+// SettingChangeInput altera um parâmetro; Value nil executa ALTER SYSTEM RESET.
+type SettingChangeInput struct {
+	Name  string  `json:"name"`
+	Value *string `json:"value"`
+}
+
+// AlterSystemCmd grava alterações no postgresql.auto.conf e recarrega a configuração.
+type AlterSystemCmd struct {
+	ConnectionID uuid.UUID            `json:"connection_id"`
+	Changes      []SettingChangeInput `json:"changes"`
+}
+
+// AppliedSetting é o valor de um parâmetro alterado após o reload.
+type AppliedSetting struct {
+	connection.Setting
+	// RestartRequired indica que o novo valor só vale após reiniciar o servidor.
+	RestartRequired bool
+}
+
+type AlterSystemHandler struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+	history  *recording.Recorder
+}
+
+func NewAlterSystemHandler(
+	repo connection.Repository,
+	crypto domain.Cryptographer,
+	gateways connection.GatewayFactory,
+	history *recording.Recorder,
+) *AlterSystemHandler {
+	return &AlterSystemHandler{repo: repo, crypto: crypto, gateways: gateways, history: history}
+}
+
+func (h *AlterSystemHandler) Handle(ctx context.Context, cmd AlterSystemCmd) (_ []AppliedSetting, err error) {
+	ctx, span := tracing.Start(ctx, "AlterSystem", &cmd.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	if len(cmd.Changes) == 0 {
+		return nil, connection.ErrNoSettingChanges
+	}
+
+	changes := make([]connection.SettingChange, 0, len(cmd.Changes))
+	for _, input := range cmd.Changes {
+		change, err := connection.NewSettingChange(input.Name, input.Value)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+
+	conn, err := h.repo.FindByID(ctx, cmd.ConnectionID)
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		return nil, ErrConnectionNotFound
+	}
+	if err := protection.GuardMutation(ctx, conn); err != nil {
+		return nil, err
+	}
+
+	gateway, err := h.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := h.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	timedCtx = h.history.Track(timedCtx, conn.ID, "", history.SourceAdmin)
+
+	settings, err := gateway.AlterSystem(timedCtx, *conn, password, changes)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := make([]AppliedSetting, len(settings))
+	for i, s := range settings {
+		applied[i] = AppliedSetting{
+			Setting:         s,
+			RestartRequired: s.PendingRestart || s.RequiresRestart(),
+		}
+	}
+
+	return applied, nil
+}
@@ -0,0 +1,97 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/protection"
+	"github.com/felipemalacarne/mesa/internal/application/recording"
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/history"
+	"github.com/google/uuid"
+)
+
+// AlterUserCmd altera atributos de um role; campos nil permanecem como estão.
+type AlterUserCmd struct {
+	ConnectionID    uuid.UUID  `json:"connection_id"`
+	Username        string     `json:"username"`
+	Password        *string    `json:"password"`
+	CanLogin        *bool      `json:"can_login"`
+	IsSuperUser     *bool      `json:"is_superuser"`
+	CanCreateDB     *bool      `json:"can_create_db"`
+	CanCreateRole   *bool      `json:"can_create_role"`
+	Replication     *bool      `json:"replication"`
+	ConnLimit       *int       `json:"conn_limit"`
+	ValidUntil      *time.Time `json:"valid_until"`
+	ClearValidUntil bool       `json:"clear_valid_until"`
+}
+
+type AlterUserHandler struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+	history  *recording.Recorder
+}
+
+func NewAlterUserHandler(
+	repo connection.Repository,
+	crypto domain.Cryptographer,
+	gateways connection.GatewayFactory,
+	history *recording.Recorder,
+) *AlterUserHandler {
+	return &AlterUserHandler{repo: repo, crypto: crypto, gateways: gateways, history: history}
+}
+
+func (h *AlterUserHandler) Handle(ctx context.Context, cmd AlterUserCmd) (err error) {
+	ctx, span := tracing.Start(ctx, "AlterUser", &cmd.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	username, err := connection.NewIdentifier(cmd.Username)
+	if err != nil {
+		return err
+	}
+
+	changes := connection.RoleChanges{
+		Password:        cmd.Password,
+		CanLogin:        cmd.CanLogin,
+		IsSuperUser:     cmd.IsSuperUser,
+		CanCreateDB:     cmd.CanCreateDB,
+		CanCreateRole:   cmd.CanCreateRole,
+		Replication:     cmd.Replication,
+		ConnLimit:       cmd.ConnLimit,
+		ValidUntil:      cmd.ValidUntil,
+		ClearValidUntil: cmd.ClearValidUntil,
+	}
+	if err := changes.Validate(); err != nil {
+		return err
+	}
+
+	conn, err := h.repo.FindByID(ctx, cmd.ConnectionID)
+	if err != nil {
+		return err
+	}
+	if conn == nil {
+		return ErrConnectionNotFound
+	}
+	if err := protection.GuardMutation(ctx, conn); err != nil {
+		return err
+	}
+
+	gateway, err := h.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return err
+	}
+
+	password, err := h.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return err
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	timedCtx = h.history.Track(timedCtx, conn.ID, "", history.SourceAdmin)
+
+	return gateway.AlterUser(timedCtx, *conn, password, username, changes)
+}
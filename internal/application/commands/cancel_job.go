@@ -0,0 +1,26 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/felipemalacarne/mesa/internal/application/jobs"
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain/job"
+	"github.com/google/uuid"
+)
+
+type CancelJobHandler struct {
+	jobs *jobs.Pool
+}
+
+func NewCancelJobHandler(pool *jobs.Pool) *CancelJobHandler {
+	return &CancelJobHandler{jobs: pool}
+}
+
+// Handle cancela o job. Um job em execução continua com status running até a tarefa retornar.
+func (h *CancelJobHandler) Handle(ctx context.Context, jobID uuid.UUID) (_ *job.Job, err error) {
+	ctx, span := tracing.Start(ctx, "CancelJob", nil)
+	defer func() { tracing.End(span, err) }()
+
+	return h.jobs.Cancel(ctx, jobID)
+}
@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/protection"
+	"github.com/felipemalacarne/mesa/internal/application/recording"
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/history"
+	"github.com/google/uuid"
+)
+
+// CancelSessionCmd interrompe a consulta em execução de um backend sem encerrar a conexão.
+type CancelSessionCmd struct {
+	ConnectionID uuid.UUID `json:"connection_id"`
+	PID          int       `json:"pid"`
+}
+
+type CancelSessionHandler struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+	history  *recording.Recorder
+}
+
+func NewCancelSessionHandler(
+	repo connection.Repository,
+	crypto domain.Cryptographer,
+	gateways connection.GatewayFactory,
+	history *recording.Recorder,
+) *CancelSessionHandler {
+	return &CancelSessionHandler{repo: repo, crypto: crypto, gateways: gateways, history: history}
+}
+
+func (h *CancelSessionHandler) Handle(ctx context.Context, cmd CancelSessionCmd) (err error) {
+	ctx, span := tracing.Start(ctx, "CancelSession", &cmd.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	conn, err := h.repo.FindByID(ctx, cmd.ConnectionID)
+	if err != nil {
+		return err
+	}
+	if conn == nil {
+		return ErrConnectionNotFound
+	}
+	if err := protection.GuardMutation(ctx, conn); err != nil {
+		return err
+	}
+
+	gateway, err := h.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return err
+	}
+
+	password, err := h.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return err
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	timedCtx = h.history.Track(timedCtx, conn.ID, "", history.SourceAdmin)
+
+	return gateway.CancelSession(timedCtx, *conn, password, cmd.PID)
+}
@@ -0,0 +1,142 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/jobs"
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/backup"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/job"
+	"github.com/google/uuid"
+)
+
+// backupTimeout limita a duração de um backup ou restore em background.
+const backupTimeout = 6 * time.Hour
+
+// CreateBackupCmd gera o dump lógico de um banco ou, se Tables for informado, só dessas tabelas
+// ("schema.tabela" ou "tabela" no schema public).
+type CreateBackupCmd struct {
+	ConnectionID uuid.UUID `json:"connection_id"`
+	Database     string    `json:"database"`
+	Tables       []string  `json:"tables"`
+}
+
+type CreateBackupHandler struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+	backups  backup.Store
+	jobs     *jobs.Pool
+}
+
+func NewCreateBackupHandler(
+	repo connection.Repository,
+	crypto domain.Cryptographer,
+	gateways connection.GatewayFactory,
+	backups backup.Store,
+	pool *jobs.Pool,
+) *CreateBackupHandler {
+	return &CreateBackupHandler{repo: repo, crypto: crypto, gateways: gateways, backups: backups, jobs: pool}
+}
+
+// Handle submete o backup como job. O resultado do job é o ID do backup, que só aparece na
+// listagem depois que o arquivo é concluído.
+func (h *CreateBackupHandler) Handle(ctx context.Context, cmd CreateBackupCmd) (_ *job.Job, err error) {
+	ctx, span := tracing.Start(ctx, "CreateBackup", &cmd.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	database, err := connection.NewIdentifier(cmd.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	var scope connection.DumpScope
+	for _, name := range cmd.Tables {
+		table, err := connection.ParseTableRef(name)
+		if err != nil {
+			return nil, err
+		}
+		scope.Tables = append(scope.Tables, table)
+	}
+
+	conn, err := h.repo.FindByID(ctx, cmd.ConnectionID)
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		return nil, ErrConnectionNotFound
+	}
+
+	gateway, err := h.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := h.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	b := backup.New(conn.ID, database.String(), scope)
+
+	target := database.String()
+	if len(cmd.Tables) > 0 {
+		target = strings.Join(cmd.Tables, ", ")
+	}
+
+	spec := jobs.Spec{
+		ConnectionID: conn.ID,
+		Kind:         "backup",
+		Database:     database.String(),
+		Target:       target,
+		Timeout:      backupTimeout,
+		// O arquivo só é criado quando o job roda: um job cancelado ainda na fila não deixa
+		// arquivo parcial para trás.
+		Run: func(ctx context.Context, r jobs.Reporter) (string, error) {
+			archive, err := h.backups.Create(ctx, b)
+			if err != nil {
+				return "", err
+			}
+
+			observer := &jobObserver{reporter: r}
+			if err := dumpToArchive(ctx, gateway, *conn, password, database, scope, archive, observer); err != nil {
+				return "", err
+			}
+			return b.ID.String(), nil
+		},
+	}
+
+	return h.jobs.Submit(ctx, spec)
+}
+
+// dumpToArchive grava o dump no arquivo e o conclui; em caso de falha o arquivo parcial é descartado.
+func dumpToArchive(
+	ctx context.Context,
+	gateway connection.Gateway,
+	conn connection.Connection,
+	password string,
+	database connection.Identifier,
+	scope connection.DumpScope,
+	archive backup.Archive,
+	observer *jobObserver,
+) error {
+	if err := gateway.DumpDatabase(ctx, conn, password, database, scope, archive, observer); err != nil {
+		if abortErr := archive.Abort(); abortErr != nil {
+			slog.WarnContext(ctx, "discarding partial backup", "error", abortErr)
+		}
+		return err
+	}
+
+	saved, err := archive.Commit()
+	if err != nil {
+		return err
+	}
+	observer.Notice(fmt.Sprintf("backup saved: %d bytes, sha256 %s", saved.Size, saved.Checksum))
+	return nil
+}
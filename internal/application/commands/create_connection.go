@@ -3,6 +3,7 @@ package commands
 import (
 	"context"
 
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
 	"github.com/felipemalacarne/mesa/internal/domain"
 	"github.com/felipemalacarne/mesa/internal/domain/connection"
 )
@@ -14,6 +15,16 @@ type CreateConnection struct {
 	Port     int    `json:"port"`
 	Username string `json:"username"`
 	Password string `json:"password"`
+	// Environment vazio equivale a development; Protection nil usa o padrão do ambiente.
+	Environment string   `json:"environment"`
+	Tags        []string `json:"tags"`
+	Color       string   `json:"color"`
+	Description string   `json:"description"`
+	Protection  *string  `json:"protection"`
+	// ReadOnly restringe a conexão a leituras, no servidor e na application.
+	ReadOnly bool `json:"read_only"`
+	// Tunnel, quando informado, faz as sessões passarem por um bastion SSH.
+	Tunnel *SSHTunnelInput `json:"ssh_tunnel"`
 }
 
 type CreateConnectionHandler struct {
@@ -25,7 +36,10 @@ func NewCreateConnectionHandler(r connection.Repository, c domain.Cryptographer)
 	return &CreateConnectionHandler{repo: r, crypto: c}
 }
 
-func (h *CreateConnectionHandler) Handle(ctx context.Context, cmd CreateConnection) (*connection.Connection, error) {
+func (h *CreateConnectionHandler) Handle(ctx context.Context, cmd CreateConnection) (_ *connection.Connection, err error) {
+	ctx, span := tracing.Start(ctx, "CreateConnection", nil)
+	defer func() { tracing.End(span, err) }()
+
 	encryptedPass, err := h.crypto.Encrypt(cmd.Password)
 	if err != nil {
 		return nil, err
@@ -43,6 +57,24 @@ func (h *CreateConnectionHandler) Handle(ctx context.Context, cmd CreateConnecti
 		return nil, err
 	}
 
+	err = conn.SetProfile(connection.Profile{
+		Environment: cmd.Environment,
+		Tags:        cmd.Tags,
+		Color:       cmd.Color,
+		Description: cmd.Description,
+		Protection:  cmd.Protection,
+	})
+	if err != nil {
+		return nil, err
+	}
+	conn.ReadOnly = cmd.ReadOnly
+
+	if cmd.Tunnel != nil {
+		if conn.Tunnel, err = newSSHTunnel(h.crypto, *cmd.Tunnel); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := h.repo.Save(ctx, conn); err != nil {
 		return nil, err
 	}
@@ -4,33 +4,49 @@ import (
 	"context"
 	"time"
 
+	"github.com/felipemalacarne/mesa/internal/application/protection"
+	"github.com/felipemalacarne/mesa/internal/application/recording"
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
 	"github.com/felipemalacarne/mesa/internal/domain"
 	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/history"
 	"github.com/google/uuid"
 )
 
-// CreateDatabaseCmd descreve o payload para criação de um novo banco remoto.
+// CreateDatabaseCmd descreve o payload para criação de um novo banco remoto. Campos vazios
+// usam os padrões do template.
 type CreateDatabaseCmd struct {
 	ConnectionID uuid.UUID `json:"connection_id"`
 	Name         string    `json:"name"`
 	Owner        string    `json:"owner"`
+	Template     *string   `json:"template"`
+	Encoding     string    `json:"encoding"`
+	Locale       string    `json:"locale"`
+	LcCollate    string    `json:"lc_collate"`
+	LcCtype      string    `json:"lc_ctype"`
+	Tablespace   *string   `json:"tablespace"`
+	ConnLimit    *int      `json:"conn_limit"`
 }
 
 type CreateDatabaseHandler struct {
 	repo     connection.Repository
 	crypto   domain.Cryptographer
 	gateways connection.GatewayFactory
+	history  *recording.Recorder
 }
 
 func NewCreateDatabaseHandler(
 	repo connection.Repository,
 	crypto domain.Cryptographer,
 	gateways connection.GatewayFactory,
+	history *recording.Recorder,
 ) *CreateDatabaseHandler {
-	return &CreateDatabaseHandler{repo: repo, crypto: crypto, gateways: gateways}
+	return &CreateDatabaseHandler{repo: repo, crypto: crypto, gateways: gateways, history: history}
 }
 
-func (h *CreateDatabaseHandler) Handle(ctx context.Context, cmd CreateDatabaseCmd) error {
+func (h *CreateDatabaseHandler) Handle(ctx context.Context, cmd CreateDatabaseCmd) (err error) {
+	ctx, span := tracing.Start(ctx, "CreateDatabase", &cmd.ConnectionID)
+	defer func() { tracing.End(span, err) }()
 
 	name, err := connection.NewIdentifier(cmd.Name)
 	if err != nil {
@@ -42,6 +58,24 @@ func (h *CreateDatabaseHandler) Handle(ctx context.Context, cmd CreateDatabaseCm
 		return err
 	}
 
+	opts := connection.DatabaseOptions{
+		Owner:     owner,
+		Encoding:  cmd.Encoding,
+		Locale:    cmd.Locale,
+		LcCollate: cmd.LcCollate,
+		LcCtype:   cmd.LcCtype,
+		ConnLimit: cmd.ConnLimit,
+	}
+	if opts.Template, err = optionalIdentifier(cmd.Template); err != nil {
+		return err
+	}
+	if opts.Tablespace, err = optionalIdentifier(cmd.Tablespace); err != nil {
+		return err
+	}
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+
 	conn, err := h.repo.FindByID(ctx, cmd.ConnectionID)
 	if err != nil {
 		return err
@@ -49,6 +83,9 @@ func (h *CreateDatabaseHandler) Handle(ctx context.Context, cmd CreateDatabaseCm
 	if conn == nil {
 		return ErrConnectionNotFound
 	}
+	if err := protection.GuardMutation(ctx, conn); err != nil {
+		return err
+	}
 
 	gateway, err := h.gateways.ForDriver(conn.Driver)
 	if err != nil {
@@ -62,6 +99,19 @@ func (h *CreateDatabaseHandler) Handle(ctx context.Context, cmd CreateDatabaseCm
 
 	timedCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
+	timedCtx = h.history.Track(timedCtx, conn.ID, name.String(), history.SourceAdmin)
+
+	return gateway.CreateDatabase(timedCtx, *conn, password, name, opts)
+}
 
-	return gateway.CreateDatabase(timedCtx, *conn, password, name, owner)
+// optionalIdentifier valida um nome opcional; nil e "" significam ausente.
+func optionalIdentifier(value *string) (*connection.Identifier, error) {
+	if value == nil || *value == "" {
+		return nil, nil
+	}
+	id, err := connection.NewIdentifier(*value)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
 }
@@ -6,8 +6,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/felipemalacarne/mesa/internal/application/protection"
+	"github.com/felipemalacarne/mesa/internal/application/recording"
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
 	"github.com/felipemalacarne/mesa/internal/domain"
 	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/history"
 	"github.com/google/uuid"
 )
 
@@ -40,13 +44,22 @@ type CreateTableHandler struct {
 	repo     connection.Repository
 	crypto   domain.Cryptographer
 	gateways connection.GatewayFactory
+	history  *recording.Recorder
 }
 
-func NewCreateTableHandler(repo connection.Repository, crypto domain.Cryptographer, gateways connection.GatewayFactory) *CreateTableHandler {
-	return &CreateTableHandler{repo: repo, crypto: crypto, gateways: gateways}
+func NewCreateTableHandler(
+	repo connection.Repository,
+	crypto domain.Cryptographer,
+	gateways connection.GatewayFactory,
+	history *recording.Recorder,
+) *CreateTableHandler {
+	return &CreateTableHandler{repo: repo, crypto: crypto, gateways: gateways, history: history}
 }
 
-func (h *CreateTableHandler) Handle(ctx context.Context, cmd CreateTableCmd) error {
+func (h *CreateTableHandler) Handle(ctx context.Context, cmd CreateTableCmd) (err error) {
+	ctx, span := tracing.Start(ctx, "CreateTable", &cmd.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
 	cmd.Name = strings.TrimSpace(cmd.Name)
 	cmd.DatabaseName = strings.TrimSpace(cmd.DatabaseName)
 
@@ -115,6 +128,9 @@ func (h *CreateTableHandler) Handle(ctx context.Context, cmd CreateTableCmd) err
 	if conn == nil {
 		return ErrConnectionNotFound
 	}
+	if err := protection.GuardMutation(ctx, conn); err != nil {
+		return err
+	}
 
 	password, err := h.crypto.Decrypt(conn.Password)
 	if err != nil {
@@ -133,6 +149,7 @@ func (h *CreateTableHandler) Handle(ctx context.Context, cmd CreateTableCmd) err
 	if err != nil {
 		return fmt.Errorf("invalid database name: %w", err)
 	}
+	timedCtx = h.history.Track(timedCtx, conn.ID, dbNameIdentifier.String(), history.SourceAdmin)
 
 	if err := gateway.CreateTable(timedCtx, *conn, password, dbNameIdentifier, *tableDef); err != nil {
 		return err
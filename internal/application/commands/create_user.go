@@ -5,32 +5,49 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/felipemalacarne/mesa/internal/application/protection"
+	"github.com/felipemalacarne/mesa/internal/application/recording"
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
 	"github.com/felipemalacarne/mesa/internal/domain"
 	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/history"
 	"github.com/google/uuid"
 )
 
 // CreateUserCmd representa a criação de um novo role no banco alvo.
 type CreateUserCmd struct {
-	ConnectionID uuid.UUID `json:"connection_id"`
-	Username     string    `json:"username"`
-	Password     string    `json:"password"`
-	IsSuperUser  bool      `json:"is_superuser"`
-	CanLogin     *bool     `json:"can_login"`
-	ConnLimit    *int      `json:"conn_limit"`
+	ConnectionID  uuid.UUID  `json:"connection_id"`
+	Username      string     `json:"username"`
+	Password      string     `json:"password"`
+	IsSuperUser   bool       `json:"is_superuser"`
+	CanLogin      *bool      `json:"can_login"`
+	CanCreateDB   bool       `json:"can_create_db"`
+	CanCreateRole bool       `json:"can_create_role"`
+	Replication   bool       `json:"replication"`
+	ConnLimit     *int       `json:"conn_limit"`
+	ValidUntil    *time.Time `json:"valid_until"`
 }
 
 type CreateUserHandler struct {
 	repo     connection.Repository
 	crypto   domain.Cryptographer
 	gateways connection.GatewayFactory
+	history  *recording.Recorder
 }
 
-func NewCreateUserHandler(repo connection.Repository, crypto domain.Cryptographer, gateways connection.GatewayFactory) *CreateUserHandler {
-	return &CreateUserHandler{repo: repo, crypto: crypto, gateways: gateways}
+func NewCreateUserHandler(
+	repo connection.Repository,
+	crypto domain.Cryptographer,
+	gateways connection.GatewayFactory,
+	history *recording.Recorder,
+) *CreateUserHandler {
+	return &CreateUserHandler{repo: repo, crypto: crypto, gateways: gateways, history: history}
 }
 
-func (h *CreateUserHandler) Handle(ctx context.Context, cmd CreateUserCmd) error {
+func (h *CreateUserHandler) Handle(ctx context.Context, cmd CreateUserCmd) (err error) {
+	ctx, span := tracing.Start(ctx, "CreateUser", &cmd.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
 	if cmd.Username == "postgres" || cmd.Username == "root" {
 		return fmt.Errorf("username %s is reserved", cmd.Username)
 	}
@@ -42,6 +59,9 @@ func (h *CreateUserHandler) Handle(ctx context.Context, cmd CreateUserCmd) error
 	if conn == nil {
 		return ErrConnectionNotFound
 	}
+	if err := protection.GuardMutation(ctx, conn); err != nil {
+		return err
+	}
 
 	gateway, err := h.gateways.ForDriver(conn.Driver)
 	if err != nil {
@@ -69,14 +89,19 @@ func (h *CreateUserHandler) Handle(ctx context.Context, cmd CreateUserCmd) error
 	}
 
 	newUser := connection.DBUser{
-		Name:        username,
-		IsSuperUser: cmd.IsSuperUser,
-		CanLogin:    canLogin,
-		ConnLimit:   connLimit,
+		Name:          username,
+		IsSuperUser:   cmd.IsSuperUser,
+		CanLogin:      canLogin,
+		ConnLimit:     connLimit,
+		CanCreateDB:   cmd.CanCreateDB,
+		CanCreateRole: cmd.CanCreateRole,
+		Replication:   cmd.Replication,
+		ValidUntil:    cmd.ValidUntil,
 	}
 
 	timedCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
+	timedCtx = h.history.Track(timedCtx, conn.ID, "", history.SourceAdmin)
 
 	return gateway.CreateUser(timedCtx, *conn, adminPass, newUser, cmd.Password)
 }
@@ -0,0 +1,33 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain/alert"
+	"github.com/google/uuid"
+)
+
+type DeleteAlertRuleHandler struct {
+	alerts alert.Repository
+}
+
+func NewDeleteAlertRuleHandler(alerts alert.Repository) *DeleteAlertRuleHandler {
+	return &DeleteAlertRuleHandler{alerts: alerts}
+}
+
+// Handle remove a regra e o estado de alerta associado a ela.
+func (h *DeleteAlertRuleHandler) Handle(ctx context.Context, ruleID uuid.UUID) (err error) {
+	ctx, span := tracing.Start(ctx, "DeleteAlertRule", nil)
+	defer func() { tracing.End(span, err) }()
+
+	rule, err := h.alerts.FindRule(ctx, ruleID)
+	if err != nil {
+		return err
+	}
+	if rule == nil {
+		return ErrAlertRuleNotFound
+	}
+
+	return h.alerts.DeleteRule(ctx, ruleID)
+}
@@ -0,0 +1,25 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain/backup"
+	"github.com/google/uuid"
+)
+
+type DeleteBackupHandler struct {
+	backups backup.Store
+}
+
+func NewDeleteBackupHandler(backups backup.Store) *DeleteBackupHandler {
+	return &DeleteBackupHandler{backups: backups}
+}
+
+// Handle remove o arquivo do backup e seus metadados.
+func (h *DeleteBackupHandler) Handle(ctx context.Context, connectionID, backupID uuid.UUID) (err error) {
+	ctx, span := tracing.Start(ctx, "DeleteBackup", &connectionID)
+	defer func() { tracing.End(span, err) }()
+
+	return h.backups.Delete(ctx, connectionID, backupID)
+}
@@ -0,0 +1,32 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain/savedquery"
+	"github.com/google/uuid"
+)
+
+type DeleteSavedQueryHandler struct {
+	library savedquery.Repository
+}
+
+func NewDeleteSavedQueryHandler(library savedquery.Repository) *DeleteSavedQueryHandler {
+	return &DeleteSavedQueryHandler{library: library}
+}
+
+func (h *DeleteSavedQueryHandler) Handle(ctx context.Context, queryID uuid.UUID) (err error) {
+	ctx, span := tracing.Start(ctx, "DeleteSavedQuery", nil)
+	defer func() { tracing.End(span, err) }()
+
+	q, err := h.library.FindQuery(ctx, queryID)
+	if err != nil {
+		return err
+	}
+	if q == nil {
+		return savedquery.ErrSavedQueryNotFound
+	}
+
+	return h.library.DeleteQuery(ctx, queryID)
+}
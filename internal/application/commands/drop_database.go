@@ -0,0 +1,80 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/protection"
+	"github.com/felipemalacarne/mesa/internal/application/recording"
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/history"
+	"github.com/google/uuid"
+)
+
+// DropDatabaseCmd remove um banco. Confirmation precisa repetir o nome do banco; Force encerra
+// as sessões conectadas a ele antes do DROP.
+type DropDatabaseCmd struct {
+	ConnectionID uuid.UUID `json:"connection_id"`
+	Name         string    `json:"name"`
+	Confirmation string    `json:"confirmation"`
+	Force        bool      `json:"force"`
+}
+
+type DropDatabaseHandler struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+	history  *recording.Recorder
+}
+
+func NewDropDatabaseHandler(
+	repo connection.Repository,
+	crypto domain.Cryptographer,
+	gateways connection.GatewayFactory,
+	history *recording.Recorder,
+) *DropDatabaseHandler {
+	return &DropDatabaseHandler{repo: repo, crypto: crypto, gateways: gateways, history: history}
+}
+
+func (h *DropDatabaseHandler) Handle(ctx context.Context, cmd DropDatabaseCmd) (err error) {
+	ctx, span := tracing.Start(ctx, "DropDatabase", &cmd.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	name, err := connection.NewIdentifier(cmd.Name)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Confirmation != cmd.Name {
+		return ErrConfirmationMismatch
+	}
+
+	conn, err := h.repo.FindByID(ctx, cmd.ConnectionID)
+	if err != nil {
+		return err
+	}
+	if conn == nil {
+		return ErrConnectionNotFound
+	}
+	if err := protection.GuardMutation(ctx, conn); err != nil {
+		return err
+	}
+
+	gateway, err := h.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return err
+	}
+
+	password, err := h.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return err
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	timedCtx = h.history.Track(timedCtx, conn.ID, name.String(), history.SourceAdmin)
+
+	return gateway.DropDatabase(timedCtx, *conn, password, name, cmd.Force)
+}
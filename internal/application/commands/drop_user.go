@@ -0,0 +1,96 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/protection"
+	"github.com/felipemalacarne/mesa/internal/application/recording"
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/history"
+	"github.com/google/uuid"
+)
+
+// dropUserTimeout cobre o REASSIGN/DROP OWNED executado em cada banco do servidor.
+const dropUserTimeout = time.Minute
+
+// DropUserCmd remove um role. ReassignTo e DropOwned tratam os objetos que ele possui.
+type DropUserCmd struct {
+	ConnectionID uuid.UUID `json:"connection_id"`
+	Username     string    `json:"username"`
+	ReassignTo   *string   `json:"reassign_to"`
+	DropOwned    bool      `json:"drop_owned"`
+}
+
+type DropUserHandler struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+	history  *recording.Recorder
+}
+
+func NewDropUserHandler(
+	repo connection.Repository,
+	crypto domain.Cryptographer,
+	gateways connection.GatewayFactory,
+	history *recording.Recorder,
+) *DropUserHandler {
+	return &DropUserHandler{repo: repo, crypto: crypto, gateways: gateways, history: history}
+}
+
+func (h *DropUserHandler) Handle(ctx context.Context, cmd DropUserCmd) (err error) {
+	ctx, span := tracing.Start(ctx, "DropUser", &cmd.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	username, err := connection.NewIdentifier(cmd.Username)
+	if err != nil {
+		return err
+	}
+
+	var opts connection.DropRoleOptions
+	opts.DropOwned = cmd.DropOwned
+	if cmd.ReassignTo != nil {
+		target, err := connection.NewIdentifier(*cmd.ReassignTo)
+		if err != nil {
+			return err
+		}
+		if target == username {
+			return connection.ErrReassignToSameRole
+		}
+		opts.ReassignTo = &target
+	}
+
+	conn, err := h.repo.FindByID(ctx, cmd.ConnectionID)
+	if err != nil {
+		return err
+	}
+	if conn == nil {
+		return ErrConnectionNotFound
+	}
+	if err := protection.GuardMutation(ctx, conn); err != nil {
+		return err
+	}
+
+	// Remover o próprio role da conexão a deixaria inutilizável.
+	if username.String() == conn.Username {
+		return ErrProtectedRole
+	}
+
+	gateway, err := h.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return err
+	}
+
+	password, err := h.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return err
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, dropUserTimeout)
+	defer cancel()
+	timedCtx = h.history.Track(timedCtx, conn.ID, "", history.SourceAdmin)
+
+	return gateway.DropUser(timedCtx, *conn, password, username, opts)
+}
@@ -4,3 +4,6 @@ import "errors"
 
 var ErrConnectionNotFound = errors.New("connection not found")
 var ErrInvalidInput = errors.New("invalid input")
+var ErrAlertRuleNotFound = errors.New("alert rule not found")
+var ErrProtectedRole = errors.New("the role used by this connection cannot be dropped")
+var ErrConfirmationMismatch = errors.New("confirmation does not match the database name")
@@ -0,0 +1,113 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/protection"
+	"github.com/felipemalacarne/mesa/internal/application/recording"
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/history"
+	"github.com/felipemalacarne/mesa/internal/domain/savedquery"
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultMaxRows é quantas linhas do resultado são devolvidas quando MaxRows não é informado.
+	DefaultMaxRows = 1000
+	MaxRowsLimit   = 10000
+)
+
+// ExecuteSavedQueryCmd executa uma consulta salva em uma conexão e banco. Params traz os valores
+// dos parâmetros decodificados do JSON; parâmetros omitidos usam o valor padrão.
+type ExecuteSavedQueryCmd struct {
+	QueryID      uuid.UUID
+	ConnectionID uuid.UUID
+	Database     string
+	Params       map[string]any
+	MaxRows      int
+}
+
+type ExecuteSavedQueryHandler struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+	library  savedquery.Repository
+	history  *recording.Recorder
+}
+
+func NewExecuteSavedQueryHandler(
+	repo connection.Repository,
+	crypto domain.Cryptographer,
+	gateways connection.GatewayFactory,
+	library savedquery.Repository,
+	history *recording.Recorder,
+) *ExecuteSavedQueryHandler {
+	return &ExecuteSavedQueryHandler{repo: repo, crypto: crypto, gateways: gateways, library: library, history: history}
+}
+
+// Handle troca os parâmetros nomeados por posicionais: os valores seguem separados do SQL e
+// nunca são interpolados no texto da consulta.
+func (h *ExecuteSavedQueryHandler) Handle(ctx context.Context, cmd ExecuteSavedQueryCmd) (_ *connection.QueryResult, err error) {
+	ctx, span := tracing.Start(ctx, "ExecuteSavedQuery", &cmd.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	maxRows := cmd.MaxRows
+	if maxRows == 0 {
+		maxRows = DefaultMaxRows
+	}
+	if maxRows < 1 || maxRows > MaxRowsLimit {
+		return nil, fmt.Errorf("%w: max_rows must be between 1 and %d", ErrInvalidInput, MaxRowsLimit)
+	}
+
+	database, err := connection.NewIdentifier(cmd.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	q, err := h.library.FindQuery(ctx, cmd.QueryID)
+	if err != nil {
+		return nil, err
+	}
+	if q == nil {
+		return nil, savedquery.ErrSavedQueryNotFound
+	}
+
+	conn, err := h.repo.FindByID(ctx, cmd.ConnectionID)
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		return nil, ErrConnectionNotFound
+	}
+	if !q.AppliesTo(*conn) {
+		return nil, savedquery.ErrOutOfScope
+	}
+	if err := protection.GuardStatement(ctx, conn, q.SQL); err != nil {
+		return nil, err
+	}
+
+	statement, args, err := q.Bind(cmd.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	gateway, err := h.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := h.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	timedCtx = h.history.Track(timedCtx, conn.ID, database.String(), history.SourceSavedQuery)
+
+	return gateway.ExecuteStatement(timedCtx, *conn, password, database, statement, args, maxRows)
+}
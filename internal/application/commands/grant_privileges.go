@@ -0,0 +1,73 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/protection"
+	"github.com/felipemalacarne/mesa/internal/application/recording"
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/history"
+	"github.com/google/uuid"
+)
+
+// GrantPrivilegesCmd executa um GRANT (ou ALTER DEFAULT PRIVILEGES ... GRANT) no banco.
+type GrantPrivilegesCmd struct {
+	ConnectionID uuid.UUID
+	DatabaseName connection.Identifier
+	Change       connection.PrivilegeChange
+}
+
+type GrantPrivilegesHandler struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+	history  *recording.Recorder
+}
+
+func NewGrantPrivilegesHandler(
+	repo connection.Repository,
+	crypto domain.Cryptographer,
+	gateways connection.GatewayFactory,
+	history *recording.Recorder,
+) *GrantPrivilegesHandler {
+	return &GrantPrivilegesHandler{repo: repo, crypto: crypto, gateways: gateways, history: history}
+}
+
+func (h *GrantPrivilegesHandler) Handle(ctx context.Context, cmd GrantPrivilegesCmd) (err error) {
+	ctx, span := tracing.Start(ctx, "GrantPrivileges", &cmd.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	if err := cmd.Change.Validate(); err != nil {
+		return err
+	}
+
+	conn, err := h.repo.FindByID(ctx, cmd.ConnectionID)
+	if err != nil {
+		return err
+	}
+	if conn == nil {
+		return ErrConnectionNotFound
+	}
+	if err := protection.GuardMutation(ctx, conn); err != nil {
+		return err
+	}
+
+	gateway, err := h.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return err
+	}
+
+	password, err := h.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return err
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	timedCtx = h.history.Track(timedCtx, conn.ID, cmd.DatabaseName.String(), history.SourceAdmin)
+
+	return gateway.GrantPrivileges(timedCtx, *conn, password, cmd.DatabaseName, cmd.Change)
+}
@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/protection"
+	"github.com/felipemalacarne/mesa/internal/application/recording"
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/history"
+	"github.com/google/uuid"
+)
+
+// GrantRoleCmd torna Member membro de Role.
+type GrantRoleCmd struct {
+	ConnectionID uuid.UUID `json:"connection_id"`
+	Role         string    `json:"role"`
+	Member       string    `json:"member"`
+	WithAdmin    bool      `json:"with_admin"`
+}
+
+type GrantRoleHandler struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+	history  *recording.Recorder
+}
+
+func NewGrantRoleHandler(
+	repo connection.Repository,
+	crypto domain.Cryptographer,
+	gateways connection.GatewayFactory,
+	history *recording.Recorder,
+) *GrantRoleHandler {
+	return &GrantRoleHandler{repo: repo, crypto: crypto, gateways: gateways, history: history}
+}
+
+func (h *GrantRoleHandler) Handle(ctx context.Context, cmd GrantRoleCmd) (err error) {
+	ctx, span := tracing.Start(ctx, "GrantRole", &cmd.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	role, err := connection.NewIdentifier(cmd.Role)
+	if err != nil {
+		return err
+	}
+
+	member, err := connection.NewIdentifier(cmd.Member)
+	if err != nil {
+		return err
+	}
+
+	if role == member {
+		return connection.ErrSelfMembership
+	}
+
+	conn, err := h.repo.FindByID(ctx, cmd.ConnectionID)
+	if err != nil {
+		return err
+	}
+	if conn == nil {
+		return ErrConnectionNotFound
+	}
+	if err := protection.GuardMutation(ctx, conn); err != nil {
+		return err
+	}
+
+	gateway, err := h.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return err
+	}
+
+	password, err := h.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return err
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	timedCtx = h.history.Track(timedCtx, conn.ID, "", history.SourceAdmin)
+
+	return gateway.GrantRole(timedCtx, *conn, password, role, member, cmd.WithAdmin)
+}
@@ -4,8 +4,12 @@ import (
 	"context"
 	"time"
 
+	"github.com/felipemalacarne/mesa/internal/application/protection"
+	"github.com/felipemalacarne/mesa/internal/application/recording"
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
 	"github.com/felipemalacarne/mesa/internal/domain"
 	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/history"
 	"github.com/google/uuid"
 )
 
@@ -19,13 +23,22 @@ type KillSessionHandler struct {
 	repo     connection.Repository
 	crypto   domain.Cryptographer
 	gateways connection.GatewayFactory
+	history  *recording.Recorder
 }
 
-func NewKillSessionHandler(repo connection.Repository, crypto domain.Cryptographer, gateways connection.GatewayFactory) *KillSessionHandler {
-	return &KillSessionHandler{repo: repo, crypto: crypto, gateways: gateways}
+func NewKillSessionHandler(
+	repo connection.Repository,
+	crypto domain.Cryptographer,
+	gateways connection.GatewayFactory,
+	history *recording.Recorder,
+) *KillSessionHandler {
+	return &KillSessionHandler{repo: repo, crypto: crypto, gateways: gateways, history: history}
 }
 
-func (h *KillSessionHandler) Handle(ctx context.Context, cmd KillSessionCmd) error {
+func (h *KillSessionHandler) Handle(ctx context.Context, cmd KillSessionCmd) (err error) {
+	ctx, span := tracing.Start(ctx, "KillSession", &cmd.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
 	conn, err := h.repo.FindByID(ctx, cmd.ConnectionID)
 	if err != nil {
 		return err
@@ -33,6 +46,9 @@ func (h *KillSessionHandler) Handle(ctx context.Context, cmd KillSessionCmd) err
 	if conn == nil {
 		return ErrConnectionNotFound
 	}
+	if err := protection.GuardMutation(ctx, conn); err != nil {
+		return err
+	}
 
 	gateway, err := h.gateways.ForDriver(conn.Driver)
 	if err != nil {
@@ -46,6 +62,7 @@ func (h *KillSessionHandler) Handle(ctx context.Context, cmd KillSessionCmd) err
 
 	timedCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
+	timedCtx = h.history.Track(timedCtx, conn.ID, "", history.SourceAdmin)
 
 	return gateway.KillSession(timedCtx, *conn, password, cmd.PID)
 }
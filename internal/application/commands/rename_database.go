@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/protection"
+	"github.com/felipemalacarne/mesa/internal/application/recording"
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/history"
+	"github.com/google/uuid"
+)
+
+// RenameDatabaseCmd renomeia um banco; o Postgres exige que não haja sessões conectadas a ele.
+type RenameDatabaseCmd struct {
+	ConnectionID uuid.UUID `json:"connection_id"`
+	Name         string    `json:"name"`
+	NewName      string    `json:"new_name"`
+}
+
+type RenameDatabaseHandler struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+	history  *recording.Recorder
+}
+
+func NewRenameDatabaseHandler(
+	repo connection.Repository,
+	crypto domain.Cryptographer,
+	gateways connection.GatewayFactory,
+	history *recording.Recorder,
+) *RenameDatabaseHandler {
+	return &RenameDatabaseHandler{repo: repo, crypto: crypto, gateways: gateways, history: history}
+}
+
+func (h *RenameDatabaseHandler) Handle(ctx context.Context, cmd RenameDatabaseCmd) (err error) {
+	ctx, span := tracing.Start(ctx, "RenameDatabase", &cmd.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	name, err := connection.NewIdentifier(cmd.Name)
+	if err != nil {
+		return err
+	}
+
+	newName, err := connection.NewIdentifier(cmd.NewName)
+	if err != nil {
+		return err
+	}
+
+	conn, err := h.repo.FindByID(ctx, cmd.ConnectionID)
+	if err != nil {
+		return err
+	}
+	if conn == nil {
+		return ErrConnectionNotFound
+	}
+	if err := protection.GuardMutation(ctx, conn); err != nil {
+		return err
+	}
+
+	gateway, err := h.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return err
+	}
+
+	password, err := h.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return err
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	timedCtx = h.history.Track(timedCtx, conn.ID, name.String(), history.SourceAdmin)
+
+	return gateway.RenameDatabase(timedCtx, *conn, password, name, newName)
+}
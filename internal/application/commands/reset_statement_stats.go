@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/protection"
+	"github.com/felipemalacarne/mesa/internal/application/recording"
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/history"
+	"github.com/google/uuid"
+)
+
+// ResetStatementStatsCmd zera as estatísticas acumuladas pelo pg_stat_statements.
+type ResetStatementStatsCmd struct {
+	ConnectionID uuid.UUID `json:"connection_id"`
+}
+
+type ResetStatementStatsHandler struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+	history  *recording.Recorder
+}
+
+func NewResetStatementStatsHandler(
+	repo connection.Repository,
+	crypto domain.Cryptographer,
+	gateways connection.GatewayFactory,
+	history *recording.Recorder,
+) *ResetStatementStatsHandler {
+	return &ResetStatementStatsHandler{repo: repo, crypto: crypto, gateways: gateways, history: history}
+}
+
+func (h *ResetStatementStatsHandler) Handle(ctx context.Context, cmd ResetStatementStatsCmd) (err error) {
+	ctx, span := tracing.Start(ctx, "ResetStatementStats", &cmd.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	conn, err := h.repo.FindByID(ctx, cmd.ConnectionID)
+	if err != nil {
+		return err
+	}
+	if conn == nil {
+		return ErrConnectionNotFound
+	}
+	if err := protection.GuardMutation(ctx, conn); err != nil {
+		return err
+	}
+
+	gateway, err := h.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return err
+	}
+
+	password, err := h.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return err
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	timedCtx = h.history.Track(timedCtx, conn.ID, "", history.SourceAdmin)
+
+	return gateway.ResetStatementStats(timedCtx, *conn, password)
+}
@@ -0,0 +1,135 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/felipemalacarne/mesa/internal/application/jobs"
+	"github.com/felipemalacarne/mesa/internal/application/protection"
+	"github.com/felipemalacarne/mesa/internal/application/recording"
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/backup"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/history"
+	"github.com/felipemalacarne/mesa/internal/domain/job"
+	"github.com/google/uuid"
+)
+
+// RestoreBackupCmd restaura um backup da conexão. Sem Database o destino é o banco de origem;
+// com Create o banco é criado antes, e com Clean os objetos do dump são removidos antes de
+// serem recriados.
+type RestoreBackupCmd struct {
+	ConnectionID uuid.UUID `json:"connection_id"`
+	BackupID     uuid.UUID `json:"backup_id"`
+	Database     *string   `json:"database"`
+	Create       bool      `json:"create"`
+	Clean        bool      `json:"clean"`
+}
+
+type RestoreBackupHandler struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+	backups  backup.Store
+	jobs     *jobs.Pool
+	history  *recording.Recorder
+}
+
+func NewRestoreBackupHandler(
+	repo connection.Repository,
+	crypto domain.Cryptographer,
+	gateways connection.GatewayFactory,
+	backups backup.Store,
+	pool *jobs.Pool,
+	history *recording.Recorder,
+) *RestoreBackupHandler {
+	return &RestoreBackupHandler{repo: repo, crypto: crypto, gateways: gateways, backups: backups, jobs: pool, history: history}
+}
+
+// Handle submete o restore como job. O checksum do arquivo é conferido já dentro do job, já
+// que exige ler o arquivo inteiro.
+func (h *RestoreBackupHandler) Handle(ctx context.Context, cmd RestoreBackupCmd) (_ *job.Job, err error) {
+	ctx, span := tracing.Start(ctx, "RestoreBackup", &cmd.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	conn, err := h.repo.FindByID(ctx, cmd.ConnectionID)
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		return nil, ErrConnectionNotFound
+	}
+	if err := protection.GuardMutation(ctx, conn); err != nil {
+		return nil, err
+	}
+
+	b, err := h.backups.Find(ctx, conn.ID, cmd.BackupID)
+	if err != nil {
+		return nil, err
+	}
+	if b == nil {
+		return nil, backup.ErrBackupNotFound
+	}
+
+	target := b.Database
+	if cmd.Database != nil {
+		target = *cmd.Database
+	}
+	database, err := connection.NewIdentifier(target)
+	if err != nil {
+		return nil, err
+	}
+
+	gateway, err := h.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := h.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := jobs.Spec{
+		ConnectionID: conn.ID,
+		Kind:         "restore",
+		Database:     database.String(),
+		Target:       b.ID.String(),
+		Timeout:      backupTimeout,
+		Run: func(ctx context.Context, r jobs.Reporter) (string, error) {
+			observer := &jobObserver{reporter: r}
+			return "", h.restore(ctx, gateway, *conn, password, b.ID, database, cmd, observer)
+		},
+	}
+
+	return h.jobs.Submit(ctx, spec)
+}
+
+func (h *RestoreBackupHandler) restore(
+	ctx context.Context,
+	gateway connection.Gateway,
+	conn connection.Connection,
+	password string,
+	backupID uuid.UUID,
+	database connection.Identifier,
+	cmd RestoreBackupCmd,
+	observer *jobObserver,
+) error {
+	dump, err := h.backups.Open(ctx, conn.ID, backupID)
+	if err != nil {
+		return err
+	}
+	defer dump.Close()
+	observer.Notice("archive checksum verified")
+
+	ctx = h.history.Track(ctx, conn.ID, database.String(), history.SourceAdmin)
+
+	if cmd.Create {
+		if err := gateway.CreateDatabase(ctx, conn, password, database, connection.DatabaseOptions{}); err != nil {
+			return err
+		}
+		observer.Notice("created database " + database.String())
+	}
+
+	return gateway.RestoreDatabase(ctx, conn, password, database, dump, connection.RestoreOptions{Clean: cmd.Clean}, observer)
+}
@@ -0,0 +1,73 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/protection"
+	"github.com/felipemalacarne/mesa/internal/application/recording"
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/history"
+	"github.com/google/uuid"
+)
+
+// RevokePrivilegesCmd executa um REVOKE (ou ALTER DEFAULT PRIVILEGES ... REVOKE) no banco.
+type RevokePrivilegesCmd struct {
+	ConnectionID uuid.UUID
+	DatabaseName connection.Identifier
+	Change       connection.PrivilegeChange
+}
+
+type RevokePrivilegesHandler struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+	history  *recording.Recorder
+}
+
+func NewRevokePrivilegesHandler(
+	repo connection.Repository,
+	crypto domain.Cryptographer,
+	gateways connection.GatewayFactory,
+	history *recording.Recorder,
+) *RevokePrivilegesHandler {
+	return &RevokePrivilegesHandler{repo: repo, crypto: crypto, gateways: gateways, history: history}
+}
+
+func (h *RevokePrivilegesHandler) Handle(ctx context.Context, cmd RevokePrivilegesCmd) (err error) {
+	ctx, span := tracing.Start(ctx, "RevokePrivileges", &cmd.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	if err := cmd.Change.Validate(); err != nil {
+		return err
+	}
+
+	conn, err := h.repo.FindByID(ctx, cmd.ConnectionID)
+	if err != nil {
+		return err
+	}
+	if conn == nil {
+		return ErrConnectionNotFound
+	}
+	if err := protection.GuardMutation(ctx, conn); err != nil {
+		return err
+	}
+
+	gateway, err := h.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return err
+	}
+
+	password, err := h.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return err
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	timedCtx = h.history.Track(timedCtx, conn.ID, cmd.DatabaseName.String(), history.SourceAdmin)
+
+	return gateway.RevokePrivileges(timedCtx, *conn, password, cmd.DatabaseName, cmd.Change)
+}
@@ -0,0 +1,209 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/jobs"
+	"github.com/felipemalacarne/mesa/internal/application/protection"
+	"github.com/felipemalacarne/mesa/internal/application/recording"
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/history"
+	"github.com/felipemalacarne/mesa/internal/domain/job"
+	"github.com/google/uuid"
+)
+
+const (
+	// maintenanceTimeout limita a duração de um comando de manutenção em background.
+	maintenanceTimeout = 6 * time.Hour
+	// progressInterval é a frequência com que o progresso do servidor é lido.
+	progressInterval = 2 * time.Second
+)
+
+// RunMaintenanceCmd descreve um VACUUM, ANALYZE, REINDEX ou REFRESH MATERIALIZED VIEW.
+type RunMaintenanceCmd struct {
+	ConnectionID uuid.UUID `json:"connection_id"`
+	Database     string    `json:"database"`
+	Kind         string    `json:"kind"`
+	Schema       string    `json:"schema"`
+	Target       *string   `json:"target"`
+	Full         bool      `json:"full"`
+	Analyze      bool      `json:"analyze"`
+	Verbose      bool      `json:"verbose"`
+	Concurrently bool      `json:"concurrently"`
+}
+
+type RunMaintenanceHandler struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+	jobs     *jobs.Pool
+	history  *recording.Recorder
+}
+
+func NewRunMaintenanceHandler(
+	repo connection.Repository,
+	crypto domain.Cryptographer,
+	gateways connection.GatewayFactory,
+	pool *jobs.Pool,
+	history *recording.Recorder,
+) *RunMaintenanceHandler {
+	return &RunMaintenanceHandler{repo: repo, crypto: crypto, gateways: gateways, jobs: pool, history: history}
+}
+
+// Handle valida o comando e o submete como job, devolvendo o job enfileirado.
+func (h *RunMaintenanceHandler) Handle(ctx context.Context, cmd RunMaintenanceCmd) (_ *job.Job, err error) {
+	ctx, span := tracing.Start(ctx, "RunMaintenance", &cmd.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	kind, err := connection.NewMaintenanceKind(cmd.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	database, err := connection.NewIdentifier(cmd.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := connection.NewIdentifier(cmd.Schema)
+	if err != nil {
+		return nil, err
+	}
+
+	var target *connection.Identifier
+	if cmd.Target != nil {
+		id, err := connection.NewIdentifier(*cmd.Target)
+		if err != nil {
+			return nil, err
+		}
+		target = &id
+	}
+
+	task, err := connection.NewMaintenanceTask(kind, database, schema, target, connection.MaintenanceOptions{
+		Full:         cmd.Full,
+		Analyze:      cmd.Analyze,
+		Verbose:      cmd.Verbose,
+		Concurrently: cmd.Concurrently,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := h.repo.FindByID(ctx, cmd.ConnectionID)
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		return nil, ErrConnectionNotFound
+	}
+	if err := protection.GuardMutation(ctx, conn); err != nil {
+		return nil, err
+	}
+
+	gateway, err := h.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := h.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := jobs.Spec{
+		ConnectionID: conn.ID,
+		Kind:         string(task.Kind),
+		Database:     task.Database.String(),
+		Target:       task.TargetName(),
+		Timeout:      maintenanceTimeout,
+		Run: func(ctx context.Context, r jobs.Reporter) (string, error) {
+			observer := &jobObserver{reporter: r}
+			stop := trackMaintenanceProgress(ctx, gateway, *conn, password, task.Database, observer)
+			defer stop()
+
+			// Só o comando de manutenção entra no histórico, não a consulta periódica do progresso.
+			ctx = h.history.Track(ctx, conn.ID, task.Database.String(), history.SourceAdmin)
+			return "", gateway.RunMaintenance(ctx, *conn, password, *task, observer)
+		},
+	}
+
+	return h.jobs.Submit(ctx, spec)
+}
+
+// jobObserver repassa ao job as mensagens do servidor e guarda o PID do backend.
+type jobObserver struct {
+	reporter jobs.Reporter
+	pid      atomic.Int64
+}
+
+func (o *jobObserver) Started(pid int) {
+	o.pid.Store(int64(pid))
+	o.reporter.Log(fmt.Sprintf("backend pid %d", pid))
+}
+
+func (o *jobObserver) Notice(message string) {
+	o.reporter.Log(message)
+}
+
+// trackMaintenanceProgress lê periodicamente o progresso reportado pelo servidor para o backend
+// do observer e o repassa ao job, até a função devolvida ser chamada.
+func trackMaintenanceProgress(
+	ctx context.Context,
+	gateway connection.Gateway,
+	conn connection.Connection,
+	password string,
+	database connection.Identifier,
+	observer *jobObserver,
+) (stop func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(progressInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			pid := observer.pid.Load()
+			if pid == 0 {
+				continue
+			}
+
+			timedCtx, cancelRead := context.WithTimeout(ctx, 5*time.Second)
+			progress, err := gateway.GetMaintenanceProgress(timedCtx, conn, password, database, int(pid))
+			cancelRead()
+			// O progresso é apenas informativo: uma falha ao lê-lo não interrompe a tarefa.
+			if err != nil {
+				slog.DebugContext(ctx, "reading maintenance progress", "pid", pid, "error", err)
+				continue
+			}
+			if progress == nil {
+				continue
+			}
+
+			phase := progress.Phase
+			if progress.Relation != "" {
+				phase += " (" + progress.Relation + ")"
+			}
+			observer.reporter.Progress(progress.Percent(), phase)
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
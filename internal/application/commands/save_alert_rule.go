@@ -0,0 +1,82 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain/alert"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/google/uuid"
+)
+
+// SaveAlertRuleCmd cria uma regra quando RuleID é nil, ou substitui a definição de uma existente.
+type SaveAlertRuleCmd struct {
+	RuleID            *uuid.UUID
+	Name              string
+	ConnectionID      *uuid.UUID
+	Condition         string
+	Threshold         float64
+	ConsecutiveChecks int
+	Channel           string
+	Target            string
+	Enabled           bool
+}
+
+type SaveAlertRuleHandler struct {
+	repo   connection.Repository
+	alerts alert.Repository
+}
+
+func NewSaveAlertRuleHandler(repo connection.Repository, alerts alert.Repository) *SaveAlertRuleHandler {
+	return &SaveAlertRuleHandler{repo: repo, alerts: alerts}
+}
+
+func (h *SaveAlertRuleHandler) Handle(ctx context.Context, cmd SaveAlertRuleCmd) (_ *alert.Rule, err error) {
+	ctx, span := tracing.Start(ctx, "SaveAlertRule", nil)
+	defer func() { tracing.End(span, err) }()
+
+	condition, err := alert.NewCondition(cmd.Condition)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, err := alert.NewChannel(cmd.Channel)
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.ConnectionID != nil {
+		conn, err := h.repo.FindByID(ctx, *cmd.ConnectionID)
+		if err != nil {
+			return nil, err
+		}
+		if conn == nil {
+			return nil, ErrConnectionNotFound
+		}
+	}
+
+	var rule *alert.Rule
+	if cmd.RuleID == nil {
+		rule, err = alert.NewRule(cmd.Name, cmd.ConnectionID, condition, cmd.Threshold, cmd.ConsecutiveChecks, channel, cmd.Target, cmd.Enabled)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		rule, err = h.alerts.FindRule(ctx, *cmd.RuleID)
+		if err != nil {
+			return nil, err
+		}
+		if rule == nil {
+			return nil, ErrAlertRuleNotFound
+		}
+		if err := rule.Update(cmd.Name, cmd.ConnectionID, condition, cmd.Threshold, cmd.ConsecutiveChecks, channel, cmd.Target, cmd.Enabled); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := h.alerts.SaveRule(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
@@ -0,0 +1,95 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/savedquery"
+	"github.com/google/uuid"
+)
+
+type SavedQueryParam struct {
+	Name        string
+	Type        string
+	Description string
+	Default     *string
+}
+
+// SaveSavedQueryCmd cria uma consulta salva quando QueryID é nil, ou substitui a definição de
+// uma existente. ConnectionID ou Driver restringem onde a consulta pode ser executada.
+type SaveSavedQueryCmd struct {
+	QueryID      *uuid.UUID
+	Name         string
+	Description  string
+	SQL          string
+	ConnectionID *uuid.UUID
+	Driver       *string
+	Tags         []string
+	Params       []SavedQueryParam
+}
+
+type SaveSavedQueryHandler struct {
+	repo    connection.Repository
+	library savedquery.Repository
+}
+
+func NewSaveSavedQueryHandler(repo connection.Repository, library savedquery.Repository) *SaveSavedQueryHandler {
+	return &SaveSavedQueryHandler{repo: repo, library: library}
+}
+
+func (h *SaveSavedQueryHandler) Handle(ctx context.Context, cmd SaveSavedQueryCmd) (_ *savedquery.Query, err error) {
+	ctx, span := tracing.Start(ctx, "SaveSavedQuery", cmd.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	var driver *connection.Driver
+	if cmd.Driver != nil {
+		driver, err = connection.NewDriver(*cmd.Driver)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	params := make([]savedquery.Param, len(cmd.Params))
+	for i, p := range cmd.Params {
+		params[i], err = savedquery.NewParam(p.Name, p.Type, p.Description, p.Default)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if cmd.ConnectionID != nil {
+		conn, err := h.repo.FindByID(ctx, *cmd.ConnectionID)
+		if err != nil {
+			return nil, err
+		}
+		if conn == nil {
+			return nil, ErrConnectionNotFound
+		}
+	}
+
+	var q *savedquery.Query
+	if cmd.QueryID == nil {
+		q, err = savedquery.New(cmd.Name, cmd.Description, cmd.SQL, cmd.ConnectionID, driver, cmd.Tags, params)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		q, err = h.library.FindQuery(ctx, *cmd.QueryID)
+		if err != nil {
+			return nil, err
+		}
+		if q == nil {
+			return nil, savedquery.ErrSavedQueryNotFound
+		}
+		if err := q.Update(cmd.Name, cmd.Description, cmd.SQL, cmd.ConnectionID, driver, cmd.Tags, params); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := h.library.SaveQuery(ctx, q); err != nil {
+		return nil, err
+	}
+
+	return q, nil
+}
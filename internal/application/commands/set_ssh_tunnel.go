@@ -0,0 +1,100 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/protection"
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/google/uuid"
+)
+
+// SSHTunnelInput descreve o bastion SSH. A autenticação é por PrivateKey, quando informada,
+// ou por Password; KnownHosts recebe as linhas de known_hosts do bastion, ex: de ssh-keyscan.
+type SSHTunnelInput struct {
+	Host       string `json:"host"`
+	Port       int    `json:"port"`
+	Username   string `json:"username"`
+	Password   string `json:"password"`
+	PrivateKey string `json:"private_key"`
+	Passphrase string `json:"passphrase"`
+	KnownHosts string `json:"known_hosts"`
+}
+
+// newSSHTunnel valida o túnel e criptografa seus segredos.
+func newSSHTunnel(crypto domain.Cryptographer, input SSHTunnelInput) (*connection.SSHTunnel, error) {
+	auth, secret := connection.TunnelAuthPassword, input.Password
+	if input.PrivateKey != "" {
+		if input.Password != "" {
+			return nil, fmt.Errorf("%w: an SSH tunnel takes a password or a private key, not both", ErrInvalidInput)
+		}
+		auth, secret = connection.TunnelAuthPrivateKey, input.PrivateKey
+	}
+
+	var encryptedSecret, encryptedPassphrase string
+	if secret != "" {
+		var err error
+		if encryptedSecret, err = crypto.Encrypt(secret); err != nil {
+			return nil, err
+		}
+	}
+	if auth == connection.TunnelAuthPrivateKey && input.Passphrase != "" {
+		var err error
+		if encryptedPassphrase, err = crypto.Encrypt(input.Passphrase); err != nil {
+			return nil, err
+		}
+	}
+
+	return connection.NewSSHTunnel(input.Host, input.Port, input.Username, string(auth), encryptedSecret, encryptedPassphrase, input.KnownHosts)
+}
+
+// SetSSHTunnelCmd configura o túnel SSH da conexão; Tunnel nil o remove.
+type SetSSHTunnelCmd struct {
+	ConnectionID uuid.UUID       `json:"connection_id"`
+	Tunnel       *SSHTunnelInput `json:"tunnel"`
+}
+
+type SetSSHTunnelHandler struct {
+	repo   connection.Repository
+	crypto domain.Cryptographer
+}
+
+func NewSetSSHTunnelHandler(repo connection.Repository, crypto domain.Cryptographer) *SetSSHTunnelHandler {
+	return &SetSSHTunnelHandler{repo: repo, crypto: crypto}
+}
+
+// Handle exige a confirmação em conexões protegidas: o túnel decide a que servidor as sessões
+// chegam.
+func (h *SetSSHTunnelHandler) Handle(ctx context.Context, cmd SetSSHTunnelCmd) (_ *connection.Connection, err error) {
+	ctx, span := tracing.Start(ctx, "SetSSHTunnel", &cmd.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	conn, err := h.repo.FindByID(ctx, cmd.ConnectionID)
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		return nil, ErrConnectionNotFound
+	}
+	if err := protection.GuardConfirmation(ctx, conn); err != nil {
+		return nil, err
+	}
+
+	var tunnel *connection.SSHTunnel
+	if cmd.Tunnel != nil {
+		if tunnel, err = newSSHTunnel(h.crypto, *cmd.Tunnel); err != nil {
+			return nil, err
+		}
+	}
+	conn.Tunnel = tunnel
+	conn.UpdatedAt = time.Now()
+
+	if err := h.repo.Save(ctx, conn); err != nil {
+		return nil, err
+	}
+
+	return conn, nil
+}
@@ -0,0 +1,119 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/protection"
+	"github.com/felipemalacarne/mesa/internal/application/recording"
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/history"
+	"github.com/google/uuid"
+)
+
+// maxPreviewSessions limita quantas sessões a pré-visualização retorna.
+const maxPreviewSessions = 1000
+
+// SignalSessionsCmd cancela ou encerra em lote as sessões que atendem ao filtro.
+// Com DryRun nada é enviado ao servidor: apenas os PIDs afetados são retornados.
+type SignalSessionsCmd struct {
+	ConnectionID uuid.UUID                `json:"connection_id"`
+	Signal       connection.SessionSignal `json:"signal"`
+	Filter       connection.SessionFilter `json:"filter"`
+	DryRun       bool                     `json:"dry_run"`
+}
+
+type SignalSessionsResult struct {
+	Matched   []int
+	Signalled []int
+	Failed    []int
+}
+
+type SignalSessionsHandler struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+	history  *recording.Recorder
+}
+
+func NewSignalSessionsHandler(
+	repo connection.Repository,
+	crypto domain.Cryptographer,
+	gateways connection.GatewayFactory,
+	history *recording.Recorder,
+) *SignalSessionsHandler {
+	return &SignalSessionsHandler{repo: repo, crypto: crypto, gateways: gateways, history: history}
+}
+
+func (h *SignalSessionsHandler) Handle(ctx context.Context, cmd SignalSessionsCmd) (_ *SignalSessionsResult, err error) {
+	ctx, span := tracing.Start(ctx, "SignalSessions", &cmd.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	f := cmd.Filter
+	if f.User == "" && f.Database == "" && len(f.States) == 0 && f.MinDuration == 0 && len(f.PIDs) == 0 {
+		return nil, fmt.Errorf("%w: bulk actions require at least one filter", ErrInvalidInput)
+	}
+
+	conn, err := h.repo.FindByID(ctx, cmd.ConnectionID)
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		return nil, ErrConnectionNotFound
+	}
+	// A simulação não altera o servidor e é permitida em qualquer conexão.
+	if !cmd.DryRun {
+		if err := protection.GuardMutation(ctx, conn); err != nil {
+			return nil, err
+		}
+	}
+
+	gateway, err := h.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := h.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	result := &SignalSessionsResult{
+		Matched:   make([]int, 0),
+		Signalled: make([]int, 0),
+		Failed:    make([]int, 0),
+	}
+
+	if cmd.DryRun {
+		page, err := gateway.ListSessions(timedCtx, *conn, password, cmd.Filter, maxPreviewSessions, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, session := range page.Sessions {
+			result.Matched = append(result.Matched, session.PID)
+		}
+		return result, nil
+	}
+
+	timedCtx = h.history.Track(timedCtx, conn.ID, "", history.SourceAdmin)
+	signals, err := gateway.SignalSessions(timedCtx, *conn, password, cmd.Filter, cmd.Signal)
+	if err != nil {
+		return nil, err
+	}
+	for _, signal := range signals {
+		result.Matched = append(result.Matched, signal.PID)
+		if signal.Signalled {
+			result.Signalled = append(result.Signalled, signal.PID)
+		} else {
+			result.Failed = append(result.Failed, signal.PID)
+		}
+	}
+
+	return result, nil
+}
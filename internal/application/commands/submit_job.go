@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain/job"
+)
+
+// JobSubmitter decodifica a entrada de um comando e o submete como job.
+type JobSubmitter func(ctx context.Context, input json.RawMessage) (*job.Job, error)
+
+// Submitter adapta o Handle de um comando que roda como job, cuja entrada é o próprio Cmd em JSON.
+func Submitter[C any](handle func(context.Context, C) (*job.Job, error)) JobSubmitter {
+	return func(ctx context.Context, input json.RawMessage) (*job.Job, error) {
+		var cmd C
+		if len(input) > 0 {
+			if err := json.Unmarshal(input, &cmd); err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+			}
+		}
+		return handle(ctx, cmd)
+	}
+}
+
+// SubmitJobCmd submete o comando Command, com a entrada Input, como job.
+type SubmitJobCmd struct {
+	Command string
+	Input   json.RawMessage
+}
+
+// SubmitJobHandler submete como job qualquer comando registrado, ex: run_maintenance.
+type SubmitJobHandler struct {
+	submitters map[string]JobSubmitter
+}
+
+func NewSubmitJobHandler(submitters map[string]JobSubmitter) *SubmitJobHandler {
+	return &SubmitJobHandler{submitters: submitters}
+}
+
+func (h *SubmitJobHandler) Handle(ctx context.Context, cmd SubmitJobCmd) (_ *job.Job, err error) {
+	ctx, span := tracing.Start(ctx, "SubmitJob", nil)
+	defer func() { tracing.End(span, err) }()
+
+	submit, ok := h.submitters[cmd.Command]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown command %q, supported commands are: %s", ErrInvalidInput, cmd.Command, strings.Join(h.Commands(), ", "))
+	}
+
+	return submit(ctx, cmd.Input)
+}
+
+// Commands devolve os comandos que podem ser submetidos como job, em ordem alfabética.
+func (h *SubmitJobHandler) Commands() []string {
+	names := make([]string, 0, len(h.submitters))
+	for name := range h.submitters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain/alert"
+	"github.com/google/uuid"
+)
+
+// ErrNotificationFailed indica que o destino da regra recusou ou não respondeu à notificação.
+var ErrNotificationFailed = errors.New("notification could not be delivered")
+
+// TestAlertRuleHandler envia uma notificação de teste para o destino configurado na regra.
+type TestAlertRuleHandler struct {
+	alerts   alert.Repository
+	notifier alert.Notifier
+}
+
+func NewTestAlertRuleHandler(alerts alert.Repository, notifier alert.Notifier) *TestAlertRuleHandler {
+	return &TestAlertRuleHandler{alerts: alerts, notifier: notifier}
+}
+
+func (h *TestAlertRuleHandler) Handle(ctx context.Context, ruleID uuid.UUID) (err error) {
+	ctx, span := tracing.Start(ctx, "TestAlertRule", nil)
+	defer func() { tracing.End(span, err) }()
+
+	rule, err := h.alerts.FindRule(ctx, ruleID)
+	if err != nil {
+		return err
+	}
+	if rule == nil {
+		return ErrAlertRuleNotFound
+	}
+
+	now := time.Now().UTC()
+	event := alert.Event{
+		Kind:           alert.EventFiring,
+		Rule:           *rule,
+		ConnectionName: "test",
+		Message:        "Test notification sent from Mesa.",
+		FiredAt:        now,
+		At:             now,
+	}
+	if rule.ConnectionID != nil {
+		event.ConnectionID = *rule.ConnectionID
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+
+	if err := h.notifier.Notify(timedCtx, event); err != nil {
+		return fmt.Errorf("%w: %v", ErrNotificationFailed, err)
+	}
+	return nil
+}
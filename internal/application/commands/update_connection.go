@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/protection"
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/google/uuid"
+)
+
+// UpdateConnectionCmd altera o perfil e o modo somente leitura da conexão; campos nil mantêm o
+// valor atual. Ao trocar o ambiente sem informar Protection, a conexão recebe a proteção padrão
+// do novo ambiente.
+type UpdateConnectionCmd struct {
+	ConnectionID uuid.UUID `json:"connection_id"`
+	Environment  *string   `json:"environment"`
+	Tags         *[]string `json:"tags"`
+	Color        *string   `json:"color"`
+	Description  *string   `json:"description"`
+	Protection   *string   `json:"protection"`
+	ReadOnly     *bool     `json:"read_only"`
+}
+
+type UpdateConnectionHandler struct {
+	repo connection.Repository
+}
+
+func NewUpdateConnectionHandler(repo connection.Repository) *UpdateConnectionHandler {
+	return &UpdateConnectionHandler{repo: repo}
+}
+
+// Handle exige a confirmação quando a conexão já é protegida, para que a proteção não possa
+// ser retirada sem ela. Conexões somente leitura podem ser alteradas: é assim que o modo é
+// desligado.
+func (h *UpdateConnectionHandler) Handle(ctx context.Context, cmd UpdateConnectionCmd) (_ *connection.Connection, err error) {
+	ctx, span := tracing.Start(ctx, "UpdateConnection", &cmd.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	conn, err := h.repo.FindByID(ctx, cmd.ConnectionID)
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		return nil, ErrConnectionNotFound
+	}
+	if err := protection.GuardConfirmation(ctx, conn); err != nil {
+		return nil, err
+	}
+
+	current := string(conn.Protection)
+	profile := connection.Profile{
+		Environment: string(conn.Environment),
+		Tags:        conn.Tags,
+		Color:       conn.Color,
+		Description: conn.Description,
+		Protection:  &current,
+	}
+	if cmd.Environment != nil {
+		profile.Environment = *cmd.Environment
+		profile.Protection = nil
+	}
+	if cmd.Tags != nil {
+		profile.Tags = *cmd.Tags
+	}
+	if cmd.Color != nil {
+		profile.Color = *cmd.Color
+	}
+	if cmd.Description != nil {
+		profile.Description = *cmd.Description
+	}
+	if cmd.Protection != nil {
+		profile.Protection = cmd.Protection
+	}
+
+	if err := conn.SetProfile(profile); err != nil {
+		return nil, err
+	}
+	if cmd.ReadOnly != nil {
+		conn.ReadOnly = *cmd.ReadOnly
+	}
+	conn.UpdatedAt = time.Now()
+
+	if err := h.repo.Save(ctx, conn); err != nil {
+		return nil, err
+	}
+
+	return conn, nil
+}
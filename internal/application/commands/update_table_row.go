@@ -5,8 +5,12 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/felipemalacarne/mesa/internal/application/protection"
+	"github.com/felipemalacarne/mesa/internal/application/recording"
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
 	"github.com/felipemalacarne/mesa/internal/domain"
 	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/history"
 	"github.com/google/uuid"
 )
 
@@ -22,17 +26,22 @@ type UpdateTableRowHandler struct {
 	repo     connection.Repository
 	crypto   domain.Cryptographer
 	gateways connection.GatewayFactory
+	history  *recording.Recorder
 }
 
 func NewUpdateTableRowHandler(
 	repo connection.Repository,
 	crypto domain.Cryptographer,
 	gateways connection.GatewayFactory,
+	history *recording.Recorder,
 ) *UpdateTableRowHandler {
-	return &UpdateTableRowHandler{repo: repo, crypto: crypto, gateways: gateways}
+	return &UpdateTableRowHandler{repo: repo, crypto: crypto, gateways: gateways, history: history}
 }
 
-func (h *UpdateTableRowHandler) Handle(ctx context.Context, cmd UpdateTableRowCmd) error {
+func (h *UpdateTableRowHandler) Handle(ctx context.Context, cmd UpdateTableRowCmd) (err error) {
+	ctx, span := tracing.Start(ctx, "UpdateTableRow", &cmd.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
 	if len(cmd.Where) == 0 {
 		return fmt.Errorf("%w: where clause is required", ErrInvalidInput)
 	}
@@ -47,6 +56,9 @@ func (h *UpdateTableRowHandler) Handle(ctx context.Context, cmd UpdateTableRowCm
 	if conn == nil {
 		return ErrConnectionNotFound
 	}
+	if err := protection.GuardMutation(ctx, conn); err != nil {
+		return err
+	}
 
 	password, err := h.crypto.Decrypt(conn.Password)
 	if err != nil {
@@ -60,6 +72,7 @@ func (h *UpdateTableRowHandler) Handle(ctx context.Context, cmd UpdateTableRowCm
 
 	timedCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
+	timedCtx = h.history.Track(timedCtx, conn.ID, cmd.DatabaseName.String(), history.SourceEdit)
 
 	return gateway.UpdateTableRow(timedCtx, *conn, password, cmd.DatabaseName, cmd.TableName, cmd.Where, cmd.Set)
 }
@@ -0,0 +1,126 @@
+// Package health runs the background checker that keeps the reachability of each connection.
+package health
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/google/uuid"
+)
+
+const (
+	// maxConcurrentChecks limita quantas conexões são verificadas ao mesmo tempo.
+	maxConcurrentChecks = 4
+	checkTimeout        = 10 * time.Second
+)
+
+// Checker pinga periodicamente cada conexão e grava o resultado no repositório de saúde.
+type Checker struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+	health   connection.HealthRepository
+	interval time.Duration
+}
+
+func NewChecker(
+	repo connection.Repository,
+	crypto domain.Cryptographer,
+	gateways connection.GatewayFactory,
+	health connection.HealthRepository,
+	interval time.Duration,
+) *Checker {
+	return &Checker{
+		repo:     repo,
+		crypto:   crypto,
+		gateways: gateways,
+		health:   health,
+		interval: interval,
+	}
+}
+
+// Run verifica todas as conexões a cada intervalo até o contexto ser cancelado.
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		c.tick(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Checker) tick(ctx context.Context) {
+	ctx = tracing.WithAttrs(ctx, slog.String(tracing.OperationKey, "CheckHealth"))
+
+	conns, err := c.repo.ListAll(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "health checker: listing connections", "error", err)
+		return
+	}
+
+	saved, err := c.health.ListHealth(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "health checker: listing previous checks", "error", err)
+		return
+	}
+	previous := make(map[uuid.UUID]connection.HealthCheck, len(saved))
+	for _, check := range saved {
+		previous[check.ConnectionID] = check
+	}
+
+	sem := make(chan struct{}, maxConcurrentChecks)
+	var wg sync.WaitGroup
+	for _, conn := range conns {
+		check, ok := previous[conn.ID]
+		if !ok {
+			check = connection.HealthCheck{ConnectionID: conn.ID}
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(conn connection.Connection, check connection.HealthCheck) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			ctx := tracing.WithConnectionID(ctx, conn.ID)
+			latency, err := c.ping(ctx, conn)
+			check.Record(err, latency, time.Now().UTC())
+
+			if err := c.health.SaveHealth(ctx, check); err != nil {
+				slog.WarnContext(ctx, "health checker: saving check", "error", err)
+			}
+		}(*conn, check)
+	}
+	wg.Wait()
+}
+
+// ping devolve a latência do ping; falhas ao preparar a conexão também a tornam indisponível.
+func (c *Checker) ping(ctx context.Context, conn connection.Connection) (time.Duration, error) {
+	gateway, err := c.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return 0, err
+	}
+
+	password, err := c.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return 0, err
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err = gateway.Ping(timedCtx, conn, password)
+	return time.Since(start), err
+}
@@ -0,0 +1,406 @@
+// Package jobs runs long-running commands on a pool of background workers and keeps their
+// state in the job repository, so they outlive the request that submitted them.
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain/job"
+	"github.com/google/uuid"
+)
+
+var ErrQueueFull = errors.New("job queue is full, try again later")
+
+const (
+	queueSize = 100
+	// defaultTimeout limita jobs cuja especificação não define um timeout.
+	defaultTimeout = time.Hour
+	// flushInterval é a frequência com que progresso e logs dos jobs ativos são gravados.
+	flushInterval = time.Second
+	pruneInterval = time.Hour
+	// watchInterval limita a frequência dos eventos enviados a quem acompanha um job.
+	watchInterval = 200 * time.Millisecond
+)
+
+// Reporter recebe o andamento de uma tarefa enquanto ela executa.
+type Reporter interface {
+	Log(line string)
+	// Progress informa o percentual concluído e a fase atual da tarefa.
+	Progress(percent float64, phase string)
+}
+
+// Task executa o trabalho de um job e devolve a referência ao que produziu, ex: o ID de um backup.
+type Task func(ctx context.Context, r Reporter) (result string, err error)
+
+type Spec struct {
+	ConnectionID uuid.UUID
+	Kind         string
+	Database     string
+	Target       string
+	Timeout      time.Duration
+	Run          Task
+}
+
+type entry struct {
+	job       *job.Job
+	spec      Spec
+	ctx       context.Context
+	cancel    context.CancelFunc
+	cancelled bool
+	dirty     bool
+	// changed é fechado a cada alteração do job para acordar quem o acompanha.
+	changed chan struct{}
+}
+
+// Pool executa os jobs submetidos em um número fixo de workers. O estado dos jobs em execução
+// fica em memória e é gravado no repositório periodicamente e a cada mudança de status.
+type Pool struct {
+	repo      job.Repository
+	workers   int
+	retention time.Duration
+	queue     chan *entry
+
+	mu     sync.Mutex
+	active map[uuid.UUID]*entry
+	// saveMu serializa as gravações para que um estado antigo nunca sobrescreva um mais novo.
+	saveMu sync.Mutex
+}
+
+func NewPool(repo job.Repository, workers int, retention time.Duration) *Pool {
+	return &Pool{
+		repo:      repo,
+		workers:   max(workers, 1),
+		retention: retention,
+		queue:     make(chan *entry, queueSize),
+		active:    make(map[uuid.UUID]*entry),
+	}
+}
+
+// Run marca como falhos os jobs interrompidos por um processo anterior e executa os workers
+// até o contexto ser cancelado, o que também cancela os jobs em execução.
+func (p *Pool) Run(ctx context.Context) {
+	ctx = tracing.WithAttrs(ctx, slog.String(tracing.OperationKey, "RunJobs"))
+
+	interrupted, err := p.repo.InterruptJobs(ctx, "interrupted by server restart", time.Now().UTC())
+	if err != nil {
+		slog.WarnContext(ctx, "job pool: failing interrupted jobs", "error", err)
+	}
+	if interrupted > 0 {
+		slog.InfoContext(ctx, "job pool: failed jobs interrupted by restart", "count", interrupted)
+	}
+	p.prune(ctx)
+
+	var wg sync.WaitGroup
+	for range p.workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			p.work(ctx)
+		}()
+	}
+
+	flush := time.NewTicker(flushInterval)
+	defer flush.Stop()
+	prune := time.NewTicker(pruneInterval)
+	defer prune.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		case <-flush.C:
+			p.flush(ctx)
+		case <-prune.C:
+			p.prune(ctx)
+		}
+	}
+}
+
+// Submit grava o job como enfileirado e o entrega aos workers. O contexto fornece apenas os
+// valores da tarefa: cancelá-lo não interrompe o job.
+func (p *Pool) Submit(ctx context.Context, spec Spec) (*job.Job, error) {
+	if spec.Timeout <= 0 {
+		spec.Timeout = defaultTimeout
+	}
+
+	j := job.New(spec.ConnectionID, spec.Kind, spec.Database, spec.Target)
+	if err := p.repo.SaveJob(ctx, *j); err != nil {
+		return nil, err
+	}
+
+	e := &entry{
+		job:     j,
+		spec:    spec,
+		ctx:     context.WithoutCancel(ctx),
+		changed: make(chan struct{}),
+	}
+
+	p.mu.Lock()
+	p.active[j.ID] = e
+	snapshot := j.Snapshot()
+	p.mu.Unlock()
+
+	select {
+	case p.queue <- e:
+		return &snapshot, nil
+	default:
+		p.finish(e, "", ErrQueueFull)
+		return nil, ErrQueueFull
+	}
+}
+
+// Get devolve o estado mais recente do job, da memória enquanto ativo ou do repositório.
+func (p *Pool) Get(ctx context.Context, id uuid.UUID) (*job.Job, error) {
+	p.mu.Lock()
+	if e, ok := p.active[id]; ok {
+		snapshot := e.job.Snapshot()
+		p.mu.Unlock()
+		return &snapshot, nil
+	}
+	p.mu.Unlock()
+
+	j, err := p.repo.FindJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if j == nil {
+		return nil, job.ErrJobNotFound
+	}
+	return j, nil
+}
+
+// List devolve os jobs do repositório, substituindo os ativos pelo estado em memória.
+func (p *Pool) List(ctx context.Context, filter job.Filter) ([]job.Job, error) {
+	jobs, err := p.repo.ListJobs(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for i, j := range jobs {
+		if e, ok := p.active[j.ID]; ok {
+			jobs[i] = e.job.Snapshot()
+		}
+	}
+	return jobs, nil
+}
+
+// Cancel cancela um job. Um job enfileirado termina imediatamente; um em execução tem seu
+// contexto cancelado e termina quando a tarefa retornar.
+func (p *Pool) Cancel(ctx context.Context, id uuid.UUID) (*job.Job, error) {
+	p.mu.Lock()
+	e, ok := p.active[id]
+	if !ok {
+		p.mu.Unlock()
+
+		j, err := p.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %s", job.ErrJobFinished, j.Status)
+	}
+
+	if e.job.Status == job.StatusQueued {
+		e.cancelled = true
+		p.mu.Unlock()
+		return p.finish(e, "", context.Canceled), nil
+	}
+
+	if !e.cancelled {
+		e.cancelled = true
+		e.cancel()
+		e.job.AppendLog("cancellation requested")
+		p.touch(e)
+	}
+	snapshot := e.job.Snapshot()
+	p.mu.Unlock()
+
+	return &snapshot, nil
+}
+
+// Watch chama fn com o estado do job a cada mudança, no máximo a cada watchInterval, até o job
+// terminar, fn falhar ou o contexto ser cancelado.
+func (p *Pool) Watch(ctx context.Context, id uuid.UUID, fn func(job.Job) error) error {
+	for {
+		p.mu.Lock()
+		e, ok := p.active[id]
+		var (
+			snapshot job.Job
+			changed  chan struct{}
+		)
+		if ok {
+			snapshot = e.job.Snapshot()
+			changed = e.changed
+		}
+		p.mu.Unlock()
+
+		if !ok {
+			j, err := p.Get(ctx, id)
+			if err != nil {
+				return err
+			}
+			return fn(*j)
+		}
+
+		if err := fn(snapshot); err != nil {
+			return err
+		}
+		if snapshot.Status.Finished() {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(watchInterval):
+		}
+	}
+}
+
+func (p *Pool) work(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e := <-p.queue:
+			p.execute(ctx, e)
+		}
+	}
+}
+
+func (p *Pool) execute(poolCtx context.Context, e *entry) {
+	p.saveMu.Lock()
+	p.mu.Lock()
+	// Jobs cancelados enquanto enfileirados são finalizados por Cancel.
+	if e.cancelled || e.job.Status.Finished() {
+		p.mu.Unlock()
+		p.saveMu.Unlock()
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(e.ctx, e.spec.Timeout)
+	defer cancel()
+	e.cancel = cancel
+	e.job.Start(time.Now().UTC())
+	snapshot := e.job.Snapshot()
+	p.touch(e)
+	p.mu.Unlock()
+
+	p.save(ctx, snapshot)
+	p.saveMu.Unlock()
+
+	// Encerrar o pool cancela os jobs em execução.
+	stop := context.AfterFunc(poolCtx, cancel)
+	defer stop()
+
+	ctx = tracing.WithConnectionID(ctx, e.spec.ConnectionID)
+	result, err := e.spec.Run(ctx, &reporter{pool: p, entry: e})
+	if err != nil && poolCtx.Err() != nil {
+		err = fmt.Errorf("interrupted by server shutdown: %w", err)
+	}
+
+	finished := p.finish(e, result, err)
+	if finished.Status == job.StatusFailed {
+		slog.WarnContext(ctx, "job failed", "job_id", finished.ID, "kind", finished.Kind, "target", finished.Target, "error", err)
+	}
+}
+
+// finish grava o estado final do job e o remove dos ativos. A remoção acontece depois da
+// gravação para que Watch, ao não encontrar o job em memória, leia o estado final.
+func (p *Pool) finish(e *entry, result string, err error) *job.Job {
+	p.saveMu.Lock()
+	defer p.saveMu.Unlock()
+
+	p.mu.Lock()
+	// Uma tarefa que conclui apesar do pedido de cancelamento é considerada bem-sucedida.
+	e.job.Finish(result, err, e.cancelled && err != nil, time.Now().UTC())
+	snapshot := e.job.Snapshot()
+	p.mu.Unlock()
+
+	p.save(e.ctx, snapshot)
+
+	p.mu.Lock()
+	delete(p.active, snapshot.ID)
+	p.touch(e)
+	p.mu.Unlock()
+
+	return &snapshot
+}
+
+// flush grava o progresso e os logs dos jobs alterados desde a última gravação.
+func (p *Pool) flush(ctx context.Context) {
+	p.saveMu.Lock()
+	defer p.saveMu.Unlock()
+
+	p.mu.Lock()
+	snapshots := make([]job.Job, 0)
+	for _, e := range p.active {
+		if e.dirty {
+			snapshots = append(snapshots, e.job.Snapshot())
+			e.dirty = false
+		}
+	}
+	p.mu.Unlock()
+
+	for _, snapshot := range snapshots {
+		p.save(ctx, snapshot)
+	}
+}
+
+func (p *Pool) prune(ctx context.Context) {
+	if err := p.repo.DeleteJobsFinishedBefore(ctx, time.Now().UTC().Add(-p.retention)); err != nil {
+		slog.WarnContext(ctx, "job pool: pruning finished jobs", "error", err)
+	}
+}
+
+// save ignora o cancelamento do contexto: o estado final precisa ser gravado mesmo durante o
+// encerramento do servidor.
+func (p *Pool) save(ctx context.Context, j job.Job) {
+	if err := p.repo.SaveJob(context.WithoutCancel(ctx), j); err != nil {
+		slog.WarnContext(ctx, "job pool: saving job", "job_id", j.ID, "error", err)
+	}
+}
+
+// touch marca o job como alterado e acorda quem o acompanha; deve ser chamado com o lock.
+func (p *Pool) touch(e *entry) {
+	e.dirty = true
+	close(e.changed)
+	e.changed = make(chan struct{})
+}
+
+type reporter struct {
+	pool  *Pool
+	entry *entry
+}
+
+func (r *reporter) Log(line string) {
+	r.pool.mu.Lock()
+	defer r.pool.mu.Unlock()
+
+	r.entry.job.AppendLog(line)
+	r.pool.touch(r.entry)
+}
+
+func (r *reporter) Progress(percent float64, phase string) {
+	r.pool.mu.Lock()
+	defer r.pool.mu.Unlock()
+
+	percent = min(max(percent, 0), 100)
+	r.entry.job.Progress = &percent
+	r.entry.job.Phase = phase
+	r.pool.touch(r.entry)
+}
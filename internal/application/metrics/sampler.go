@@ -0,0 +1,211 @@
+// Package metrics runs the background sampler that feeds the per-connection time series.
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/metric"
+	"github.com/google/uuid"
+)
+
+const (
+	// maxConcurrentSamples limita quantas conexões são amostradas ao mesmo tempo.
+	maxConcurrentSamples = 4
+	sampleTimeout        = 10 * time.Second
+	housekeepingTimeout  = 30 * time.Second
+)
+
+// retention define por quanto tempo cada resolução é mantida.
+var retention = map[metric.Resolution]time.Duration{
+	metric.ResolutionRaw:         24 * time.Hour,
+	metric.ResolutionFiveMinutes: 7 * 24 * time.Hour,
+	metric.ResolutionHour:        90 * 24 * time.Hour,
+}
+
+// rollups lista as agregações feitas a cada ciclo, da mais fina para a mais grossa.
+var rollups = []struct{ from, to metric.Resolution }{
+	{metric.ResolutionRaw, metric.ResolutionFiveMinutes},
+	{metric.ResolutionFiveMinutes, metric.ResolutionHour},
+}
+
+// Sampler coleta periodicamente as estatísticas de cada conexão e mantém a série histórica.
+type Sampler struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+	samples  metric.Repository
+	interval time.Duration
+
+	mu   sync.Mutex
+	prev map[uuid.UUID]connection.ServerStats
+}
+
+func NewSampler(
+	repo connection.Repository,
+	crypto domain.Cryptographer,
+	gateways connection.GatewayFactory,
+	samples metric.Repository,
+	interval time.Duration,
+) *Sampler {
+	return &Sampler{
+		repo:     repo,
+		crypto:   crypto,
+		gateways: gateways,
+		samples:  samples,
+		interval: interval,
+		prev:     make(map[uuid.UUID]connection.ServerStats),
+	}
+}
+
+// Run amostra todas as conexões a cada intervalo até o contexto ser cancelado.
+func (s *Sampler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.tick(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Sampler) tick(ctx context.Context) {
+	ctx = tracing.WithAttrs(ctx, slog.String(tracing.OperationKey, "SampleMetrics"))
+
+	conns, err := s.repo.ListAll(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "metrics sampler: listing connections", "error", err)
+		return
+	}
+
+	s.forget(conns)
+
+	sem := make(chan struct{}, maxConcurrentSamples)
+	var wg sync.WaitGroup
+	for _, conn := range conns {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(conn connection.Connection) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			ctx := tracing.WithConnectionID(ctx, conn.ID)
+			if err := s.sample(ctx, conn); err != nil {
+				slog.WarnContext(ctx, "metrics sampler: sampling connection", "error", err)
+			}
+		}(*conn)
+	}
+	wg.Wait()
+
+	s.housekeep(ctx, conns)
+}
+
+func (s *Sampler) sample(ctx context.Context, conn connection.Connection) error {
+	gateway, err := s.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return err
+	}
+
+	password, err := s.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return err
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, sampleTimeout)
+	defer cancel()
+
+	stats, err := gateway.GetServerStats(timedCtx, conn, password)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	prev, ok := s.prev[conn.ID]
+	s.prev[conn.ID] = *stats
+	s.mu.Unlock()
+
+	var previous *connection.ServerStats
+	if ok {
+		previous = &prev
+	}
+
+	return s.samples.Save(timedCtx, metric.NewSample(conn.ID, previous, *stats))
+}
+
+// forget descarta os snapshots de conexões que foram removidas.
+func (s *Sampler) forget(conns []*connection.Connection) {
+	alive := make(map[uuid.UUID]bool, len(conns))
+	for _, conn := range conns {
+		alive[conn.ID] = true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id := range s.prev {
+		if !alive[id] {
+			delete(s.prev, id)
+		}
+	}
+}
+
+// housekeep agrega os buckets já fechados e apaga as amostras fora da retenção.
+func (s *Sampler) housekeep(ctx context.Context, conns []*connection.Connection) {
+	timedCtx, cancel := context.WithTimeout(ctx, housekeepingTimeout)
+	defer cancel()
+
+	now := time.Now().UTC()
+	for _, conn := range conns {
+		for _, r := range rollups {
+			if err := s.rollup(timedCtx, conn.ID, r.from, r.to, now); err != nil {
+				slog.WarnContext(ctx, "metrics sampler: rolling up samples",
+					"from", r.from, "to", r.to, tracing.ConnectionIDKey, conn.ID, "error", err)
+			}
+		}
+	}
+
+	for resolution, keep := range retention {
+		if err := s.samples.DeleteBefore(timedCtx, resolution, now.Add(-keep)); err != nil {
+			slog.WarnContext(ctx, "metrics sampler: pruning samples", "resolution", resolution, "error", err)
+		}
+	}
+}
+
+// rollup agrega as amostras de source em buckets de target, a partir do último bucket gravado
+// até o último bucket completamente fechado.
+func (s *Sampler) rollup(ctx context.Context, connectionID uuid.UUID, source, target metric.Resolution, now time.Time) error {
+	bucket := target.Bucket()
+	to := now.Truncate(bucket)
+	from := now.Add(-retention[source]).Truncate(bucket)
+
+	latest, err := s.samples.Latest(ctx, connectionID, target)
+	if err != nil {
+		return err
+	}
+	if latest != nil && latest.Add(bucket).After(from) {
+		from = latest.Add(bucket)
+	}
+	if !from.Before(to) {
+		return nil
+	}
+
+	samples, err := s.samples.Range(ctx, connectionID, source, from, to)
+	if err != nil {
+		return err
+	}
+
+	aggregated := metric.Downsample(samples, target)
+	if len(aggregated) == 0 {
+		return nil
+	}
+	return s.samples.Save(ctx, aggregated...)
+}
@@ -0,0 +1,59 @@
+// Package protection applies the safeguards of read-only and protected connections, shared by
+// the commands and by the queries that may change the server, such as EXPLAIN ANALYZE.
+package protection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+)
+
+var ErrConfirmationRequired = errors.New("the connection is protected, confirm the command with the connection name")
+var ErrReadOnlyConnection = errors.New("the connection is read-only")
+
+type confirmationKey struct{}
+
+// WithConfirmation guarda no contexto a confirmação enviada pelo cliente: o nome da conexão
+// protegida em que o comando vai rodar.
+func WithConfirmation(ctx context.Context, confirmation string) context.Context {
+	return context.WithValue(ctx, confirmationKey{}, confirmation)
+}
+
+func confirmationFrom(ctx context.Context) string {
+	confirmation, _ := ctx.Value(confirmationKey{}).(string)
+	return confirmation
+}
+
+// GuardMutation é chamado por todo comando que altera o servidor, antes de qualquer acesso a
+// ele. Conexões somente leitura recusam o comando; as protegidas exigem a confirmação.
+func GuardMutation(ctx context.Context, conn *connection.Connection) error {
+	if conn.ReadOnly {
+		return fmt.Errorf("%w: %s", ErrReadOnlyConnection, conn.Name)
+	}
+	return GuardConfirmation(ctx, conn)
+}
+
+// GuardConfirmation exige, em conexões protegidas, que a confirmação do contexto seja o nome
+// da conexão.
+func GuardConfirmation(ctx context.Context, conn *connection.Connection) error {
+	if !conn.RequiresConfirmation() {
+		return nil
+	}
+	if confirmationFrom(ctx) != conn.Name {
+		return fmt.Errorf("%w: %s (%s)", ErrConfirmationRequired, conn.Name, conn.Environment)
+	}
+	return nil
+}
+
+// GuardStatement protege a execução de SQL livre. Conexões protegidas confirmam toda execução:
+// SELECT ... FOR UPDATE ou INTO, setval e funções que escrevem começam todos com SELECT.
+// Conexões somente leitura recusam também as instruções que não são leituras ou que chamam
+// funções como pg_terminate_backend, que uma sessão somente leitura não impede.
+func GuardStatement(ctx context.Context, conn *connection.Connection, statement string) error {
+	if !connection.IsReadStatement(statement) || connection.CallsSideEffectFunction(statement) {
+		return GuardMutation(ctx, conn)
+	}
+	return GuardConfirmation(ctx, conn)
+}
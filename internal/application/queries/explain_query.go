@@ -0,0 +1,97 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/protection"
+	"github.com/felipemalacarne/mesa/internal/application/recording"
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/history"
+	"github.com/google/uuid"
+)
+
+const (
+	// slowestPlanNodes é quantos nós são destacados como os mais lentos.
+	slowestPlanNodes = 3
+	// misestimateFactor é a razão entre linhas estimadas e reais a partir da qual um nó é destacado.
+	misestimateFactor = 10
+)
+
+type ExplainQuery struct {
+	ConnectionID uuid.UUID
+	DatabaseName connection.Identifier
+	Statement    string
+	Options      connection.ExplainOptions
+}
+
+// ExplainResult traz o plano e os nós que merecem atenção.
+type ExplainResult struct {
+	Plan         *connection.QueryPlan
+	Slowest      []connection.PlanNode
+	Misestimates []connection.PlanNode
+}
+
+// ExplainQueryHandler executa EXPLAIN para uma instrução e destaca os pontos críticos do plano.
+type ExplainQueryHandler struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+	history  *recording.Recorder
+}
+
+func NewExplainQueryHandler(repo connection.Repository, crypto domain.Cryptographer, gateways connection.GatewayFactory, history *recording.Recorder) *ExplainQueryHandler {
+	return &ExplainQueryHandler{repo: repo, crypto: crypto, gateways: gateways, history: history}
+}
+
+func (h *ExplainQueryHandler) Handle(ctx context.Context, query ExplainQuery) (_ *ExplainResult, err error) {
+	ctx, span := tracing.Start(ctx, "ExplainQuery", &query.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	statement, err := connection.NewStatement(query.Statement)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := h.repo.FindByID(ctx, query.ConnectionID)
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		return nil, ErrConnectionNotFound
+	}
+	// ANALYZE executa a instrução. O rollback não desfaz sequências, sessões encerradas nem
+	// comandos enviados por dblink, então valem as mesmas regras de um comando.
+	if query.Options.Analyze {
+		if err := protection.GuardStatement(ctx, conn, statement); err != nil {
+			return nil, err
+		}
+	}
+
+	gateway, err := h.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := h.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	timedCtx = h.history.Track(timedCtx, conn.ID, query.DatabaseName.String(), history.SourceExplain)
+
+	plan, err := gateway.Explain(timedCtx, *conn, password, query.DatabaseName, statement, query.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExplainResult{
+		Plan:         plan,
+		Slowest:      plan.SlowestNodes(slowestPlanNodes),
+		Misestimates: plan.Misestimates(misestimateFactor),
+	}, nil
+}
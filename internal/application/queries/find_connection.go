@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
 	"github.com/felipemalacarne/mesa/internal/domain/connection"
 	"github.com/google/uuid"
 )
@@ -14,15 +15,25 @@ type FindConnection struct {
 	ConnectionID uuid.UUID
 }
 
+// ConnectionView é a conexão junto com o resultado da última verificação de saúde.
+type ConnectionView struct {
+	*connection.Connection
+	Health *connection.HealthCheck // nil enquanto a conexão não foi verificada
+}
+
 type FindConnectionHandler struct {
-	repo connection.Repository
+	repo   connection.Repository
+	health connection.HealthRepository
 }
 
-func NewFindConnectionHandler(repo connection.Repository) *FindConnectionHandler {
-	return &FindConnectionHandler{repo: repo}
+func NewFindConnectionHandler(repo connection.Repository, health connection.HealthRepository) *FindConnectionHandler {
+	return &FindConnectionHandler{repo: repo, health: health}
 }
 
-func (h *FindConnectionHandler) Handle(ctx context.Context, query FindConnection) (*connection.Connection, error) {
+func (h *FindConnectionHandler) Handle(ctx context.Context, query FindConnection) (_ *ConnectionView, err error) {
+	ctx, span := tracing.Start(ctx, "FindConnection", &query.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
 	conn, err := h.repo.FindByID(ctx, query.ConnectionID)
 	if err != nil {
 		return nil, err
@@ -32,5 +43,10 @@ func (h *FindConnectionHandler) Handle(ctx context.Context, query FindConnection
 		return nil, ErrConnectionNotFound
 	}
 
-	return conn, nil
+	health, err := h.health.FindHealth(ctx, conn.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConnectionView{Connection: conn, Health: health}, nil
 }
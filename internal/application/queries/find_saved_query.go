@@ -0,0 +1,31 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain/savedquery"
+	"github.com/google/uuid"
+)
+
+type FindSavedQueryHandler struct {
+	library savedquery.Repository
+}
+
+func NewFindSavedQueryHandler(library savedquery.Repository) *FindSavedQueryHandler {
+	return &FindSavedQueryHandler{library: library}
+}
+
+func (h *FindSavedQueryHandler) Handle(ctx context.Context, queryID uuid.UUID) (_ *savedquery.Query, err error) {
+	ctx, span := tracing.Start(ctx, "FindSavedQuery", nil)
+	defer func() { tracing.End(span, err) }()
+
+	q, err := h.library.FindQuery(ctx, queryID)
+	if err != nil {
+		return nil, err
+	}
+	if q == nil {
+		return nil, savedquery.ErrSavedQueryNotFound
+	}
+	return q, nil
+}
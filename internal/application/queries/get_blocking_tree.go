@@ -0,0 +1,64 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/google/uuid"
+)
+
+// BlockingTree agrupa as sessões bloqueadas sob o bloqueador raiz.
+type BlockingTree struct {
+	Roots        []connection.BlockingNode
+	WaitingCount int
+}
+
+// GetBlockingTreeHandler monta a árvore de bloqueios a partir de pg_locks e pg_blocking_pids().
+type GetBlockingTreeHandler struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+}
+
+func NewGetBlockingTreeHandler(repo connection.Repository, crypto domain.Cryptographer, gateways connection.GatewayFactory) *GetBlockingTreeHandler {
+	return &GetBlockingTreeHandler{repo: repo, crypto: crypto, gateways: gateways}
+}
+
+func (h *GetBlockingTreeHandler) Handle(ctx context.Context, connectionID uuid.UUID) (_ *BlockingTree, err error) {
+	ctx, span := tracing.Start(ctx, "GetBlockingTree", &connectionID)
+	defer func() { tracing.End(span, err) }()
+
+	conn, err := h.repo.FindByID(ctx, connectionID)
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		return nil, ErrConnectionNotFound
+	}
+
+	gateway, err := h.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := h.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	waits, err := gateway.GetLockWaits(timedCtx, *conn, password)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BlockingTree{
+		Roots:        connection.BuildBlockingTree(waits),
+		WaitingCount: connection.WaitingCount(waits),
+	}, nil
+}
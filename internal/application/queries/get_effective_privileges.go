@@ -0,0 +1,58 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/google/uuid"
+)
+
+// GetEffectivePrivileges pede a matriz de privilégios de Role nas tabelas do banco,
+// opcionalmente restrita a um schema.
+type GetEffectivePrivileges struct {
+	ConnectionID uuid.UUID
+	DatabaseName connection.Identifier
+	Role         connection.Identifier
+	Schema       *connection.Identifier
+}
+
+type GetEffectivePrivilegesHandler struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+}
+
+func NewGetEffectivePrivilegesHandler(repo connection.Repository, crypto domain.Cryptographer, gateways connection.GatewayFactory) *GetEffectivePrivilegesHandler {
+	return &GetEffectivePrivilegesHandler{repo: repo, crypto: crypto, gateways: gateways}
+}
+
+func (h *GetEffectivePrivilegesHandler) Handle(ctx context.Context, query GetEffectivePrivileges) (_ *connection.EffectivePrivileges, err error) {
+	ctx, span := tracing.Start(ctx, "GetEffectivePrivileges", &query.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	conn, err := h.repo.FindByID(ctx, query.ConnectionID)
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		return nil, ErrConnectionNotFound
+	}
+
+	gateway, err := h.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := h.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return gateway.GetEffectivePrivileges(timedCtx, *conn, password, query.DatabaseName, query.Role, query.Schema)
+}
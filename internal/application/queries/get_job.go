@@ -0,0 +1,25 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/felipemalacarne/mesa/internal/application/jobs"
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain/job"
+	"github.com/google/uuid"
+)
+
+type GetJobHandler struct {
+	jobs *jobs.Pool
+}
+
+func NewGetJobHandler(pool *jobs.Pool) *GetJobHandler {
+	return &GetJobHandler{jobs: pool}
+}
+
+func (h *GetJobHandler) Handle(ctx context.Context, jobID uuid.UUID) (_ *job.Job, err error) {
+	ctx, span := tracing.Start(ctx, "GetJob", nil)
+	defer func() { tracing.End(span, err) }()
+
+	return h.jobs.Get(ctx, jobID)
+}
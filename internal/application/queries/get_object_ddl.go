@@ -0,0 +1,58 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/google/uuid"
+)
+
+type GetObjectDDL struct {
+	ConnectionID uuid.UUID
+	DatabaseName connection.Identifier
+	Schema       connection.Identifier
+	Kind         connection.ObjectKind
+	Name         connection.Identifier
+}
+
+// GetObjectDDLHandler reconstrói o CREATE de um objeto do schema (tabela, view, índice, função ou trigger).
+type GetObjectDDLHandler struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+}
+
+func NewGetObjectDDLHandler(repo connection.Repository, crypto domain.Cryptographer, gateways connection.GatewayFactory) *GetObjectDDLHandler {
+	return &GetObjectDDLHandler{repo: repo, crypto: crypto, gateways: gateways}
+}
+
+func (h *GetObjectDDLHandler) Handle(ctx context.Context, query GetObjectDDL) (_ *connection.ObjectDDL, err error) {
+	ctx, span := tracing.Start(ctx, "GetObjectDDL", &query.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	conn, err := h.repo.FindByID(ctx, query.ConnectionID)
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		return nil, ErrConnectionNotFound
+	}
+
+	gateway, err := h.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := h.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return gateway.GetObjectDDL(timedCtx, *conn, password, query.DatabaseName, query.Kind, query.Schema, query.Name)
+}
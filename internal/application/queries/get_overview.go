@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
 	"github.com/felipemalacarne/mesa/internal/domain"
 	"github.com/felipemalacarne/mesa/internal/domain/connection"
 	"github.com/google/uuid"
@@ -20,7 +21,10 @@ func NewGetOverviewHandler(repo connection.Repository, crypto domain.Cryptograph
 	return &GetOverviewHandler{repo: repo, crypto: crypto, gateways: gateways}
 }
 
-func (h *GetOverviewHandler) Handle(ctx context.Context, connectionID uuid.UUID) (*connection.ServerHealth, int64, error) {
+func (h *GetOverviewHandler) Handle(ctx context.Context, connectionID uuid.UUID) (_ *connection.ServerHealth, _ int64, err error) {
+	ctx, span := tracing.Start(ctx, "GetOverview", &connectionID)
+	defer func() { tracing.End(span, err) }()
+
 	conn, err := h.repo.FindByID(ctx, connectionID)
 	if err != nil {
 		return nil, 0, err
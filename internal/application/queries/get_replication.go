@@ -0,0 +1,81 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/google/uuid"
+)
+
+// slotRetentionWarning é o volume de WAL retido a partir do qual um slot inativo é sinalizado.
+const slotRetentionWarning int64 = 1 << 30
+
+// ReplicationOverview junta o estado de recuperação do servidor, os clientes de replicação e os slots.
+type ReplicationOverview struct {
+	Status               connection.ReplicaStatus
+	Clients              []connection.ReplicationClient
+	Slots                []connection.ReplicationSlot
+	SlotRetentionWarning int64
+}
+
+type GetReplicationHandler struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+}
+
+func NewGetReplicationHandler(repo connection.Repository, crypto domain.Cryptographer, gateways connection.GatewayFactory) *GetReplicationHandler {
+	return &GetReplicationHandler{repo: repo, crypto: crypto, gateways: gateways}
+}
+
+func (h *GetReplicationHandler) Handle(ctx context.Context, connectionID uuid.UUID) (_ *ReplicationOverview, err error) {
+	ctx, span := tracing.Start(ctx, "GetReplication", &connectionID)
+	defer func() { tracing.End(span, err) }()
+
+	conn, err := h.repo.FindByID(ctx, connectionID)
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		return nil, ErrConnectionNotFound
+	}
+
+	gateway, err := h.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := h.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	status, err := gateway.GetReplicaStatus(timedCtx, *conn, password)
+	if err != nil {
+		return nil, err
+	}
+
+	// A standby may also stream to cascading replicas, so clients are listed on both sides.
+	clients, err := gateway.ListReplicationClients(timedCtx, *conn, password)
+	if err != nil {
+		return nil, err
+	}
+
+	slots, err := gateway.ListReplicationSlots(timedCtx, *conn, password)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReplicationOverview{
+		Status:               *status,
+		Clients:              clients,
+		Slots:                slots,
+		SlotRetentionWarning: slotRetentionWarning,
+	}, nil
+}
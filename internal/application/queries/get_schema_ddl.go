@@ -0,0 +1,56 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/google/uuid"
+)
+
+type GetSchemaDDL struct {
+	ConnectionID uuid.UUID
+	DatabaseName connection.Identifier
+	Schema       connection.Identifier
+}
+
+// GetSchemaDDLHandler gera o dump de DDL de todos os objetos de um schema.
+type GetSchemaDDLHandler struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+}
+
+func NewGetSchemaDDLHandler(repo connection.Repository, crypto domain.Cryptographer, gateways connection.GatewayFactory) *GetSchemaDDLHandler {
+	return &GetSchemaDDLHandler{repo: repo, crypto: crypto, gateways: gateways}
+}
+
+func (h *GetSchemaDDLHandler) Handle(ctx context.Context, query GetSchemaDDL) (_ *connection.SchemaDDL, err error) {
+	ctx, span := tracing.Start(ctx, "GetSchemaDDL", &query.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	conn, err := h.repo.FindByID(ctx, query.ConnectionID)
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		return nil, ErrConnectionNotFound
+	}
+
+	gateway, err := h.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := h.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	return gateway.GetSchemaDDL(timedCtx, *conn, password, query.DatabaseName, query.Schema)
+}
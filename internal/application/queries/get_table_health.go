@@ -0,0 +1,57 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/google/uuid"
+)
+
+type GetTableHealth struct {
+	ConnectionID uuid.UUID
+	DatabaseName connection.Identifier
+	Schema       connection.Identifier
+	TableName    connection.Identifier
+}
+
+// GetTableHealthHandler retorna as estatísticas de uso, manutenção e cache de uma tabela.
+type GetTableHealthHandler struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+}
+
+func NewGetTableHealthHandler(repo connection.Repository, crypto domain.Cryptographer, gateways connection.GatewayFactory) *GetTableHealthHandler {
+	return &GetTableHealthHandler{repo: repo, crypto: crypto, gateways: gateways}
+}
+
+func (h *GetTableHealthHandler) Handle(ctx context.Context, query GetTableHealth) (_ *connection.TableHealth, err error) {
+	ctx, span := tracing.Start(ctx, "GetTableHealth", &query.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	conn, err := h.repo.FindByID(ctx, query.ConnectionID)
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		return nil, ErrConnectionNotFound
+	}
+
+	gateway, err := h.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := h.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return gateway.GetTableHealth(timedCtx, *conn, password, query.DatabaseName, query.Schema, query.TableName)
+}
@@ -0,0 +1,23 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain/alert"
+)
+
+type ListAlertRulesHandler struct {
+	alerts alert.Repository
+}
+
+func NewListAlertRulesHandler(alerts alert.Repository) *ListAlertRulesHandler {
+	return &ListAlertRulesHandler{alerts: alerts}
+}
+
+func (h *ListAlertRulesHandler) Handle(ctx context.Context) (_ []*alert.Rule, err error) {
+	ctx, span := tracing.Start(ctx, "ListAlertRules", nil)
+	defer func() { tracing.End(span, err) }()
+
+	return h.alerts.ListRules(ctx)
+}
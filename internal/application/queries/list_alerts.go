@@ -0,0 +1,74 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain/alert"
+	"github.com/google/uuid"
+)
+
+// ListAlerts filtra o estado das regras; Statuses vazio devolve todos os estados.
+type ListAlerts struct {
+	ConnectionID *uuid.UUID
+	Statuses     []alert.Status
+}
+
+// AlertView junta o estado de uma regra em uma conexão com a definição da regra.
+type AlertView struct {
+	alert.State
+	Rule alert.Rule
+}
+
+type ListAlertsHandler struct {
+	alerts alert.Repository
+}
+
+func NewListAlertsHandler(alerts alert.Repository) *ListAlertsHandler {
+	return &ListAlertsHandler{alerts: alerts}
+}
+
+func (h *ListAlertsHandler) Handle(ctx context.Context, query ListAlerts) (_ []AlertView, err error) {
+	ctx, span := tracing.Start(ctx, "ListAlerts", query.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	rules, err := h.alerts.ListRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]*alert.Rule, len(rules))
+	for _, rule := range rules {
+		byID[rule.ID] = rule
+	}
+
+	states, err := h.alerts.ListStates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	views := make([]AlertView, 0, len(states))
+	for _, state := range states {
+		rule, ok := byID[state.RuleID]
+		if !ok {
+			continue
+		}
+		if query.ConnectionID != nil && state.ConnectionID != *query.ConnectionID {
+			continue
+		}
+		if len(query.Statuses) > 0 && !hasStatus(query.Statuses, state.Status) {
+			continue
+		}
+		views = append(views, AlertView{State: state, Rule: *rule})
+	}
+
+	return views, nil
+}
+
+func hasStatus(statuses []alert.Status, status alert.Status) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,35 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain/backup"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/google/uuid"
+)
+
+// ListBackupsHandler lista os backups concluídos de uma conexão, do mais recente ao mais antigo.
+type ListBackupsHandler struct {
+	repo    connection.Repository
+	backups backup.Store
+}
+
+func NewListBackupsHandler(repo connection.Repository, backups backup.Store) *ListBackupsHandler {
+	return &ListBackupsHandler{repo: repo, backups: backups}
+}
+
+func (h *ListBackupsHandler) Handle(ctx context.Context, connectionID uuid.UUID) (_ []backup.Backup, err error) {
+	ctx, span := tracing.Start(ctx, "ListBackups", &connectionID)
+	defer func() { tracing.End(span, err) }()
+
+	conn, err := h.repo.FindByID(ctx, connectionID)
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		return nil, ErrConnectionNotFound
+	}
+
+	return h.backups.List(ctx, connectionID)
+}
@@ -3,6 +3,7 @@ package queries
 import (
 	"context"
 
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
 	"github.com/felipemalacarne/mesa/internal/domain"
 	"github.com/felipemalacarne/mesa/internal/domain/connection"
 	"github.com/google/uuid"
@@ -28,7 +29,10 @@ func NewListColumnsHandler(
 	return &ListColumnsHandler{repo: repo, crypto: crypto, gateway: gateway}
 }
 
-func (h *ListColumnsHandler) Handle(ctx context.Context, query ListColumns) ([]connection.Column, error) {
+func (h *ListColumnsHandler) Handle(ctx context.Context, query ListColumns) (_ []connection.Column, err error) {
+	ctx, span := tracing.Start(ctx, "ListColumns", &query.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
 	conn, err := h.repo.FindByID(ctx, query.ConnectionID)
 	if err != nil {
 		return nil, err
@@ -47,9 +51,9 @@ func (h *ListColumnsHandler) Handle(ctx context.Context, query ListColumns) ([]c
 	if err != nil {
 		return nil, err
 	}
-	
+
 	columns, err := gateway.GetColumns(ctx, *conn, password, query.DatabaseName, query.TableName)
-	if err !=nil {
+	if err != nil {
 		return nil, err
 	}
 
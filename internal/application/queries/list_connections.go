@@ -3,21 +3,67 @@ package queries
 import (
 	"context"
 
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
 	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/google/uuid"
 )
 
-type ListConnections struct{}
+// ListConnections filtra as conexões; campos vazios não filtram.
+type ListConnections struct {
+	Environment string
+	Tag         string
+	// Search busca no nome, no host e na descrição, sem diferenciar maiúsculas.
+	Search string
+}
 
 type ListConnectionsHandler struct {
-	repo connection.Repository
+	repo   connection.Repository
+	health connection.HealthRepository
 }
 
-func NewListConnectionsHandler(repo connection.Repository) *ListConnectionsHandler {
+func NewListConnectionsHandler(repo connection.Repository, health connection.HealthRepository) *ListConnectionsHandler {
 	return &ListConnectionsHandler{
-		repo: repo,
+		repo:   repo,
+		health: health,
 	}
 }
 
-func (h *ListConnectionsHandler) Handle(ctx context.Context, query ListConnections) ([]*connection.Connection, error) {
-	return h.repo.ListAll(ctx)
+func (h *ListConnectionsHandler) Handle(ctx context.Context, query ListConnections) (_ []ConnectionView, err error) {
+	ctx, span := tracing.Start(ctx, "ListConnections", nil)
+	defer func() { tracing.End(span, err) }()
+
+	filter := connection.Filter{Tag: query.Tag, Search: query.Search}
+	if query.Environment != "" {
+		if filter.Environment, err = connection.NewEnvironment(query.Environment); err != nil {
+			return nil, err
+		}
+	}
+
+	conns, err := h.repo.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	checks, err := h.health.ListHealth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byConnection := make(map[uuid.UUID]connection.HealthCheck, len(checks))
+	for _, check := range checks {
+		byConnection[check.ConnectionID] = check
+	}
+
+	views := make([]ConnectionView, 0, len(conns))
+	for _, conn := range conns {
+		if !filter.Matches(conn) {
+			continue
+		}
+		view := ConnectionView{Connection: conn}
+		if check, ok := byConnection[conn.ID]; ok {
+			view.Health = &check
+		}
+		views = append(views, view)
+	}
+
+	return views, nil
 }
@@ -3,6 +3,7 @@ package queries
 import (
 	"context"
 
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
 	"github.com/felipemalacarne/mesa/internal/domain"
 	"github.com/felipemalacarne/mesa/internal/domain/connection"
 	"github.com/google/uuid"
@@ -22,7 +23,10 @@ func NewListDatabasesHandler(repo connection.Repository, crypto domain.Cryptogra
 	return &ListDatabasesHandler{repo: repo, crypto: crypto, gateways: gateways}
 }
 
-func (h *ListDatabasesHandler) Handle(ctx context.Context, query ListDatabases) ([]connection.Database, error) {
+func (h *ListDatabasesHandler) Handle(ctx context.Context, query ListDatabases) (_ []connection.Database, err error) {
+	ctx, span := tracing.Start(ctx, "ListDatabases", &query.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
 	conn, err := h.repo.FindByID(ctx, query.ConnectionID)
 	if err != nil {
 		return nil, err
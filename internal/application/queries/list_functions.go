@@ -0,0 +1,56 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/google/uuid"
+)
+
+type ListFunctions struct {
+	ConnectionID uuid.UUID
+	DatabaseName connection.Identifier
+	Schema       connection.Identifier
+}
+
+// ListFunctionsHandler lista funções e procedures de um schema.
+type ListFunctionsHandler struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+}
+
+func NewListFunctionsHandler(repo connection.Repository, crypto domain.Cryptographer, gateways connection.GatewayFactory) *ListFunctionsHandler {
+	return &ListFunctionsHandler{repo: repo, crypto: crypto, gateways: gateways}
+}
+
+func (h *ListFunctionsHandler) Handle(ctx context.Context, query ListFunctions) (_ []connection.Function, err error) {
+	ctx, span := tracing.Start(ctx, "ListFunctions", &query.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	conn, err := h.repo.FindByID(ctx, query.ConnectionID)
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		return nil, ErrConnectionNotFound
+	}
+
+	gateway, err := h.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := h.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return gateway.GetFunctions(timedCtx, *conn, password, query.DatabaseName, query.Schema)
+}
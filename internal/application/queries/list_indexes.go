@@ -3,6 +3,7 @@ package queries
 import (
 	"context"
 
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
 	"github.com/felipemalacarne/mesa/internal/domain"
 	"github.com/felipemalacarne/mesa/internal/domain/connection"
 	"github.com/google/uuid"
@@ -28,7 +29,10 @@ func NewListIndexesHandler(
 	return &ListIndexesHandler{repo: repo, crypto: crypto, gateway: gateway}
 }
 
-func (h *ListIndexesHandler) Handle(ctx context.Context, query ListIndexes) ([]connection.Index, error) {
+func (h *ListIndexesHandler) Handle(ctx context.Context, query ListIndexes) (_ []connection.Index, err error) {
+	ctx, span := tracing.Start(ctx, "ListIndexes", &query.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
 	conn, err := h.repo.FindByID(ctx, query.ConnectionID)
 	if err != nil {
 		return nil, err
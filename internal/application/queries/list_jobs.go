@@ -0,0 +1,25 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/felipemalacarne/mesa/internal/application/jobs"
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain/job"
+)
+
+// ListJobsHandler lista os jobs do mais recente ao mais antigo, opcionalmente de uma conexão ou status.
+type ListJobsHandler struct {
+	jobs *jobs.Pool
+}
+
+func NewListJobsHandler(pool *jobs.Pool) *ListJobsHandler {
+	return &ListJobsHandler{jobs: pool}
+}
+
+func (h *ListJobsHandler) Handle(ctx context.Context, filter job.Filter) (_ []job.Job, err error) {
+	ctx, span := tracing.Start(ctx, "ListJobs", filter.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	return h.jobs.List(ctx, filter)
+}
@@ -0,0 +1,56 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/google/uuid"
+)
+
+type ListMaterializedViews struct {
+	ConnectionID uuid.UUID
+	DatabaseName connection.Identifier
+	Schema       connection.Identifier
+}
+
+// ListMaterializedViewsHandler lista as materialized views de um schema com status de população e último refresh.
+type ListMaterializedViewsHandler struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+}
+
+func NewListMaterializedViewsHandler(repo connection.Repository, crypto domain.Cryptographer, gateways connection.GatewayFactory) *ListMaterializedViewsHandler {
+	return &ListMaterializedViewsHandler{repo: repo, crypto: crypto, gateways: gateways}
+}
+
+func (h *ListMaterializedViewsHandler) Handle(ctx context.Context, query ListMaterializedViews) (_ []connection.MaterializedView, err error) {
+	ctx, span := tracing.Start(ctx, "ListMaterializedViews", &query.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	conn, err := h.repo.FindByID(ctx, query.ConnectionID)
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		return nil, ErrConnectionNotFound
+	}
+
+	gateway, err := h.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := h.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return gateway.GetMaterializedViews(timedCtx, *conn, password, query.DatabaseName, query.Schema)
+}
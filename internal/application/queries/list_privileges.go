@@ -0,0 +1,56 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/google/uuid"
+)
+
+type ListPrivileges struct {
+	ConnectionID uuid.UUID
+	DatabaseName connection.Identifier
+	Filter       connection.PrivilegeFilter
+}
+
+// ListPrivilegesHandler decodifica as ACLs dos objetos de um banco e os default privileges.
+type ListPrivilegesHandler struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+}
+
+func NewListPrivilegesHandler(repo connection.Repository, crypto domain.Cryptographer, gateways connection.GatewayFactory) *ListPrivilegesHandler {
+	return &ListPrivilegesHandler{repo: repo, crypto: crypto, gateways: gateways}
+}
+
+func (h *ListPrivilegesHandler) Handle(ctx context.Context, query ListPrivileges) (_ *connection.DatabasePrivileges, err error) {
+	ctx, span := tracing.Start(ctx, "ListPrivileges", &query.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	conn, err := h.repo.FindByID(ctx, query.ConnectionID)
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		return nil, ErrConnectionNotFound
+	}
+
+	gateway, err := h.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := h.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return gateway.ListPrivileges(timedCtx, *conn, password, query.DatabaseName, query.Filter)
+}
@@ -0,0 +1,57 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/savedquery"
+	"github.com/google/uuid"
+)
+
+// ListSavedQueries filtra a biblioteca. Com ConnectionID, devolve só as consultas que podem
+// ser executadas na conexão; Search procura no nome, descrição e SQL.
+type ListSavedQueries struct {
+	ConnectionID *uuid.UUID
+	Tag          string
+	Search       string
+}
+
+type ListSavedQueriesHandler struct {
+	repo    connection.Repository
+	library savedquery.Repository
+}
+
+func NewListSavedQueriesHandler(repo connection.Repository, library savedquery.Repository) *ListSavedQueriesHandler {
+	return &ListSavedQueriesHandler{repo: repo, library: library}
+}
+
+func (h *ListSavedQueriesHandler) Handle(ctx context.Context, query ListSavedQueries) (_ []*savedquery.Query, err error) {
+	ctx, span := tracing.Start(ctx, "ListSavedQueries", query.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	filter := savedquery.Filter{Tag: query.Tag, Search: query.Search}
+	if query.ConnectionID != nil {
+		conn, err := h.repo.FindByID(ctx, *query.ConnectionID)
+		if err != nil {
+			return nil, err
+		}
+		if conn == nil {
+			return nil, ErrConnectionNotFound
+		}
+		filter.Connection = conn
+	}
+
+	all, err := h.library.ListQueries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*savedquery.Query, 0, len(all))
+	for _, q := range all {
+		if filter.Matches(q) {
+			result = append(result, q)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,56 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/google/uuid"
+)
+
+type ListSequences struct {
+	ConnectionID uuid.UUID
+	DatabaseName connection.Identifier
+	Schema       connection.Identifier
+}
+
+// ListSequencesHandler lista as sequences de um schema com o valor atual.
+type ListSequencesHandler struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+}
+
+func NewListSequencesHandler(repo connection.Repository, crypto domain.Cryptographer, gateways connection.GatewayFactory) *ListSequencesHandler {
+	return &ListSequencesHandler{repo: repo, crypto: crypto, gateways: gateways}
+}
+
+func (h *ListSequencesHandler) Handle(ctx context.Context, query ListSequences) (_ []connection.Sequence, err error) {
+	ctx, span := tracing.Start(ctx, "ListSequences", &query.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	conn, err := h.repo.FindByID(ctx, query.ConnectionID)
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		return nil, ErrConnectionNotFound
+	}
+
+	gateway, err := h.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := h.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return gateway.GetSequences(timedCtx, *conn, password, query.DatabaseName, query.Schema)
+}
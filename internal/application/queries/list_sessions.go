@@ -4,12 +4,20 @@ import (
 	"context"
 	"time"
 
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
 	"github.com/felipemalacarne/mesa/internal/domain"
 	"github.com/felipemalacarne/mesa/internal/domain/connection"
 	"github.com/google/uuid"
 )
 
-// ListSessionsHandler retorna a lista de sessões ativas para monitoramento em tempo real.
+type ListSessions struct {
+	ConnectionID uuid.UUID
+	Filter       connection.SessionFilter
+	Limit        int
+	Offset       int
+}
+
+// ListSessionsHandler retorna a lista de sessões para monitoramento em tempo real.
 type ListSessionsHandler struct {
 	repo     connection.Repository
 	crypto   domain.Cryptographer
@@ -20,8 +28,11 @@ func NewListSessionsHandler(repo connection.Repository, crypto domain.Cryptograp
 	return &ListSessionsHandler{repo: repo, crypto: crypto, gateways: gateways}
 }
 
-func (h *ListSessionsHandler) Handle(ctx context.Context, connectionID uuid.UUID) ([]connection.Session, error) {
-	conn, err := h.repo.FindByID(ctx, connectionID)
+func (h *ListSessionsHandler) Handle(ctx context.Context, query ListSessions) (_ *connection.SessionPage, err error) {
+	ctx, span := tracing.Start(ctx, "ListSessions", &query.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	conn, err := h.repo.FindByID(ctx, query.ConnectionID)
 	if err != nil {
 		return nil, err
 	}
@@ -42,5 +53,5 @@ func (h *ListSessionsHandler) Handle(ctx context.Context, connectionID uuid.UUID
 	timedCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	return gateway.ListSessions(timedCtx, *conn, password)
+	return gateway.ListSessions(timedCtx, *conn, password, query.Filter, query.Limit, query.Offset)
 }
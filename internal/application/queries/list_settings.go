@@ -0,0 +1,72 @@
+package queries
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/google/uuid"
+)
+
+// ListSettings filtra os parâmetros por nome ou descrição quando Search não é vazio.
+type ListSettings struct {
+	ConnectionID uuid.UUID
+	Search       string
+}
+
+// ListSettingsHandler lista o pg_settings agrupado por categoria.
+type ListSettingsHandler struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+}
+
+func NewListSettingsHandler(repo connection.Repository, crypto domain.Cryptographer, gateways connection.GatewayFactory) *ListSettingsHandler {
+	return &ListSettingsHandler{repo: repo, crypto: crypto, gateways: gateways}
+}
+
+func (h *ListSettingsHandler) Handle(ctx context.Context, query ListSettings) (_ []connection.SettingGroup, err error) {
+	ctx, span := tracing.Start(ctx, "ListSettings", &query.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	conn, err := h.repo.FindByID(ctx, query.ConnectionID)
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		return nil, ErrConnectionNotFound
+	}
+
+	gateway, err := h.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := h.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	settings, err := gateway.GetSettings(timedCtx, *conn, password)
+	if err != nil {
+		return nil, err
+	}
+
+	if search := strings.ToLower(strings.TrimSpace(query.Search)); search != "" {
+		filtered := settings[:0]
+		for _, s := range settings {
+			if strings.Contains(s.Name, search) || strings.Contains(strings.ToLower(s.Description), search) {
+				filtered = append(filtered, s)
+			}
+		}
+		settings = filtered
+	}
+
+	return connection.GroupSettings(settings), nil
+}
@@ -3,6 +3,7 @@ package queries
 import (
 	"context"
 
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
 	"github.com/felipemalacarne/mesa/internal/domain"
 	"github.com/felipemalacarne/mesa/internal/domain/connection"
 	"github.com/google/uuid"
@@ -23,7 +24,10 @@ func NewListTablesHandler(repo connection.Repository, crypto domain.Cryptographe
 	return &ListTablesHandler{repo: repo, crypto: crypto, gateways: gateways}
 }
 
-func (h *ListTablesHandler) Handle(ctx context.Context, query ListTables) ([]connection.Table, error) {
+func (h *ListTablesHandler) Handle(ctx context.Context, query ListTables) (_ []connection.Table, err error) {
+	ctx, span := tracing.Start(ctx, "ListTables", &query.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
 	conn, err := h.repo.FindByID(ctx, query.ConnectionID)
 	if err != nil {
 		return nil, err
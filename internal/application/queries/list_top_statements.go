@@ -0,0 +1,56 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/google/uuid"
+)
+
+type ListTopStatements struct {
+	ConnectionID uuid.UUID
+	OrderBy      connection.StatementOrder
+	Limit        int
+}
+
+// ListTopStatementsHandler lista as consultas normalizadas mais custosas segundo o pg_stat_statements.
+type ListTopStatementsHandler struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+}
+
+func NewListTopStatementsHandler(repo connection.Repository, crypto domain.Cryptographer, gateways connection.GatewayFactory) *ListTopStatementsHandler {
+	return &ListTopStatementsHandler{repo: repo, crypto: crypto, gateways: gateways}
+}
+
+func (h *ListTopStatementsHandler) Handle(ctx context.Context, query ListTopStatements) (_ []connection.StatementStats, err error) {
+	ctx, span := tracing.Start(ctx, "ListTopStatements", &query.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	conn, err := h.repo.FindByID(ctx, query.ConnectionID)
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		return nil, ErrConnectionNotFound
+	}
+
+	gateway, err := h.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := h.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return gateway.GetTopStatements(timedCtx, *conn, password, query.OrderBy, query.Limit)
+}
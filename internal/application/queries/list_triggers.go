@@ -0,0 +1,57 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/google/uuid"
+)
+
+type ListTriggers struct {
+	ConnectionID uuid.UUID
+	DatabaseName connection.Identifier
+	Schema       connection.Identifier
+	TableName    connection.Identifier
+}
+
+// ListTriggersHandler lista os triggers de uma tabela.
+type ListTriggersHandler struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+}
+
+func NewListTriggersHandler(repo connection.Repository, crypto domain.Cryptographer, gateways connection.GatewayFactory) *ListTriggersHandler {
+	return &ListTriggersHandler{repo: repo, crypto: crypto, gateways: gateways}
+}
+
+func (h *ListTriggersHandler) Handle(ctx context.Context, query ListTriggers) (_ []connection.Trigger, err error) {
+	ctx, span := tracing.Start(ctx, "ListTriggers", &query.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	conn, err := h.repo.FindByID(ctx, query.ConnectionID)
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		return nil, ErrConnectionNotFound
+	}
+
+	gateway, err := h.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := h.crypto.Decrypt(conn.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return gateway.GetTriggers(timedCtx, *conn, password, query.DatabaseName, query.Schema, query.TableName)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
 	"github.com/felipemalacarne/mesa/internal/domain"
 	"github.com/felipemalacarne/mesa/internal/domain/connection"
 	"github.com/google/uuid"
@@ -24,7 +25,10 @@ func NewListUsersHandler(repo connection.Repository, crypto domain.Cryptographer
 	return &ListUsersHandler{repo: repo, crypto: crypto, gateways: gateways}
 }
 
-func (h *ListUsersHandler) Handle(ctx context.Context, query ListUsers) ([]connection.DBUser, error) {
+func (h *ListUsersHandler) Handle(ctx context.Context, query ListUsers) (_ []connection.DBUser, err error) {
+	ctx, span := tracing.Start(ctx, "ListUsers", &query.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
 	conn, err := h.repo.FindByID(ctx, query.ConnectionID)
 	if err != nil {
 		return nil, err
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
 	"github.com/felipemalacarne/mesa/internal/domain"
 	"github.com/felipemalacarne/mesa/internal/domain/connection"
 	"github.com/google/uuid"
@@ -31,7 +32,10 @@ func NewPingConnectionHandler(
 	}
 }
 
-func (h *PingConnectionHandler) Handle(ctx context.Context, query PingConnection) error {
+func (h *PingConnectionHandler) Handle(ctx context.Context, query PingConnection) (err error) {
+	ctx, span := tracing.Start(ctx, "PingConnection", &query.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
 	conn, err := h.repo.FindByID(ctx, query.ConnectionID)
 	if err != nil {
 		return err
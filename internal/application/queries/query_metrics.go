@@ -0,0 +1,66 @@
+package queries
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/metric"
+	"github.com/google/uuid"
+)
+
+var ErrInvalidTimeRange = errors.New("from must be before to")
+
+// QueryMetrics pede a série de uma conexão; sem Resolution ela é escolhida pelo tamanho do intervalo.
+type QueryMetrics struct {
+	ConnectionID uuid.UUID
+	From         time.Time
+	To           time.Time
+	Resolution   *metric.Resolution
+}
+
+type MetricSeries struct {
+	Resolution metric.Resolution
+	Samples    []metric.Sample
+}
+
+// QueryMetricsHandler lê o histórico gravado pelo sampler em background.
+type QueryMetricsHandler struct {
+	repo    connection.Repository
+	samples metric.Repository
+}
+
+func NewQueryMetricsHandler(repo connection.Repository, samples metric.Repository) *QueryMetricsHandler {
+	return &QueryMetricsHandler{repo: repo, samples: samples}
+}
+
+func (h *QueryMetricsHandler) Handle(ctx context.Context, query QueryMetrics) (_ *MetricSeries, err error) {
+	ctx, span := tracing.Start(ctx, "QueryMetrics", &query.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	if !query.From.Before(query.To) {
+		return nil, ErrInvalidTimeRange
+	}
+
+	conn, err := h.repo.FindByID(ctx, query.ConnectionID)
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		return nil, ErrConnectionNotFound
+	}
+
+	resolution := metric.ResolutionFor(query.From, query.To)
+	if query.Resolution != nil {
+		resolution = *query.Resolution
+	}
+
+	samples, err := h.samples.Range(ctx, conn.ID, resolution, query.From, query.To)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MetricSeries{Resolution: resolution, Samples: samples}, nil
+}
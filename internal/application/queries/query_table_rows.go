@@ -3,8 +3,11 @@ package queries
 import (
 	"context"
 
+	"github.com/felipemalacarne/mesa/internal/application/recording"
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
 	"github.com/felipemalacarne/mesa/internal/domain"
 	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/history"
 	"github.com/google/uuid"
 )
 
@@ -22,17 +25,22 @@ type QueryTableRowsHandler struct {
 	repo    connection.Repository
 	crypto  domain.Cryptographer
 	gateway connection.GatewayFactory
+	history *recording.Recorder
 }
 
 func NewQueryTableRowsHandler(
 	repo connection.Repository,
 	crypto domain.Cryptographer,
 	gateway connection.GatewayFactory,
+	history *recording.Recorder,
 ) *QueryTableRowsHandler {
-	return &QueryTableRowsHandler{repo: repo, crypto: crypto, gateway: gateway}
+	return &QueryTableRowsHandler{repo: repo, crypto: crypto, gateway: gateway, history: history}
 }
 
-func (h *QueryTableRowsHandler) Handle(ctx context.Context, query QueryTableRows) (*connection.TableRows, error) {
+func (h *QueryTableRowsHandler) Handle(ctx context.Context, query QueryTableRows) (_ *connection.TableRows, err error) {
+	ctx, span := tracing.Start(ctx, "QueryTableRows", &query.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
 	conn, err := h.repo.FindByID(ctx, query.ConnectionID)
 	if err != nil {
 		return nil, err
@@ -52,6 +60,8 @@ func (h *QueryTableRowsHandler) Handle(ctx context.Context, query QueryTableRows
 		return nil, err
 	}
 
+	ctx = h.history.Track(ctx, conn.ID, query.DatabaseName.String(), history.SourceBrowse)
+
 	rows, err := gateway.QueryTableRows(ctx, *conn, password, query.DatabaseName, query.TableName, query.Limit, query.Offset, query.SortBy, query.SortOrder)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,24 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain/history"
+)
+
+// SearchHistoryHandler busca no histórico de comandos, do mais recente ao mais antigo.
+type SearchHistoryHandler struct {
+	history history.Repository
+}
+
+func NewSearchHistoryHandler(repo history.Repository) *SearchHistoryHandler {
+	return &SearchHistoryHandler{history: repo}
+}
+
+func (h *SearchHistoryHandler) Handle(ctx context.Context, filter history.Filter) (_ []history.Entry, err error) {
+	ctx, span := tracing.Start(ctx, "SearchHistory", filter.ConnectionID)
+	defer func() { tracing.End(span, err) }()
+
+	return h.history.ListEntries(ctx, filter)
+}
@@ -0,0 +1,23 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/felipemalacarne/mesa/internal/application/jobs"
+	"github.com/felipemalacarne/mesa/internal/domain/job"
+	"github.com/google/uuid"
+)
+
+// WatchJobHandler acompanha um job, chamando fn a cada mudança até o job terminar. Não abre um
+// span: a chamada dura enquanto o cliente acompanhar o job.
+type WatchJobHandler struct {
+	jobs *jobs.Pool
+}
+
+func NewWatchJobHandler(pool *jobs.Pool) *WatchJobHandler {
+	return &WatchJobHandler{jobs: pool}
+}
+
+func (h *WatchJobHandler) Handle(ctx context.Context, jobID uuid.UUID, fn func(job.Job) error) error {
+	return h.jobs.Watch(ctx, jobID, fn)
+}
@@ -0,0 +1,113 @@
+// Package recording keeps the query history: it collects the statements the gateways run on
+// behalf of the user and writes them to the history repository in the background.
+package recording
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/history"
+	"github.com/google/uuid"
+)
+
+const (
+	queueSize = 1000
+	// batchSize é quantas entradas são gravadas de uma vez.
+	batchSize     = 100
+	flushInterval = time.Second
+	pruneInterval = time.Hour
+)
+
+// Recorder grava o histórico sem atrasar as requisições: as entradas passam por uma fila e
+// são descartadas, com um aviso no log, quando ela está cheia.
+type Recorder struct {
+	repo       history.Repository
+	retention  time.Duration
+	maxEntries int
+	queue      chan history.Entry
+}
+
+// NewRecorder cria o gravador; o histórico guarda as entradas dos últimos retention e no
+// máximo maxEntries por conexão.
+func NewRecorder(repo history.Repository, retention time.Duration, maxEntries int) *Recorder {
+	return &Recorder{
+		repo:       repo,
+		retention:  retention,
+		maxEntries: maxEntries,
+		queue:      make(chan history.Entry, queueSize),
+	}
+}
+
+// Track devolve um contexto em que os comandos enviados pelo gateway entram no histórico da
+// conexão e do banco, marcados com a origem.
+func (r *Recorder) Track(ctx context.Context, connectionID uuid.UUID, database string, source history.Source) context.Context {
+	return connection.WithStatementListener(ctx, func(executed connection.ExecutedStatement) {
+		select {
+		case r.queue <- history.NewEntry(connectionID, database, source, executed):
+		default:
+			slog.WarnContext(ctx, "query history: queue is full, dropping entry", "source", source)
+		}
+	})
+}
+
+// Run grava as entradas enfileiradas e aplica a retenção até o contexto ser cancelado; as
+// entradas ainda na fila são gravadas antes de retornar.
+func (r *Recorder) Run(ctx context.Context) {
+	ctx = tracing.WithAttrs(ctx, slog.String(tracing.OperationKey, "RecordHistory"))
+
+	r.prune(ctx)
+
+	flush := time.NewTicker(flushInterval)
+	defer flush.Stop()
+	prune := time.NewTicker(pruneInterval)
+	defer prune.Stop()
+
+	batch := make([]history.Entry, 0, batchSize)
+	for {
+		select {
+		case <-ctx.Done():
+			for {
+				select {
+				case e := <-r.queue:
+					batch = append(batch, e)
+				default:
+					r.save(ctx, batch)
+					return
+				}
+			}
+		case e := <-r.queue:
+			batch = append(batch, e)
+			if len(batch) >= batchSize {
+				r.save(ctx, batch)
+				batch = batch[:0]
+			}
+		case <-flush.C:
+			r.save(ctx, batch)
+			batch = batch[:0]
+		case <-prune.C:
+			r.prune(ctx)
+		}
+	}
+}
+
+// save ignora o cancelamento do contexto para não perder as entradas durante o encerramento.
+func (r *Recorder) save(ctx context.Context, batch []history.Entry) {
+	if len(batch) == 0 {
+		return
+	}
+	if err := r.repo.SaveEntries(context.WithoutCancel(ctx), batch); err != nil {
+		slog.WarnContext(ctx, "query history: saving entries", "count", len(batch), "error", err)
+	}
+}
+
+func (r *Recorder) prune(ctx context.Context) {
+	if err := r.repo.DeleteEntriesBefore(ctx, time.Now().UTC().Add(-r.retention)); err != nil {
+		slog.WarnContext(ctx, "query history: deleting expired entries", "error", err)
+	}
+	if err := r.repo.TrimEntries(ctx, r.maxEntries); err != nil {
+		slog.WarnContext(ctx, "query history: trimming entries", "error", err)
+	}
+}
@@ -0,0 +1,123 @@
+// Package tracing correlates logs and OpenTelemetry spans across the REST, application and
+// gateway layers. Request, connection and operation identifiers are carried in the context
+// and added to every log record written with a *Context slog call.
+package tracing
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/felipemalacarne/mesa"
+
+// Attribute keys shared by log records and spans.
+const (
+	RequestIDKey    = "request_id"
+	ConnectionIDKey = "connection_id"
+	OperationKey    = "operation"
+)
+
+type attrsKey struct{}
+
+// Tracer returns the tracer used by Mesa's own instrumentation.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// WithAttrs returns a context whose log records also carry attrs. Later values of the same
+// key replace earlier ones.
+func WithAttrs(ctx context.Context, attrs ...slog.Attr) context.Context {
+	current := attrsFrom(ctx)
+	merged := make([]slog.Attr, 0, len(current)+len(attrs))
+	for _, attr := range current {
+		if !containsKey(attrs, attr.Key) {
+			merged = append(merged, attr)
+		}
+	}
+	merged = append(merged, attrs...)
+	return context.WithValue(ctx, attrsKey{}, merged)
+}
+
+// WithRequestID tags the context with the ID of the API request being served.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return WithAttrs(ctx, slog.String(RequestIDKey, requestID))
+}
+
+// WithConnectionID tags the context and its current span with the connection being used.
+func WithConnectionID(ctx context.Context, connectionID uuid.UUID) context.Context {
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String("mesa."+ConnectionIDKey, connectionID.String()))
+	return WithAttrs(ctx, slog.String(ConnectionIDKey, connectionID.String()))
+}
+
+// Start opens the span of an application handler and tags the logs written under it with the
+// operation name. The span must be closed with End.
+func Start(ctx context.Context, operation string, connectionID *uuid.UUID) (context.Context, trace.Span) {
+	ctx, span := Tracer().Start(ctx, operation, trace.WithAttributes(attribute.String("mesa."+OperationKey, operation)))
+	ctx = WithAttrs(ctx, slog.String(OperationKey, operation))
+	if connectionID != nil {
+		ctx = WithConnectionID(ctx, *connectionID)
+	}
+	return ctx, span
+}
+
+// End records err, if any, on the span and closes it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func attrsFrom(ctx context.Context) []slog.Attr {
+	attrs, _ := ctx.Value(attrsKey{}).([]slog.Attr)
+	return attrs
+}
+
+func containsKey(attrs []slog.Attr, key string) bool {
+	for _, attr := range attrs {
+		if attr.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// LogHandler decorates a slog.Handler adding the context attributes and the active trace and
+// span IDs to each record.
+type LogHandler struct {
+	next slog.Handler
+}
+
+func NewLogHandler(next slog.Handler) *LogHandler {
+	return &LogHandler{next: next}
+}
+
+func (h *LogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *LogHandler) Handle(ctx context.Context, record slog.Record) error {
+	record.AddAttrs(attrsFrom(ctx)...)
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		record.AddAttrs(
+			slog.String("trace_id", sc.TraceID().String()),
+			slog.String("span_id", sc.SpanID().String()),
+		)
+	}
+	return h.next.Handle(ctx, record)
+}
+
+func (h *LogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &LogHandler{next: h.next.WithAttrs(attrs)}
+}
+
+func (h *LogHandler) WithGroup(name string) slog.Handler {
+	return &LogHandler{next: h.next.WithGroup(name)}
+}
@@ -1,21 +1,57 @@
 // Package config provides configuration loading functionality for the application.
 package config
 
-import "os"
+import (
+	"log/slog"
+	"os"
+	"strconv"
+	"time"
+)
 
 type Config struct {
-	AppKey      string
-	DatabaseURL string
-	DBDriver    string
-	Port        string
+	AppKey          string
+	DatabaseURL     string
+	DBDriver        string
+	Port            string
+	MetricsInterval time.Duration
+	AlertsInterval  time.Duration
+	HealthInterval  time.Duration
+	// MetricsConnectionGauges makes /metrics ping every saved connection on each scrape.
+	MetricsConnectionGauges bool
+	LogFormat               string
+	LogLevel                string
+	// OTLPEndpoint receives the traces over OTLP/HTTP, ex: http://localhost:4318. Empty disables export.
+	OTLPEndpoint string
+	// BackupDir is where backup archives are written, one subdirectory per connection.
+	BackupDir string
+	// JobWorkers is how many background jobs run at the same time.
+	JobWorkers int
+	// JobRetention is how long finished jobs are kept in the metadata store.
+	JobRetention time.Duration
+	// HistoryRetention is how long executed statements are kept in the query history.
+	HistoryRetention time.Duration
+	// HistoryMaxEntries caps the query history of each connection; older entries go first.
+	HistoryMaxEntries int
 }
 
 func Load() Config {
 	return Config{
-		AppKey:      getEnv("APP_KEY", "default_app_key_please_change_me"),
-		DatabaseURL: getEnv("DATABASE_URL", "./mesa.db"),
-		DBDriver:    getEnv("DB_DRIVER", "sqlite"),
-		Port:        getEnv("PORT", "8080"),
+		AppKey:                  getEnv("APP_KEY", "default_app_key_please_change_me"),
+		DatabaseURL:             getEnv("DATABASE_URL", "./mesa.db"),
+		DBDriver:                getEnv("DB_DRIVER", "sqlite"),
+		Port:                    getEnv("PORT", "8080"),
+		MetricsInterval:         getDurationEnv("METRICS_INTERVAL", 30*time.Second),
+		AlertsInterval:          getDurationEnv("ALERTS_INTERVAL", time.Minute),
+		HealthInterval:          getDurationEnv("HEALTH_CHECK_INTERVAL", 30*time.Second),
+		MetricsConnectionGauges: getBoolEnv("METRICS_CONNECTION_GAUGES", false),
+		LogFormat:               getEnv("LOG_FORMAT", "text"),
+		LogLevel:                getEnv("LOG_LEVEL", "info"),
+		OTLPEndpoint:            getEnv("OTEL_EXPORTER_OTLP_ENDPOINT", ""),
+		BackupDir:               getEnv("BACKUP_DIR", "./backups"),
+		JobWorkers:              getIntEnv("JOB_WORKERS", 4),
+		JobRetention:            getDurationEnv("JOB_RETENTION", 7*24*time.Hour),
+		HistoryRetention:        getDurationEnv("HISTORY_RETENTION", 30*24*time.Hour),
+		HistoryMaxEntries:       getIntEnv("HISTORY_MAX_ENTRIES", 10000),
 	}
 }
 
@@ -25,3 +61,42 @@ func getEnv(key, def string) string {
 	}
 	return def
 }
+
+func getDurationEnv(key string, def time.Duration) time.Duration {
+	val := getEnv(key, "")
+	if val == "" {
+		return def
+	}
+	d, err := time.ParseDuration(val)
+	if err != nil || d <= 0 {
+		slog.Warn("invalid duration setting, using default", "key", key, "value", val, "default", def)
+		return def
+	}
+	return d
+}
+
+func getIntEnv(key string, def int) int {
+	val := getEnv(key, "")
+	if val == "" {
+		return def
+	}
+	n, err := strconv.Atoi(val)
+	if err != nil || n <= 0 {
+		slog.Warn("invalid integer setting, using default", "key", key, "value", val, "default", def)
+		return def
+	}
+	return n
+}
+
+func getBoolEnv(key string, def bool) bool {
+	val := getEnv(key, "")
+	if val == "" {
+		return def
+	}
+	b, err := strconv.ParseBool(val)
+	if err != nil {
+		slog.Warn("invalid boolean setting, using default", "key", key, "value", val, "default", def)
+		return def
+	}
+	return b
+}
@@ -0,0 +1,246 @@
+// Package alert models user-defined alert rules evaluated against each connection.
+package alert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidCondition = errors.New("supported conditions are: connection_usage, long_running_query, unreachable")
+	ErrInvalidChannel   = errors.New("supported channels are: webhook, slack")
+	ErrInvalidThreshold = errors.New("threshold is out of range for this condition")
+	ErrInvalidTarget    = errors.New("target must be an absolute http(s) URL")
+	ErrEmptyRuleName    = errors.New("rule name must not be empty")
+)
+
+// Condition is what a rule watches on a connection.
+type Condition string
+
+const (
+	// ConditionConnectionUsage fires when sessions exceed Threshold percent of max_connections.
+	ConditionConnectionUsage Condition = "connection_usage"
+	// ConditionLongRunningQuery fires when an active query runs for more than Threshold seconds.
+	ConditionLongRunningQuery Condition = "long_running_query"
+	// ConditionUnreachable fires when the connection cannot be reached. Threshold is unused.
+	ConditionUnreachable Condition = "unreachable"
+)
+
+func NewCondition(condition string) (Condition, error) {
+	c := Condition(strings.ToLower(strings.TrimSpace(condition)))
+	switch c {
+	case ConditionConnectionUsage, ConditionLongRunningQuery, ConditionUnreachable:
+		return c, nil
+	}
+	return "", ErrInvalidCondition
+}
+
+// Channel is the notifier backend a rule delivers to.
+type Channel string
+
+const (
+	ChannelWebhook Channel = "webhook"
+	ChannelSlack   Channel = "slack"
+)
+
+func NewChannel(channel string) (Channel, error) {
+	c := Channel(strings.ToLower(strings.TrimSpace(channel)))
+	switch c {
+	case ChannelWebhook, ChannelSlack:
+		return c, nil
+	}
+	return "", ErrInvalidChannel
+}
+
+// Rule describes when to alert and where to send the notification. ConnectionID nil applies the
+// rule to every connection. ConsecutiveChecks is how many evaluations in a row must breach
+// before the alert fires, ex: "unreachable for 3 checks".
+type Rule struct {
+	ID                uuid.UUID
+	Name              string
+	ConnectionID      *uuid.UUID
+	Condition         Condition
+	Threshold         float64
+	ConsecutiveChecks int
+	Channel           Channel
+	Target            string
+	Enabled           bool
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+func NewRule(name string, connectionID *uuid.UUID, condition Condition, threshold float64, consecutiveChecks int, channel Channel, target string, enabled bool) (*Rule, error) {
+	now := time.Now()
+	rule := &Rule{
+		ID:        uuid.New(),
+		CreatedAt: now,
+	}
+	if err := rule.Update(name, connectionID, condition, threshold, consecutiveChecks, channel, target, enabled); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// Update validates and replaces the definition of the rule, keeping its identity.
+func (r *Rule) Update(name string, connectionID *uuid.UUID, condition Condition, threshold float64, consecutiveChecks int, channel Channel, target string, enabled bool) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ErrEmptyRuleName
+	}
+
+	switch condition {
+	case ConditionConnectionUsage:
+		if threshold <= 0 || threshold > 100 {
+			return fmt.Errorf("%w: connection_usage expects a percentage in (0, 100]", ErrInvalidThreshold)
+		}
+	case ConditionLongRunningQuery:
+		if threshold <= 0 {
+			return fmt.Errorf("%w: long_running_query expects a positive number of seconds", ErrInvalidThreshold)
+		}
+	case ConditionUnreachable:
+		threshold = 0
+	default:
+		return ErrInvalidCondition
+	}
+
+	if consecutiveChecks < 1 {
+		consecutiveChecks = 1
+	}
+
+	if channel != ChannelWebhook && channel != ChannelSlack {
+		return ErrInvalidChannel
+	}
+
+	u, err := url.Parse(strings.TrimSpace(target))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrInvalidTarget
+	}
+
+	r.Name = name
+	r.ConnectionID = connectionID
+	r.Condition = condition
+	r.Threshold = threshold
+	r.ConsecutiveChecks = consecutiveChecks
+	r.Channel = channel
+	r.Target = u.String()
+	r.Enabled = enabled
+	r.UpdatedAt = time.Now()
+	return nil
+}
+
+// AppliesTo reports whether the rule watches the given connection.
+func (r Rule) AppliesTo(connectionID uuid.UUID) bool {
+	return r.ConnectionID == nil || *r.ConnectionID == connectionID
+}
+
+// Breached reports whether an observed value crosses the rule threshold. For unreachable
+// rules the value is 1 when the check failed.
+func (r Rule) Breached(value float64) bool {
+	if r.Condition == ConditionUnreachable {
+		return value > 0
+	}
+	return value > r.Threshold
+}
+
+// Status is the lifecycle of an alert for one rule and connection.
+type Status string
+
+const (
+	StatusOK      Status = "ok"
+	StatusPending Status = "pending" // breaching, but not for enough consecutive checks yet
+	StatusFiring  Status = "firing"
+)
+
+// State is the persisted evaluation state of a rule on a connection. Keeping it across
+// restarts is what prevents the same incident from being notified twice.
+type State struct {
+	RuleID              uuid.UUID
+	ConnectionID        uuid.UUID
+	Status              Status
+	ConsecutiveBreaches int
+	Value               float64
+	Message             string
+	FiredAt             *time.Time
+	ResolvedAt          *time.Time
+	EvaluatedAt         time.Time
+}
+
+// NewState returns the initial state of a rule on a connection.
+func NewState(ruleID, connectionID uuid.UUID) State {
+	return State{RuleID: ruleID, ConnectionID: connectionID, Status: StatusOK}
+}
+
+// EventKind tells whether a notification opens or closes an incident.
+type EventKind string
+
+const (
+	EventFiring   EventKind = "firing"
+	EventResolved EventKind = "resolved"
+)
+
+// Event is what notifiers deliver.
+type Event struct {
+	Kind           EventKind
+	Rule           Rule
+	ConnectionID   uuid.UUID
+	ConnectionName string
+	Value          float64
+	Message        string
+	FiredAt        time.Time
+	At             time.Time
+}
+
+// Observe records an evaluation and reports the event to notify, if any. Only the transitions
+// into and out of firing produce events, so a long incident is notified exactly twice.
+func (s *State) Observe(rule Rule, value float64, message string, now time.Time) (EventKind, bool) {
+	s.Value = value
+	s.Message = message
+	s.EvaluatedAt = now
+
+	if !rule.Breached(value) {
+		wasFiring := s.Status == StatusFiring
+		s.ConsecutiveBreaches = 0
+		s.Status = StatusOK
+		if !wasFiring {
+			return "", false
+		}
+		s.ResolvedAt = &now
+		return EventResolved, true
+	}
+
+	s.ConsecutiveBreaches++
+	if s.Status == StatusFiring {
+		return "", false
+	}
+	if s.ConsecutiveBreaches < rule.ConsecutiveChecks {
+		s.Status = StatusPending
+		return "", false
+	}
+
+	s.Status = StatusFiring
+	s.FiredAt = &now
+	s.ResolvedAt = nil
+	return EventFiring, true
+}
+
+// Notifier delivers events to the channel configured on the rule.
+type Notifier interface {
+	Notify(ctx context.Context, event Event) error
+}
+
+type Repository interface {
+	SaveRule(ctx context.Context, rule *Rule) error
+	// FindRule returns nil when the rule does not exist.
+	FindRule(ctx context.Context, id uuid.UUID) (*Rule, error)
+	ListRules(ctx context.Context) ([]*Rule, error)
+	DeleteRule(ctx context.Context, id uuid.UUID) error
+
+	SaveState(ctx context.Context, state State) error
+	ListStates(ctx context.Context) ([]State, error)
+}
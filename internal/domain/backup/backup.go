@@ -0,0 +1,72 @@
+// Package backup models the logical backups taken from a connection's databases.
+package backup
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrBackupNotFound   = errors.New("backup not found")
+	ErrChecksumMismatch = errors.New("backup archive does not match its checksum")
+)
+
+// Backup is a finished archive holding the dump of a database or of some of its tables.
+type Backup struct {
+	ID           uuid.UUID
+	ConnectionID uuid.UUID
+	Database     string
+	// Tables lists the dumped tables as "schema.table"; empty for a whole-database backup.
+	Tables []string
+	// Size is the size of the compressed archive in bytes.
+	Size int64
+	// Checksum is the hex-encoded SHA-256 of the archive, verified before restoring.
+	Checksum  string
+	CreatedAt time.Time
+}
+
+func New(connectionID uuid.UUID, database string, scope connection.DumpScope) Backup {
+	tables := make([]string, len(scope.Tables))
+	for i, table := range scope.Tables {
+		tables[i] = table.String()
+	}
+
+	return Backup{
+		ID:           uuid.New(),
+		ConnectionID: connectionID,
+		Database:     database,
+		Tables:       tables,
+		CreatedAt:    time.Now().UTC(),
+	}
+}
+
+// Archive is a backup being written. It only becomes visible in the Store once committed.
+type Archive interface {
+	connection.DumpWriter
+	// Commit finishes the archive and returns the backup with its size and checksum.
+	Commit() (*Backup, error)
+	// Abort discards the partial archive.
+	Abort() error
+}
+
+// Reader reads the dump of a stored backup.
+type Reader interface {
+	connection.DumpReader
+	Close() error
+}
+
+// Store keeps the backup archives of every connection.
+type Store interface {
+	Create(ctx context.Context, b Backup) (Archive, error)
+	// Find returns nil when the backup does not exist.
+	Find(ctx context.Context, connectionID, id uuid.UUID) (*Backup, error)
+	// Open verifies the checksum of the archive and opens it for reading.
+	Open(ctx context.Context, connectionID, id uuid.UUID) (Reader, error)
+	// List returns the backups of a connection, most recent first.
+	List(ctx context.Context, connectionID uuid.UUID) ([]Backup, error)
+	Delete(ctx context.Context, connectionID, id uuid.UUID) error
+}
@@ -15,13 +15,24 @@ var (
 )
 
 type Connection struct {
-	ID        uuid.UUID
-	Name      string
-	Driver    Driver
-	Host      string
-	Port      int
-	Username  string
-	Password  string // Já deve chegar aqui criptografada pela camada de application
+	ID       uuid.UUID
+	Name     string
+	Driver   Driver
+	Host     string
+	Port     int
+	Username string
+	Password string // Já deve chegar aqui criptografada pela camada de application
+	// Environment, Tags, Color e Description identificam a conexão na interface.
+	Environment Environment
+	Tags        []string
+	Color       string
+	Description string
+	Protection  Protection
+	// ReadOnly faz o gateway abrir as sessões em modo somente leitura e a application
+	// recusar os comandos que alteram o servidor.
+	ReadOnly bool
+	// Tunnel, quando presente, é o bastion SSH pelo qual as sessões são abertas.
+	Tunnel    *SSHTunnel
 	UpdatedAt time.Time
 	CreatedAt time.Time
 }
@@ -43,14 +54,17 @@ func NewConnection(name, driver, host string, port int, user, encryptedPass stri
 	}
 
 	return &Connection{
-		ID:        uuid,
-		Name:      strings.TrimSpace(name),
-		Driver:    *validatedDriver,
-		Host:      host,
-		Port:      port,
-		Username:  user,
-		Password:  encryptedPass,
-		UpdatedAt: time.Now(),
-		CreatedAt: time.Now(),
+		ID:          uuid,
+		Name:        strings.TrimSpace(name),
+		Driver:      *validatedDriver,
+		Host:        host,
+		Port:        port,
+		Username:    user,
+		Password:    encryptedPass,
+		Environment: EnvironmentDevelopment,
+		Tags:        []string{},
+		Protection:  ProtectionNone,
+		UpdatedAt:   time.Now(),
+		CreatedAt:   time.Now(),
 	}, nil
 }
@@ -0,0 +1,54 @@
+package connection
+
+import (
+	"errors"
+	"regexp"
+)
+
+var (
+	ErrInvalidEncoding   = errors.New("invalid encoding name")
+	ErrInvalidLocale     = errors.New("invalid locale name")
+	ErrConflictingLocale = errors.New("locale cannot be combined with lc_collate or lc_ctype")
+	ErrDatabaseInUse     = errors.New("database is being accessed by other sessions")
+	ErrDatabaseExists    = errors.New("database already exists")
+)
+
+var (
+	encodingRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+	// localeRegex accepts names such as "C", "pt_BR.UTF-8" and "sr_RS@latin".
+	localeRegex = regexp.MustCompile(`^[A-Za-z0-9_.@-]+$`)
+)
+
+// DatabaseOptions are the CREATE DATABASE options. Empty strings and nil pointers keep the
+// server defaults, which come from the template (template1 unless Template is set).
+//
+// PostgreSQL only accepts an encoding or locale different from the template's when the
+// template is template0.
+type DatabaseOptions struct {
+	Owner      Identifier
+	Template   *Identifier
+	Encoding   string
+	Locale     string
+	LcCollate  string
+	LcCtype    string
+	Tablespace *Identifier
+	ConnLimit  *int
+}
+
+func (o DatabaseOptions) Validate() error {
+	if o.Encoding != "" && !encodingRegex.MatchString(o.Encoding) {
+		return ErrInvalidEncoding
+	}
+	for _, locale := range []string{o.Locale, o.LcCollate, o.LcCtype} {
+		if locale != "" && !localeRegex.MatchString(locale) {
+			return ErrInvalidLocale
+		}
+	}
+	if o.Locale != "" && (o.LcCollate != "" || o.LcCtype != "") {
+		return ErrConflictingLocale
+	}
+	if o.ConnLimit != nil && *o.ConnLimit < -1 {
+		return ErrInvalidConnLimit
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package connection
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrInvalidObjectKind = errors.New("supported object kinds are: table, view, materialized_view, index, function, trigger")
+
+// ObjectKind identifies the kind of schema object whose definition can be extracted.
+type ObjectKind string
+
+const (
+	ObjectKindTable            ObjectKind = "table"
+	ObjectKindView             ObjectKind = "view"
+	ObjectKindMaterializedView ObjectKind = "materialized_view"
+	ObjectKindIndex            ObjectKind = "index"
+	ObjectKindFunction         ObjectKind = "function"
+	ObjectKindTrigger          ObjectKind = "trigger"
+)
+
+func NewObjectKind(kind string) (ObjectKind, error) {
+	k := ObjectKind(strings.ToLower(strings.TrimSpace(kind)))
+	if !k.IsValid() {
+		return "", ErrInvalidObjectKind
+	}
+
+	return k, nil
+}
+
+func (k ObjectKind) IsValid() bool {
+	switch k {
+	case ObjectKindTable, ObjectKindView, ObjectKindMaterializedView, ObjectKindIndex, ObjectKindFunction, ObjectKindTrigger:
+		return true
+	}
+	return false
+}
+
+func (k ObjectKind) String() string {
+	return string(k)
+}
+
+// ObjectDDL holds the reconstructed CREATE statement(s) of a single schema object.
+type ObjectDDL struct {
+	Kind       ObjectKind
+	Schema     string
+	Name       string
+	Definition string
+}
+
+// SchemaDDL holds the DDL dump of every supported object inside a schema.
+type SchemaDDL struct {
+	Schema     string
+	Definition string
+}
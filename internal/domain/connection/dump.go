@@ -0,0 +1,94 @@
+package connection
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+var (
+	ErrInvalidTableRef = errors.New("table must be written as schema.table or table")
+	ErrDumpConflict    = errors.New("the database already has objects of the dump")
+)
+
+// TableRef names a table inside a schema.
+type TableRef struct {
+	Schema Identifier
+	Name   Identifier
+}
+
+// ParseTableRef reads "schema.table", or "table" for a table in the public schema.
+func ParseTableRef(value string) (TableRef, error) {
+	schema, name, found := strings.Cut(value, ".")
+	if !found {
+		schema, name = "public", value
+	}
+
+	schemaID, err := NewIdentifier(schema)
+	if err != nil {
+		return TableRef{}, fmt.Errorf("%w: %q", ErrInvalidTableRef, value)
+	}
+	nameID, err := NewIdentifier(name)
+	if err != nil {
+		return TableRef{}, fmt.Errorf("%w: %q", ErrInvalidTableRef, value)
+	}
+
+	return TableRef{Schema: schemaID, Name: nameID}, nil
+}
+
+func (t TableRef) String() string {
+	return t.Schema.String() + "." + t.Name.String()
+}
+
+// DumpSection is a SQL script of a logical dump. Restoring runs clean (when asked), pre-data,
+// the table data and then post-data, so that loading the rows is not slowed down by indexes
+// or blocked by foreign keys.
+type DumpSection string
+
+const (
+	// DumpClean drops the objects the dump recreates.
+	DumpClean DumpSection = "clean"
+	// DumpPreData creates schemas, extensions, types, sequences, functions and tables.
+	DumpPreData DumpSection = "pre-data"
+	// DumpPostData creates indexes, foreign keys, views and triggers, and restores sequence values.
+	DumpPostData DumpSection = "post-data"
+)
+
+var DumpSections = []DumpSection{DumpClean, DumpPreData, DumpPostData}
+
+// DumpTable describes the rows of a table in a dump, stored in the COPY text format with the
+// columns in the listed order. Generated columns are left out since the server computes them.
+type DumpTable struct {
+	Schema  string
+	Name    string
+	Columns []string
+}
+
+// DumpScope selects what a dump contains. Without tables the whole database is dumped;
+// otherwise only the given tables, with the types, sequences and trigger functions they need.
+type DumpScope struct {
+	Tables []TableRef
+}
+
+// DumpWriter receives a dump as it is produced.
+type DumpWriter interface {
+	WriteSection(section DumpSection, script string) error
+	// WriteTable starts the data of a table; the returned writer is valid until the next call.
+	WriteTable(table DumpTable) (io.Writer, error)
+}
+
+// DumpReader gives access to a dump being restored.
+type DumpReader interface {
+	Section(section DumpSection) (string, error)
+	Tables() []DumpTable
+	// OpenTable opens the data of the i-th table of Tables.
+	OpenTable(i int) (io.ReadCloser, error)
+}
+
+// RestoreOptions controls how a dump is loaded into a database.
+type RestoreOptions struct {
+	// Clean drops the objects of the dump before recreating them, for restoring over an
+	// existing copy. Objects depending on them, such as views, are dropped as well.
+	Clean bool
+}
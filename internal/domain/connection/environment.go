@@ -0,0 +1,153 @@
+package connection
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+var (
+	ErrInvalidEnvironment = errors.New("supported environments are: production, staging, development")
+	ErrInvalidProtection  = errors.New("supported protection levels are: none, confirm")
+	ErrInvalidColor       = errors.New("color must be a hex value such as #1f6feb")
+)
+
+var hexColor = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+type Environment string
+
+const (
+	EnvironmentProduction  Environment = "production"
+	EnvironmentStaging     Environment = "staging"
+	EnvironmentDevelopment Environment = "development"
+)
+
+// NewEnvironment validates the environment; an empty value means development.
+func NewEnvironment(environment string) (Environment, error) {
+	e := Environment(strings.ToLower(strings.TrimSpace(environment)))
+	switch e {
+	case "":
+		return EnvironmentDevelopment, nil
+	case EnvironmentProduction, EnvironmentStaging, EnvironmentDevelopment:
+		return e, nil
+	}
+	return "", ErrInvalidEnvironment
+}
+
+// Protection says how carefully the commands that change the server must be issued.
+type Protection string
+
+const (
+	ProtectionNone Protection = "none"
+	// ProtectionConfirm requires every mutating command to be confirmed with the connection name.
+	ProtectionConfirm Protection = "confirm"
+)
+
+func NewProtection(protection string) (Protection, error) {
+	p := Protection(strings.ToLower(strings.TrimSpace(protection)))
+	switch p {
+	case ProtectionNone, ProtectionConfirm:
+		return p, nil
+	}
+	return "", ErrInvalidProtection
+}
+
+// DefaultProtection is the protection of a connection that does not choose one: production
+// connections require confirmations.
+func (e Environment) DefaultProtection() Protection {
+	if e == EnvironmentProduction {
+		return ProtectionConfirm
+	}
+	return ProtectionNone
+}
+
+// Profile describes the connection to the people using it. A nil Protection applies the
+// default of the environment.
+type Profile struct {
+	Environment string
+	Tags        []string
+	Color       string
+	Description string
+	Protection  *string
+}
+
+// SetProfile validates and applies the profile; tags are lowercased and deduplicated.
+func (c *Connection) SetProfile(p Profile) error {
+	environment, err := NewEnvironment(p.Environment)
+	if err != nil {
+		return err
+	}
+
+	protection := environment.DefaultProtection()
+	if p.Protection != nil {
+		if protection, err = NewProtection(*p.Protection); err != nil {
+			return err
+		}
+	}
+
+	color := strings.TrimSpace(p.Color)
+	if color != "" && !hexColor.MatchString(color) {
+		return ErrInvalidColor
+	}
+
+	c.Environment = environment
+	c.Tags = normalizeTags(p.Tags)
+	c.Color = strings.ToLower(color)
+	c.Description = strings.TrimSpace(p.Description)
+	c.Protection = protection
+	return nil
+}
+
+// RequiresConfirmation reports whether mutating commands must be confirmed with the name.
+func (c Connection) RequiresConfirmation() bool {
+	return c.Protection == ProtectionConfirm
+}
+
+func normalizeTags(tags []string) []string {
+	seen := make(map[string]bool, len(tags))
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		result = append(result, tag)
+	}
+	return result
+}
+
+// Filter narrows the list of connections. Zero values match everything.
+type Filter struct {
+	Environment Environment
+	Tag         string
+	// Search matches the name, host or description, ignoring case.
+	Search string
+}
+
+func (f Filter) Matches(c *Connection) bool {
+	if f.Environment != "" && c.Environment != f.Environment {
+		return false
+	}
+
+	if tag := strings.ToLower(strings.TrimSpace(f.Tag)); tag != "" {
+		tagged := false
+		for _, t := range c.Tags {
+			if t == tag {
+				tagged = true
+				break
+			}
+		}
+		if !tagged {
+			return false
+		}
+	}
+
+	if search := strings.ToLower(strings.TrimSpace(f.Search)); search != "" {
+		return strings.Contains(strings.ToLower(c.Name), search) ||
+			strings.Contains(strings.ToLower(c.Host), search) ||
+			strings.Contains(strings.ToLower(c.Description), search)
+	}
+
+	return true
+}
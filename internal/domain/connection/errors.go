@@ -14,4 +14,8 @@ var (
 	ErrPermissionDenied = errors.New("permission denied")
 	ErrResourceNotFound = errors.New("resource not found")
 	ErrTimeout          = errors.New("operation timed out")
+
+	// Capability Errors
+	ErrExtensionNotInstalled    = errors.New("required extension is not installed")
+	ErrUnsupportedServerVersion = errors.New("server version is too old")
 )
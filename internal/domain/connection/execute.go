@@ -0,0 +1,91 @@
+package connection
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// QueryResult is the outcome of running an arbitrary statement through ExecuteStatement.
+type QueryResult struct {
+	Columns []string
+	Rows    [][]any
+	// RowsAffected is the row count of the command tag: rows returned by a SELECT or changed by
+	// an INSERT, UPDATE or DELETE.
+	RowsAffected int64
+	// Truncated tells that the statement returned more rows than were kept.
+	Truncated bool
+	Duration  time.Duration
+}
+
+// ExecutedStatement describes a statement a gateway sent to the server.
+type ExecutedStatement struct {
+	SQL string
+	// RedactedSQL is SQL with its literals replaced by "?", for statements whose literals may
+	// hold secrets.
+	RedactedSQL string
+	StartedAt   time.Time
+	Duration    time.Duration
+	// RowsReturned is set for statements that return rows and RowsAffected for the others,
+	// both only when the statement succeeded.
+	RowsReturned *int64
+	RowsAffected *int64
+	Err          error
+}
+
+// StatementListener receives the statements a gateway sends to the server while the context
+// carries it. Transaction control (BEGIN, COMMIT, ROLLBACK) is not reported.
+type StatementListener func(ExecutedStatement)
+
+type statementListenerKey struct{}
+
+func WithStatementListener(ctx context.Context, listener StatementListener) context.Context {
+	return context.WithValue(ctx, statementListenerKey{}, listener)
+}
+
+// StatementListenerFrom returns the listener carried by the context, or nil.
+func StatementListenerFrom(ctx context.Context) StatementListener {
+	listener, _ := ctx.Value(statementListenerKey{}).(StatementListener)
+	return listener
+}
+
+// readKeywords are the statements that only read. WITH is left out because a CTE may wrap an
+// INSERT, UPDATE or DELETE, and EXPLAIN because EXPLAIN ANALYZE runs the statement.
+var readKeywords = map[string]bool{
+	"SELECT": true,
+	"SHOW":   true,
+	"VALUES": true,
+	"TABLE":  true,
+}
+
+// IsReadStatement reports whether the statement only reads, judging by its first keyword
+// after comments and opening parentheses. It is conservative: anything it does not recognize
+// counts as a write. The first keyword says nothing about what the statement calls or locks,
+// so a read by this measure may still write, ex: SELECT ... FOR UPDATE or SELECT setval(...).
+func IsReadStatement(sql string) bool {
+	rest := sql
+	for {
+		rest = strings.TrimLeftFunc(rest, func(r rune) bool { return unicode.IsSpace(r) || r == '(' })
+		switch {
+		case strings.HasPrefix(rest, "--"):
+			end := strings.IndexByte(rest, '\n')
+			if end < 0 {
+				return false
+			}
+			rest = rest[end+1:]
+		case strings.HasPrefix(rest, "/*"):
+			end := strings.Index(rest, "*/")
+			if end < 0 {
+				return false
+			}
+			rest = rest[end+2:]
+		default:
+			end := strings.IndexFunc(rest, func(r rune) bool { return !unicode.IsLetter(r) })
+			if end < 0 {
+				end = len(rest)
+			}
+			return readKeywords[strings.ToUpper(rest[:end])]
+		}
+	}
+}
@@ -0,0 +1,252 @@
+package connection
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrEmptyStatement = errors.New("statement must not be empty")
+	ErrInvalidPlan    = errors.New("could not parse execution plan")
+)
+
+type ExplainOptions struct {
+	Analyze bool
+	Buffers bool
+	Verbose bool
+}
+
+// NewStatement normalizes the SQL submitted to EXPLAIN, dropping the trailing semicolon.
+func NewStatement(sql string) (string, error) {
+	statement := strings.TrimRight(strings.TrimSpace(sql), "; \t\n")
+	if statement == "" {
+		return "", ErrEmptyStatement
+	}
+	return statement, nil
+}
+
+// PlanNode is a node of the execution plan. Actual* fields are only set when the plan was
+// produced with ANALYZE and, as in PostgreSQL, are averages per loop.
+type PlanNode struct {
+	ID                 int // pre-order position inside the plan
+	NodeType           string
+	ParentRelationship string
+	RelationName       string
+	Schema             string
+	Alias              string
+	IndexName          string
+	JoinType           string
+	Strategy           string
+
+	StartupCost float64
+	TotalCost   float64
+	PlanRows    float64
+	PlanWidth   int
+
+	ActualStartupTime float64 // ms
+	ActualTotalTime   float64 // ms
+	ActualRows        float64
+	ActualLoops       float64
+
+	Filter              string
+	IndexCond           string
+	JoinFilter          string
+	HashCond            string
+	RowsRemovedByFilter float64
+	Output              []string
+
+	SharedHitBlocks     int64
+	SharedReadBlocks    int64
+	SharedDirtiedBlocks int64
+	SharedWrittenBlocks int64
+	TempReadBlocks      int64
+	TempWrittenBlocks   int64
+
+	Children []PlanNode
+}
+
+// InclusiveTime is the total time spent in the node and its children across all loops, in ms.
+func (n PlanNode) InclusiveTime() float64 {
+	return n.ActualTotalTime * math.Max(n.ActualLoops, 1)
+}
+
+// ExclusiveTime is the time spent in the node itself, excluding its children, in ms.
+func (n PlanNode) ExclusiveTime() float64 {
+	exclusive := n.InclusiveTime()
+	for _, child := range n.Children {
+		// InitPlans and SubPlans may run outside the parent's own loop; keep the estimate non-negative.
+		exclusive -= child.InclusiveTime()
+	}
+	return math.Max(exclusive, 0)
+}
+
+// EstimateFactor returns how far the planner row estimate was from the actual rows, as a ratio
+// always >= 1 (ex: 10 means off by an order of magnitude in either direction).
+func (n PlanNode) EstimateFactor() float64 {
+	if n.ActualLoops == 0 {
+		return 1
+	}
+	planned := math.Max(n.PlanRows, 1)
+	actual := math.Max(n.ActualRows, 1)
+	return math.Max(planned/actual, actual/planned)
+}
+
+type QueryPlan struct {
+	Root          PlanNode
+	PlanningTime  float64 // ms, zero when not reported
+	ExecutionTime float64 // ms, only with ANALYZE
+	Analyzed      bool
+	Raw           string // JSON as returned by the server
+}
+
+// Nodes returns every plan node in pre-order.
+func (p QueryPlan) Nodes() []PlanNode {
+	var nodes []PlanNode
+	var walk func(n PlanNode)
+	walk = func(n PlanNode) {
+		nodes = append(nodes, n)
+		for _, child := range n.Children {
+			walk(child)
+		}
+	}
+	walk(p.Root)
+	return nodes
+}
+
+// SlowestNodes returns up to limit nodes ordered by exclusive time. Empty without ANALYZE.
+func (p QueryPlan) SlowestNodes(limit int) []PlanNode {
+	if !p.Analyzed {
+		return nil
+	}
+
+	nodes := p.Nodes()
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nodes[i].ExclusiveTime() > nodes[j].ExclusiveTime()
+	})
+
+	if len(nodes) > limit {
+		nodes = nodes[:limit]
+	}
+	return nodes
+}
+
+// Misestimates returns the nodes whose row estimate is off by at least minFactor. Empty without ANALYZE.
+func (p QueryPlan) Misestimates(minFactor float64) []PlanNode {
+	if !p.Analyzed {
+		return nil
+	}
+
+	var nodes []PlanNode
+	for _, node := range p.Nodes() {
+		if node.EstimateFactor() >= minFactor {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// ParseJSONPlan parses the output of EXPLAIN (FORMAT JSON).
+func ParseJSONPlan(raw []byte) (*QueryPlan, error) {
+	var documents []struct {
+		Plan          jsonPlanNode `json:"Plan"`
+		PlanningTime  float64      `json:"Planning Time"`
+		ExecutionTime *float64     `json:"Execution Time"`
+	}
+	if err := json.Unmarshal(raw, &documents); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPlan, err)
+	}
+	if len(documents) == 0 {
+		return nil, fmt.Errorf("%w: empty output", ErrInvalidPlan)
+	}
+
+	doc := documents[0]
+	nextID := 0
+	plan := &QueryPlan{
+		Root:         doc.Plan.toNode(&nextID),
+		PlanningTime: doc.PlanningTime,
+		Analyzed:     doc.ExecutionTime != nil,
+		Raw:          string(raw),
+	}
+	if doc.ExecutionTime != nil {
+		plan.ExecutionTime = *doc.ExecutionTime
+	}
+
+	return plan, nil
+}
+
+type jsonPlanNode struct {
+	NodeType            string         `json:"Node Type"`
+	ParentRelationship  string         `json:"Parent Relationship"`
+	RelationName        string         `json:"Relation Name"`
+	Schema              string         `json:"Schema"`
+	Alias               string         `json:"Alias"`
+	IndexName           string         `json:"Index Name"`
+	JoinType            string         `json:"Join Type"`
+	Strategy            string         `json:"Strategy"`
+	StartupCost         float64        `json:"Startup Cost"`
+	TotalCost           float64        `json:"Total Cost"`
+	PlanRows            float64        `json:"Plan Rows"`
+	PlanWidth           int            `json:"Plan Width"`
+	ActualStartupTime   float64        `json:"Actual Startup Time"`
+	ActualTotalTime     float64        `json:"Actual Total Time"`
+	ActualRows          float64        `json:"Actual Rows"`
+	ActualLoops         float64        `json:"Actual Loops"`
+	Filter              string         `json:"Filter"`
+	IndexCond           string         `json:"Index Cond"`
+	JoinFilter          string         `json:"Join Filter"`
+	HashCond            string         `json:"Hash Cond"`
+	RowsRemovedByFilter float64        `json:"Rows Removed by Filter"`
+	Output              []string       `json:"Output"`
+	SharedHitBlocks     int64          `json:"Shared Hit Blocks"`
+	SharedReadBlocks    int64          `json:"Shared Read Blocks"`
+	SharedDirtiedBlocks int64          `json:"Shared Dirtied Blocks"`
+	SharedWrittenBlocks int64          `json:"Shared Written Blocks"`
+	TempReadBlocks      int64          `json:"Temp Read Blocks"`
+	TempWrittenBlocks   int64          `json:"Temp Written Blocks"`
+	Plans               []jsonPlanNode `json:"Plans"`
+}
+
+func (n jsonPlanNode) toNode(nextID *int) PlanNode {
+	node := PlanNode{
+		ID:                  *nextID,
+		NodeType:            n.NodeType,
+		ParentRelationship:  n.ParentRelationship,
+		RelationName:        n.RelationName,
+		Schema:              n.Schema,
+		Alias:               n.Alias,
+		IndexName:           n.IndexName,
+		JoinType:            n.JoinType,
+		Strategy:            n.Strategy,
+		StartupCost:         n.StartupCost,
+		TotalCost:           n.TotalCost,
+		PlanRows:            n.PlanRows,
+		PlanWidth:           n.PlanWidth,
+		ActualStartupTime:   n.ActualStartupTime,
+		ActualTotalTime:     n.ActualTotalTime,
+		ActualRows:          n.ActualRows,
+		ActualLoops:         n.ActualLoops,
+		Filter:              n.Filter,
+		IndexCond:           n.IndexCond,
+		JoinFilter:          n.JoinFilter,
+		HashCond:            n.HashCond,
+		RowsRemovedByFilter: n.RowsRemovedByFilter,
+		Output:              n.Output,
+		SharedHitBlocks:     n.SharedHitBlocks,
+		SharedReadBlocks:    n.SharedReadBlocks,
+		SharedDirtiedBlocks: n.SharedDirtiedBlocks,
+		SharedWrittenBlocks: n.SharedWrittenBlocks,
+		TempReadBlocks:      n.TempReadBlocks,
+		TempWrittenBlocks:   n.TempWrittenBlocks,
+	}
+	*nextID++
+
+	for _, child := range n.Plans {
+		node.Children = append(node.Children, child.toNode(nextID))
+	}
+
+	return node
+}
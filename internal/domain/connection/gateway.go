@@ -9,23 +9,65 @@ type Inspector interface {
 	GetColumns(ctx context.Context, conn Connection, password string, dbName, tableName Identifier) ([]Column, error)
 	GetIndexes(ctx context.Context, conn Connection, password string, dbName, tableName Identifier) ([]Index, error)
 	QueryTableRows(ctx context.Context, conn Connection, password string, dbName, tableName Identifier, limit, offset int, sortBy *Identifier, sortOrder string) (*TableRows, error)
+	GetObjectDDL(ctx context.Context, conn Connection, password string, dbName Identifier, kind ObjectKind, schema, name Identifier) (*ObjectDDL, error)
+	GetSchemaDDL(ctx context.Context, conn Connection, password string, dbName, schema Identifier) (*SchemaDDL, error)
+	GetViews(ctx context.Context, conn Connection, password string, dbName, schema Identifier) ([]View, error)
+	GetMaterializedViews(ctx context.Context, conn Connection, password string, dbName, schema Identifier) ([]MaterializedView, error)
+	GetFunctions(ctx context.Context, conn Connection, password string, dbName, schema Identifier) ([]Function, error)
+	GetTriggers(ctx context.Context, conn Connection, password string, dbName, schema, tableName Identifier) ([]Trigger, error)
+	GetSequences(ctx context.Context, conn Connection, password string, dbName, schema Identifier) ([]Sequence, error)
+	GetTypes(ctx context.Context, conn Connection, password string, dbName, schema Identifier) ([]UserType, error)
+	GetTableHealth(ctx context.Context, conn Connection, password string, dbName, schema, tableName Identifier) (*TableHealth, error)
+	Explain(ctx context.Context, conn Connection, password string, dbName Identifier, statement string, opts ExplainOptions) (*QueryPlan, error)
+	GetSettings(ctx context.Context, conn Connection, password string) ([]Setting, error)
+	ListPrivileges(ctx context.Context, conn Connection, password string, dbName Identifier, filter PrivilegeFilter) (*DatabasePrivileges, error)
+	GetEffectivePrivileges(ctx context.Context, conn Connection, password string, dbName, role Identifier, schema *Identifier) (*EffectivePrivileges, error)
 }
 
 // Monitor checks runtime health and active sessions.
 type Monitor interface {
 	Ping(ctx context.Context, conn Connection, password string) error
 	GetServerHealth(ctx context.Context, conn Connection, password string) (*ServerHealth, error)
-	ListSessions(ctx context.Context, conn Connection, password string) ([]Session, error)
+	GetServerStats(ctx context.Context, conn Connection, password string) (*ServerStats, error)
+	ListSessions(ctx context.Context, conn Connection, password string, filter SessionFilter, limit, offset int) (*SessionPage, error)
+	GetTopStatements(ctx context.Context, conn Connection, password string, orderBy StatementOrder, limit int) ([]StatementStats, error)
+	GetLockWaits(ctx context.Context, conn Connection, password string) ([]LockWait, error)
+	GetReplicaStatus(ctx context.Context, conn Connection, password string) (*ReplicaStatus, error)
+	ListReplicationClients(ctx context.Context, conn Connection, password string) ([]ReplicationClient, error)
+	ListReplicationSlots(ctx context.Context, conn Connection, password string) ([]ReplicationSlot, error)
 }
 
-// Administrator handles user management, database creation, and row-level data manipulation.
+// Administrator handles user management, database creation, row-level data manipulation and maintenance.
 type Administrator interface {
 	KillSession(ctx context.Context, conn Connection, password string, pid int) error
+	CancelSession(ctx context.Context, conn Connection, password string, pid int) error
+	SignalSessions(ctx context.Context, conn Connection, password string, filter SessionFilter, signal SessionSignal) ([]SignalResult, error)
+	ResetStatementStats(ctx context.Context, conn Connection, password string) error
+	// AlterSystem applies the changes with ALTER SYSTEM, reloads the configuration and returns
+	// the resulting settings, whose PendingRestart tells which changes still need a restart.
+	AlterSystem(ctx context.Context, conn Connection, password string, changes []SettingChange) ([]Setting, error)
 	ListUsers(ctx context.Context, conn Connection, password string) ([]DBUser, error)
 	CreateUser(ctx context.Context, conn Connection, password string, user DBUser, secret string) error
-	DropUser(ctx context.Context, conn Connection, password string, username Identifier) error
-	CreateDatabase(ctx context.Context, conn Connection, password string, dbName, owner Identifier) error
+	AlterUser(ctx context.Context, conn Connection, password string, username Identifier, changes RoleChanges) error
+	DropUser(ctx context.Context, conn Connection, password string, username Identifier, opts DropRoleOptions) error
+	GrantRole(ctx context.Context, conn Connection, password string, role, member Identifier, withAdmin bool) error
+	RevokeRole(ctx context.Context, conn Connection, password string, role, member Identifier) error
+	GrantPrivileges(ctx context.Context, conn Connection, password string, dbName Identifier, change PrivilegeChange) error
+	RevokePrivileges(ctx context.Context, conn Connection, password string, dbName Identifier, change PrivilegeChange) error
+	CreateDatabase(ctx context.Context, conn Connection, password string, dbName Identifier, opts DatabaseOptions) error
+	DropDatabase(ctx context.Context, conn Connection, password string, dbName Identifier, force bool) error
+	RenameDatabase(ctx context.Context, conn Connection, password string, dbName, newName Identifier) error
 	UpdateTableRow(ctx context.Context, conn Connection, password string, dbName, tableName Identifier, where, set map[Identifier]any) error
+	// ExecuteStatement runs a single statement whose arguments are bound to the positional
+	// placeholders $1, $2... and keeps at most maxRows of the rows it returns.
+	ExecuteStatement(ctx context.Context, conn Connection, password string, dbName Identifier, statement string, args []any, maxRows int) (*QueryResult, error)
+	RunMaintenance(ctx context.Context, conn Connection, password string, task MaintenanceTask, observer MaintenanceObserver) error
+	GetMaintenanceProgress(ctx context.Context, conn Connection, password string, dbName Identifier, pid int) (*MaintenanceProgress, error)
+	// DumpDatabase writes a logical dump of the database; the observer receives the backend
+	// PID of the session copying the data and a line per dumped table.
+	DumpDatabase(ctx context.Context, conn Connection, password string, dbName Identifier, scope DumpScope, w DumpWriter, observer MaintenanceObserver) error
+	// RestoreDatabase loads a dump into an existing database inside a single transaction.
+	RestoreDatabase(ctx context.Context, conn Connection, password string, dbName Identifier, dump DumpReader, opts RestoreOptions, observer MaintenanceObserver) error
 }
 
 // Gateway aggregates all operations (kept for backward compatibility during refactor).
@@ -0,0 +1,49 @@
+package connection
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HealthStatus is the outcome of the last background check of a connection.
+type HealthStatus string
+
+const (
+	HealthUnknown HealthStatus = "unknown" // not checked yet
+	HealthOK      HealthStatus = "ok"
+	HealthError   HealthStatus = "error"
+)
+
+// HealthCheck is the last known reachability of a connection. LastSuccessAt survives failed
+// checks, so it tells for how long a connection has been down.
+type HealthCheck struct {
+	ConnectionID  uuid.UUID
+	Status        HealthStatus
+	Error         string
+	Latency       time.Duration
+	CheckedAt     time.Time
+	LastSuccessAt *time.Time
+}
+
+// Record stores the result of a ping, keeping the last success time of previous checks.
+func (h *HealthCheck) Record(err error, latency time.Duration, now time.Time) {
+	h.CheckedAt = now
+	h.Latency = latency
+	if err != nil {
+		h.Status = HealthError
+		h.Error = err.Error()
+		return
+	}
+	h.Status = HealthOK
+	h.Error = ""
+	h.LastSuccessAt = &now
+}
+
+type HealthRepository interface {
+	SaveHealth(ctx context.Context, check HealthCheck) error
+	// FindHealth returns nil when the connection was never checked.
+	FindHealth(ctx context.Context, connectionID uuid.UUID) (*HealthCheck, error)
+	ListHealth(ctx context.Context) ([]HealthCheck, error)
+}
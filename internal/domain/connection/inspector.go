@@ -6,8 +6,20 @@ type Database struct {
 	Name       string
 	Owner      string
 	Encoding   string
+	Collation  string
+	Ctype      string
+	Tablespace string
+	ConnLimit  int // -1 = unlimited
 	Size       int64
-	TableCount int
+	// TableCount is nil when the database could not be inspected, ex: no CONNECT privilege.
+	TableCount *int
+	// Connections, Commits and Rollbacks come from pg_stat_database.
+	Connections int
+	Commits     int64
+	Rollbacks   int64
+	// LastActivity is the latest state change among the sessions connected to the database;
+	// nil when there are none.
+	LastActivity *time.Time
 }
 
 type Table struct {
@@ -50,20 +62,30 @@ type ServerHealth struct {
 }
 
 type DBUser struct {
-	Name        Identifier
-	IsSuperUser bool
-	CanLogin    bool
-	ConnLimit   int
+	Name          Identifier
+	IsSuperUser   bool
+	CanLogin      bool
+	ConnLimit     int
+	CanCreateDB   bool
+	CanCreateRole bool
+	Replication   bool
+	ValidUntil    *time.Time // nil quando a senha não expira
+	MemberOf      []RoleMembership
 }
 
 type Session struct {
-	PID       int
-	User      string
-	Database  string
-	State     string
-	Query     string
-	Duration  time.Duration
-	StartedAt time.Time
+	PID             int
+	User            string
+	Database        string
+	State           string
+	Query           string
+	Duration        time.Duration
+	StartedAt       time.Time
+	ClientAddr      string
+	ApplicationName string
+	WaitEventType   string
+	WaitEvent       string
+	BackendType     string
 }
 
 // type TableColumnDefinition struct {
@@ -0,0 +1,132 @@
+package connection
+
+import (
+	"sort"
+	"time"
+)
+
+// LockWait describes a session involved in lock contention: either waiting for a lock
+// (BlockedBy not empty) or holding one that others wait for.
+type LockWait struct {
+	PID             int
+	User            string
+	Database        string
+	State           string
+	Query           string
+	ApplicationName string
+	WaitEventType   string
+	WaitEvent       string
+
+	// Lock being waited for, empty for sessions that are not waiting.
+	LockType string
+	LockMode string
+	Relation string
+
+	WaitDuration        time.Duration
+	TransactionDuration time.Duration
+	// BlockedBy lists every session the wait depends on, as pg_blocking_pids reports it:
+	// the ones holding a conflicting lock and the ones ahead in the queue for it. HeldBy is the
+	// subset holding the lock.
+	BlockedBy []int
+	HeldBy    []int
+}
+
+func (w LockWait) IsWaiting() bool {
+	return len(w.BlockedBy) > 0
+}
+
+// BlockingNode is a session and the sessions waiting on it.
+type BlockingNode struct {
+	LockWait
+	Blocked []BlockingNode
+}
+
+// parent is the blocker the session is shown under: the lowest PID holding the lock it waits
+// for, or the lowest one ahead of it in the queue when none holds it.
+func (w LockWait) parent() int {
+	blockers := w.HeldBy
+	if len(blockers) == 0 {
+		blockers = w.BlockedBy
+	}
+	parent := blockers[0]
+	for _, pid := range blockers[1:] {
+		parent = min(parent, pid)
+	}
+	return parent
+}
+
+// BuildBlockingTree arranges lock waits into trees whose roots are the sessions blocking
+// others without waiting themselves. Each session appears once, under the blocker holding the
+// lock it waits for when there is one; its other blockers are only listed in BlockedBy, since
+// sessions queued for the same lock block each other and would otherwise repeat
+// exponentially. Sessions only reachable through a cycle (a deadlock not yet resolved) become
+// roots.
+func BuildBlockingTree(waits []LockWait) []BlockingNode {
+	byPID := make(map[int]LockWait, len(waits))
+	blocked := make(map[int][]int)
+	for _, w := range waits {
+		byPID[w.PID] = w
+		if w.IsWaiting() {
+			parent := w.parent()
+			blocked[parent] = append(blocked[parent], w.PID)
+		}
+	}
+	for pid := range blocked {
+		sort.Ints(blocked[pid])
+	}
+
+	placed := make(map[int]bool)
+	var build func(pid int) BlockingNode
+	build = func(pid int) BlockingNode {
+		placed[pid] = true
+
+		node := BlockingNode{LockWait: byPID[pid]}
+		node.PID = pid
+		for _, waiter := range blocked[pid] {
+			if placed[waiter] {
+				continue
+			}
+			node.Blocked = append(node.Blocked, build(waiter))
+		}
+		return node
+	}
+
+	var roots []int
+	for pid := range blocked {
+		if w, ok := byPID[pid]; !ok || !w.IsWaiting() {
+			roots = append(roots, pid)
+		}
+	}
+	sort.Ints(roots)
+
+	trees := make([]BlockingNode, 0, len(roots))
+	for _, pid := range roots {
+		trees = append(trees, build(pid))
+	}
+
+	var cycles []int
+	for pid := range blocked {
+		if !placed[pid] {
+			cycles = append(cycles, pid)
+		}
+	}
+	sort.Ints(cycles)
+	for _, pid := range cycles {
+		if !placed[pid] {
+			trees = append(trees, build(pid))
+		}
+	}
+
+	return trees
+}
+
+// WaitingCount returns how many sessions are waiting on a lock.
+func WaitingCount(waits []LockWait) int {
+	count := 0
+	for _, w := range waits {
+		if w.IsWaiting() {
+			count++
+		}
+	}
+	return count
+}
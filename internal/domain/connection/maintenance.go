@@ -0,0 +1,114 @@
+package connection
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrInvalidMaintenanceKind = errors.New("supported maintenance operations are: vacuum, analyze, reindex, refresh_materialized_view")
+	ErrMaintenanceTarget      = errors.New("refresh_materialized_view requires a target materialized view")
+	ErrMaintenanceOption      = errors.New("option is not supported by this maintenance operation")
+)
+
+type MaintenanceKind string
+
+const (
+	MaintenanceVacuum      MaintenanceKind = "vacuum"
+	MaintenanceAnalyze     MaintenanceKind = "analyze"
+	MaintenanceReindex     MaintenanceKind = "reindex"
+	MaintenanceRefreshView MaintenanceKind = "refresh_materialized_view"
+)
+
+func NewMaintenanceKind(kind string) (MaintenanceKind, error) {
+	k := MaintenanceKind(strings.ToLower(strings.TrimSpace(kind)))
+	switch k {
+	case MaintenanceVacuum, MaintenanceAnalyze, MaintenanceReindex, MaintenanceRefreshView:
+		return k, nil
+	}
+	return "", ErrInvalidMaintenanceKind
+}
+
+type MaintenanceOptions struct {
+	Full         bool // VACUUM only
+	Analyze      bool // VACUUM only
+	Verbose      bool // VACUUM, ANALYZE and REINDEX
+	Concurrently bool // REINDEX and REFRESH MATERIALIZED VIEW
+}
+
+// MaintenanceTask describes a maintenance command on a single relation or, when Target is nil,
+// on the whole database.
+type MaintenanceTask struct {
+	Kind     MaintenanceKind
+	Database Identifier
+	Schema   Identifier
+	Target   *Identifier
+	Options  MaintenanceOptions
+}
+
+func NewMaintenanceTask(kind MaintenanceKind, database, schema Identifier, target *Identifier, opts MaintenanceOptions) (*MaintenanceTask, error) {
+	switch kind {
+	case MaintenanceVacuum:
+		if opts.Concurrently {
+			return nil, fmt.Errorf("%w: concurrently", ErrMaintenanceOption)
+		}
+	case MaintenanceAnalyze:
+		if opts.Full || opts.Analyze || opts.Concurrently {
+			return nil, fmt.Errorf("%w: only verbose is allowed", ErrMaintenanceOption)
+		}
+	case MaintenanceReindex:
+		if opts.Full || opts.Analyze {
+			return nil, fmt.Errorf("%w: only verbose and concurrently are allowed", ErrMaintenanceOption)
+		}
+	case MaintenanceRefreshView:
+		if target == nil {
+			return nil, ErrMaintenanceTarget
+		}
+		if opts.Full || opts.Analyze || opts.Verbose {
+			return nil, fmt.Errorf("%w: only concurrently is allowed", ErrMaintenanceOption)
+		}
+	default:
+		return nil, ErrInvalidMaintenanceKind
+	}
+
+	return &MaintenanceTask{
+		Kind:     kind,
+		Database: database,
+		Schema:   schema,
+		Target:   target,
+		Options:  opts,
+	}, nil
+}
+
+// TargetName returns "schema.relation" or the database name for database-wide tasks.
+func (t MaintenanceTask) TargetName() string {
+	if t.Target == nil {
+		return t.Database.String()
+	}
+	return t.Schema.String() + "." + t.Target.String()
+}
+
+// MaintenanceObserver receives runtime information while a maintenance task executes.
+type MaintenanceObserver interface {
+	// Started is called with the backend PID once the command is about to be sent.
+	Started(pid int)
+	// Notice is called for each server message (ex: VACUUM VERBOSE output).
+	Notice(message string)
+}
+
+// MaintenanceProgress is a snapshot of the pg_stat_progress_* row of a running backend.
+type MaintenanceProgress struct {
+	Command     string
+	Phase       string
+	Relation    string
+	BlocksTotal int64
+	BlocksDone  int64
+}
+
+func (p MaintenanceProgress) Percent() float64 {
+	if p.BlocksTotal <= 0 {
+		return 0
+	}
+	return float64(p.BlocksDone) / float64(p.BlocksTotal) * 100
+}
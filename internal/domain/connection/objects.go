@@ -0,0 +1,118 @@
+package connection
+
+import (
+	"fmt"
+	"time"
+)
+
+type View struct {
+	Schema  string
+	Name    string
+	Owner   string
+	Comment string
+}
+
+type MaterializedView struct {
+	Schema    string
+	Name      string
+	Owner     string
+	Populated bool
+	Size      int64
+	// DataFileModifiedAt is the modification time of the view's data file. It is not the time
+	// of the last refresh, only an upper bound for it: VACUUM, hint-bit writes and checkpoints
+	// also touch the file, so the last refresh may be much older. It is nil when the server
+	// does not expose file timestamps to this role.
+	DataFileModifiedAt *time.Time
+	Comment            string
+}
+
+type RoutineKind string
+
+const (
+	RoutineKindFunction  RoutineKind = "function"
+	RoutineKindProcedure RoutineKind = "procedure"
+	RoutineKindAggregate RoutineKind = "aggregate"
+	RoutineKindWindow    RoutineKind = "window"
+)
+
+type Volatility string
+
+const (
+	VolatilityImmutable Volatility = "immutable"
+	VolatilityStable    Volatility = "stable"
+	VolatilityVolatile  Volatility = "volatile"
+)
+
+type Function struct {
+	Schema          string
+	Name            string
+	Kind            RoutineKind
+	Arguments       string
+	ReturnType      string // empty for procedures
+	Language        string
+	Volatility      Volatility
+	Owner           string
+	SecurityDefiner bool
+}
+
+// Signature returns the name and argument list that identify an overload, ex: "add(a integer, b integer)".
+func (f Function) Signature() string {
+	return fmt.Sprintf("%s(%s)", f.Name, f.Arguments)
+}
+
+type Trigger struct {
+	Name       string
+	Table      string
+	Timing     string   // "BEFORE", "AFTER", "INSTEAD OF"
+	Events     []string // "INSERT", "UPDATE", "DELETE", "TRUNCATE"
+	Level      string   // "ROW", "STATEMENT"
+	Function   string
+	Enabled    bool
+	Definition string
+}
+
+type Sequence struct {
+	Schema       string
+	Name         string
+	DataType     string
+	Start        int64
+	Increment    int64
+	Min          int64
+	Max          int64
+	Cache        int64
+	Cycle        bool
+	CurrentValue *int64 // nil until nextval is called for the first time
+	OwnedBy      string // "table.column" for serial and identity sequences
+}
+
+type TypeKind string
+
+const (
+	TypeKindEnum      TypeKind = "enum"
+	TypeKindDomain    TypeKind = "domain"
+	TypeKindComposite TypeKind = "composite"
+)
+
+type TypeAttribute struct {
+	Name string
+	Type string
+}
+
+type UserType struct {
+	Schema  string
+	Name    string
+	Kind    TypeKind
+	Comment string
+
+	// Enum
+	Labels []string
+
+	// Domain
+	BaseType    string
+	NotNull     bool
+	Default     string
+	Constraints []string
+
+	// Composite
+	Attributes []TypeAttribute
+}
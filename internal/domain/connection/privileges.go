@@ -0,0 +1,311 @@
+package connection
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrInvalidObjectType         = errors.New("invalid privilege object type")
+	ErrInvalidPrivilege          = errors.New("privilege does not apply to the object type")
+	ErrIncompletePrivilegeTarget = errors.New("privilege target is incomplete")
+	ErrMalformedACL              = errors.New("malformed ACL item")
+)
+
+// PrivilegeObjectType is the kind of object a privilege is granted on.
+type PrivilegeObjectType string
+
+const (
+	PrivilegeOnDatabase PrivilegeObjectType = "database"
+	PrivilegeOnSchema   PrivilegeObjectType = "schema"
+	PrivilegeOnTable    PrivilegeObjectType = "table"
+	PrivilegeOnSequence PrivilegeObjectType = "sequence"
+	PrivilegeOnFunction PrivilegeObjectType = "function"
+)
+
+func ParsePrivilegeObjectType(value string) (PrivilegeObjectType, error) {
+	t := PrivilegeObjectType(strings.ToLower(value))
+	if _, ok := objectPrivileges[t]; !ok {
+		return "", ErrInvalidObjectType
+	}
+	return t, nil
+}
+
+// Privileges lists the privileges that can be granted on the object type.
+func (t PrivilegeObjectType) Privileges() []Privilege {
+	return objectPrivileges[t]
+}
+
+// Privilege is a privilege keyword as written in GRANT.
+type Privilege string
+
+const (
+	PrivilegeSelect      Privilege = "SELECT"
+	PrivilegeInsert      Privilege = "INSERT"
+	PrivilegeUpdate      Privilege = "UPDATE"
+	PrivilegeDelete      Privilege = "DELETE"
+	PrivilegeTruncate    Privilege = "TRUNCATE"
+	PrivilegeReferences  Privilege = "REFERENCES"
+	PrivilegeTrigger     Privilege = "TRIGGER"
+	PrivilegeMaintain    Privilege = "MAINTAIN"
+	PrivilegeExecute     Privilege = "EXECUTE"
+	PrivilegeUsage       Privilege = "USAGE"
+	PrivilegeCreate      Privilege = "CREATE"
+	PrivilegeConnect     Privilege = "CONNECT"
+	PrivilegeTemporary   Privilege = "TEMPORARY"
+	PrivilegeSet         Privilege = "SET"
+	PrivilegeAlterSystem Privilege = "ALTER SYSTEM"
+)
+
+// TablePrivileges are the columns of the effective-privileges matrix. MAINTAIN exists since
+// PostgreSQL 17; older servers reject granting it.
+var TablePrivileges = []Privilege{
+	PrivilegeSelect,
+	PrivilegeInsert,
+	PrivilegeUpdate,
+	PrivilegeDelete,
+	PrivilegeTruncate,
+	PrivilegeReferences,
+	PrivilegeTrigger,
+	PrivilegeMaintain,
+}
+
+var objectPrivileges = map[PrivilegeObjectType][]Privilege{
+	PrivilegeOnDatabase: {PrivilegeCreate, PrivilegeConnect, PrivilegeTemporary},
+	PrivilegeOnSchema:   {PrivilegeCreate, PrivilegeUsage},
+	PrivilegeOnTable:    TablePrivileges,
+	PrivilegeOnSequence: {PrivilegeUsage, PrivilegeSelect, PrivilegeUpdate},
+	PrivilegeOnFunction: {PrivilegeExecute},
+}
+
+// aclPrivileges maps the letters of an aclitem to privileges, as in the "Privileges" section
+// of the PostgreSQL manual.
+var aclPrivileges = map[byte]Privilege{
+	'r': PrivilegeSelect,
+	'w': PrivilegeUpdate,
+	'a': PrivilegeInsert,
+	'd': PrivilegeDelete,
+	'D': PrivilegeTruncate,
+	'x': PrivilegeReferences,
+	't': PrivilegeTrigger,
+	'm': PrivilegeMaintain,
+	'X': PrivilegeExecute,
+	'U': PrivilegeUsage,
+	'C': PrivilegeCreate,
+	'c': PrivilegeConnect,
+	'T': PrivilegeTemporary,
+	's': PrivilegeSet,
+	'A': PrivilegeAlterSystem,
+}
+
+// GrantedPrivilege is a privilege held by a grantee. Grantable means it was granted WITH GRANT
+// OPTION.
+type GrantedPrivilege struct {
+	Privilege Privilege
+	Grantable bool
+}
+
+// ACLEntry is a decoded aclitem. An empty Grantee stands for PUBLIC.
+type ACLEntry struct {
+	Grantee    string
+	Grantor    string
+	Privileges []GrantedPrivilege
+}
+
+// ParseACLItem decodes the text form of an aclitem, ex: `app=arwd*/postgres` or `=c/postgres`.
+func ParseACLItem(item string) (ACLEntry, error) {
+	grantee, rest, err := readACLRole(item)
+	if err != nil {
+		return ACLEntry{}, err
+	}
+	if !strings.HasPrefix(rest, "=") {
+		return ACLEntry{}, fmt.Errorf("%w: %q", ErrMalformedACL, item)
+	}
+	rest = rest[1:]
+
+	slash := strings.IndexByte(rest, '/')
+	if slash < 0 {
+		return ACLEntry{}, fmt.Errorf("%w: %q", ErrMalformedACL, item)
+	}
+	letters := rest[:slash]
+
+	grantor, tail, err := readACLRole(rest[slash+1:])
+	if err != nil || tail != "" {
+		return ACLEntry{}, fmt.Errorf("%w: %q", ErrMalformedACL, item)
+	}
+
+	entry := ACLEntry{Grantee: grantee, Grantor: grantor, Privileges: make([]GrantedPrivilege, 0, len(letters))}
+	for i := 0; i < len(letters); i++ {
+		privilege, ok := aclPrivileges[letters[i]]
+		if !ok {
+			return ACLEntry{}, fmt.Errorf("%w: unknown privilege %q in %q", ErrMalformedACL, letters[i], item)
+		}
+		granted := GrantedPrivilege{Privilege: privilege}
+		if i+1 < len(letters) && letters[i+1] == '*' {
+			granted.Grantable = true
+			i++
+		}
+		entry.Privileges = append(entry.Privileges, granted)
+	}
+	return entry, nil
+}
+
+// readACLRole reads a role name, double-quoted when it has special characters, and returns
+// the remaining text.
+func readACLRole(s string) (string, string, error) {
+	if !strings.HasPrefix(s, `"`) {
+		end := strings.IndexAny(s, "=/")
+		if end < 0 {
+			return s, "", nil
+		}
+		return s[:end], s[end:], nil
+	}
+
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		if s[i] != '"' {
+			b.WriteByte(s[i])
+			continue
+		}
+		if i+1 < len(s) && s[i+1] == '"' {
+			b.WriteByte('"')
+			i++
+			continue
+		}
+		return b.String(), s[i+1:], nil
+	}
+	return "", "", fmt.Errorf("%w: unterminated role name in %q", ErrMalformedACL, s)
+}
+
+// ObjectPrivileges is the ACL of an object. Objects without an explicit ACL report the
+// built-in defaults (the owner holds every privilege, plus the PUBLIC defaults).
+type ObjectPrivileges struct {
+	ObjectType PrivilegeObjectType
+	Schema     string // empty for databases and schemas
+	Name       string
+	Arguments  string // identity arguments of functions, ex: "integer, text"
+	Owner      string
+	ACL        []ACLEntry
+}
+
+// DefaultPrivileges is an ALTER DEFAULT PRIVILEGES entry: the ACL given to objects Role creates
+// from now on, in Schema or, when it is empty, in any schema.
+type DefaultPrivileges struct {
+	Role       string
+	Schema     string
+	ObjectType PrivilegeObjectType
+	ACL        []ACLEntry
+}
+
+// DatabasePrivileges is the ACL listing of a database.
+type DatabasePrivileges struct {
+	Objects  []ObjectPrivileges
+	Defaults []DefaultPrivileges
+}
+
+// PrivilegeFilter narrows the ACL listing. Zero values match everything.
+type PrivilegeFilter struct {
+	ObjectType PrivilegeObjectType
+	Schema     *Identifier
+}
+
+// TableAccess is a row of the effective-privileges matrix: what a role can do on a table once
+// role membership, PUBLIC grants and ownership are taken into account.
+type TableAccess struct {
+	Schema string
+	Table  string
+	// Owner is true when the role owns the table or inherits from its owner.
+	Owner bool
+	// SchemaUsage is false when the role lacks USAGE on the schema, making the table
+	// unreachable regardless of its own privileges.
+	SchemaUsage bool
+	Privileges  []Privilege
+}
+
+// EffectivePrivileges is the privileges matrix of a role across the tables of a database.
+type EffectivePrivileges struct {
+	Role   string
+	Tables []TableAccess
+}
+
+// PrivilegeChange describes a GRANT or REVOKE. Depending on ObjectType the target is:
+//   - database, schema: Object;
+//   - table, sequence, function: Schema and Object, or Schema and AllInSchema;
+//   - function: Arguments identifies the overload ("" for no arguments).
+//
+// With Default the change applies to objects created later (ALTER DEFAULT PRIVILEGES), by
+// ForRole or by the current role, in Schema or in any schema.
+type PrivilegeChange struct {
+	ObjectType  PrivilegeObjectType
+	Schema      *Identifier
+	Object      *Identifier
+	Arguments   *string
+	AllInSchema bool
+	// Privileges is empty for ALL PRIVILEGES.
+	Privileges []Privilege
+	// Grantee is nil for PUBLIC.
+	Grantee *Identifier
+	// GrantOption grants WITH GRANT OPTION, or revokes only the grant option.
+	GrantOption bool
+	// Cascade also revokes the privileges granted onwards by the grantee.
+	Cascade bool
+	Default bool
+	ForRole *Identifier
+}
+
+// Validate checks that the privileges apply to the object type and that the target is complete.
+func (c PrivilegeChange) Validate() error {
+	allowed, ok := objectPrivileges[c.ObjectType]
+	if !ok {
+		return ErrInvalidObjectType
+	}
+	for _, p := range c.Privileges {
+		if !containsPrivilege(allowed, p) {
+			return fmt.Errorf("%w: %s on %s", ErrInvalidPrivilege, p, c.ObjectType)
+		}
+	}
+
+	if c.Default {
+		switch {
+		case c.ObjectType == PrivilegeOnDatabase:
+			return fmt.Errorf("%w: default privileges do not apply to databases", ErrInvalidObjectType)
+		case c.Object != nil || c.AllInSchema:
+			return fmt.Errorf("%w: default privileges apply to future objects, not to a named one", ErrIncompletePrivilegeTarget)
+		case c.ObjectType == PrivilegeOnSchema && c.Schema != nil:
+			return fmt.Errorf("%w: default schema privileges cannot be limited to a schema", ErrIncompletePrivilegeTarget)
+		}
+		return nil
+	}
+
+	if c.ForRole != nil {
+		return fmt.Errorf("%w: for_role only applies to default privileges", ErrIncompletePrivilegeTarget)
+	}
+
+	switch c.ObjectType {
+	case PrivilegeOnDatabase, PrivilegeOnSchema:
+		if c.Object == nil || c.AllInSchema {
+			return fmt.Errorf("%w: the %s name is required", ErrIncompletePrivilegeTarget, c.ObjectType)
+		}
+	default:
+		if c.Schema == nil {
+			return fmt.Errorf("%w: the schema is required", ErrIncompletePrivilegeTarget)
+		}
+		if c.AllInSchema == (c.Object != nil) {
+			return fmt.Errorf("%w: name an object or set all_in_schema", ErrIncompletePrivilegeTarget)
+		}
+		if c.ObjectType == PrivilegeOnFunction && c.Object != nil && c.Arguments == nil {
+			return fmt.Errorf("%w: the function arguments are required", ErrIncompletePrivilegeTarget)
+		}
+	}
+	return nil
+}
+
+func containsPrivilege(privileges []Privilege, p Privilege) bool {
+	for _, candidate := range privileges {
+		if candidate == p {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+package connection
+
+import "time"
+
+// ReplicationClient is a standby or logical subscriber streaming from the server, as reported by
+// pg_stat_replication. Lags are nil when the server has no recent measurement (ex: idle primary).
+type ReplicationClient struct {
+	PID             int
+	User            string
+	ApplicationName string
+	ClientAddr      string
+	State           string
+	SyncState       string
+	SentLSN         string
+	WriteLSN        string
+	FlushLSN        string
+	ReplayLSN       string
+	WriteLag        *time.Duration
+	FlushLag        *time.Duration
+	ReplayLag       *time.Duration
+	ReplayLagBytes  int64 // WAL generated but not yet replayed by the client
+	BackendStart    time.Time
+}
+
+// ReplicaStatus is the recovery state of the server itself. LSNs and timestamps are only set on
+// a standby.
+type ReplicaStatus struct {
+	InRecovery     bool
+	ReplayPaused   bool
+	ReceiveLSN     string
+	ReplayLSN      string
+	ReplayLagBytes int64
+	LastReplayAt   *time.Time
+	// ReplayDelay is the time since the last replayed transaction committed on the primary. On a
+	// quiet primary it keeps growing even though the standby is fully caught up.
+	ReplayDelay *time.Duration
+}
+
+// ReplicationSlot is a row of pg_replication_slots. RetainedWALBytes is how much WAL the slot
+// prevents the server from recycling.
+type ReplicationSlot struct {
+	Name              string
+	Plugin            string
+	SlotType          string
+	Database          string
+	Active            bool
+	ActivePID         *int
+	RestartLSN        string
+	ConfirmedFlushLSN string
+	RetainedWALBytes  int64
+	WALStatus         string // reserved, extended, unreserved or lost
+}
+
+// AtRisk reports whether the slot is filling the disk: nobody is consuming it and it either
+// retains at least threshold bytes of WAL or is already past max_slot_wal_keep_size.
+func (s ReplicationSlot) AtRisk(threshold int64) bool {
+	if s.Active {
+		return false
+	}
+	return s.RetainedWALBytes >= threshold || s.WALStatus == "unreserved" || s.WALStatus == "lost"
+}
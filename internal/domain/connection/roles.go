@@ -0,0 +1,60 @@
+package connection
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrNoRoleChanges      = errors.New("at least one role attribute must be changed")
+	ErrInvalidConnLimit   = errors.New("connection limit must be -1 (unlimited) or greater")
+	ErrConflictingExpiry  = errors.New("valid_until and clear_valid_until are mutually exclusive")
+	ErrSelfMembership     = errors.New("a role cannot be a member of itself")
+	ErrRoleHasDependents  = errors.New("role owns objects or holds privileges; reassign or drop them first")
+	ErrReassignToSameRole = errors.New("owned objects cannot be reassigned to the role being dropped")
+)
+
+// RoleMembership is a role the user is a member of. AdminOption allows the member to grant the
+// role to others.
+type RoleMembership struct {
+	Role        string
+	AdminOption bool
+}
+
+// RoleChanges lists the attributes ALTER ROLE must change; nil fields are kept as they are.
+type RoleChanges struct {
+	Password      *string
+	CanLogin      *bool
+	IsSuperUser   *bool
+	CanCreateDB   *bool
+	CanCreateRole *bool
+	Replication   *bool
+	ConnLimit     *int
+	ValidUntil    *time.Time
+	// ClearValidUntil makes the password valid forever (VALID UNTIL 'infinity').
+	ClearValidUntil bool
+}
+
+// Validate rejects empty and contradictory changes.
+func (c RoleChanges) Validate() error {
+	if c.Password == nil && c.CanLogin == nil && c.IsSuperUser == nil && c.CanCreateDB == nil &&
+		c.CanCreateRole == nil && c.Replication == nil && c.ConnLimit == nil && c.ValidUntil == nil && !c.ClearValidUntil {
+		return ErrNoRoleChanges
+	}
+	if c.ConnLimit != nil && *c.ConnLimit < -1 {
+		return ErrInvalidConnLimit
+	}
+	if c.ValidUntil != nil && c.ClearValidUntil {
+		return ErrConflictingExpiry
+	}
+	return nil
+}
+
+// DropRoleOptions controls what happens to the objects owned by a role being dropped. Both
+// apply to every database of the server, since REASSIGN OWNED and DROP OWNED only affect the
+// database they run in. When both are set, ownership is reassigned first and DROP OWNED then
+// revokes the remaining privileges.
+type DropRoleOptions struct {
+	ReassignTo *Identifier
+	DropOwned  bool
+}
@@ -0,0 +1,86 @@
+package connection
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidSessionState  = errors.New("supported session states are: active, idle, idle_in_transaction, idle_in_transaction_aborted, fastpath_function_call, disabled")
+	ErrInvalidSessionSignal = errors.New("supported session actions are: cancel, terminate")
+)
+
+// SessionState mirrors pg_stat_activity.state, using snake_case names in the API.
+type SessionState string
+
+const (
+	SessionActive                   SessionState = "active"
+	SessionIdle                     SessionState = "idle"
+	SessionIdleInTransaction        SessionState = "idle_in_transaction"
+	SessionIdleInTransactionAborted SessionState = "idle_in_transaction_aborted"
+	SessionFastpathFunctionCall     SessionState = "fastpath_function_call"
+	SessionDisabled                 SessionState = "disabled"
+)
+
+func NewSessionState(state string) (SessionState, error) {
+	s := SessionState(strings.ToLower(strings.TrimSpace(state)))
+	switch s {
+	case SessionActive, SessionIdle, SessionIdleInTransaction, SessionIdleInTransactionAborted, SessionFastpathFunctionCall, SessionDisabled:
+		return s, nil
+	}
+	return "", ErrInvalidSessionState
+}
+
+// ServerValue returns the state as reported by the server, ex: "idle in transaction (aborted)".
+func (s SessionState) ServerValue() string {
+	switch s {
+	case SessionIdleInTransactionAborted:
+		return "idle in transaction (aborted)"
+	case SessionFastpathFunctionCall:
+		return "fastpath function call"
+	default:
+		return strings.ReplaceAll(string(s), "_", " ")
+	}
+}
+
+// SessionFilter selects sessions. Empty fields match everything, except States: when empty,
+// idle sessions are left out, which is what a live monitor usually wants.
+type SessionFilter struct {
+	User        string
+	Database    string
+	States      []SessionState
+	MinDuration time.Duration
+	PIDs        []int // restricts the match to these PIDs, ex: the ones shown in a preview
+}
+
+// SessionPage is a page of sessions plus the number of sessions matching the filter.
+type SessionPage struct {
+	Sessions []Session
+	Total    int
+	Limit    int
+	Offset   int
+}
+
+// SessionSignal is the action sent to a backend.
+type SessionSignal string
+
+const (
+	SignalCancel    SessionSignal = "cancel"    // pg_cancel_backend: aborts the current query
+	SignalTerminate SessionSignal = "terminate" // pg_terminate_backend: closes the connection
+)
+
+func NewSessionSignal(signal string) (SessionSignal, error) {
+	s := SessionSignal(strings.ToLower(strings.TrimSpace(signal)))
+	switch s {
+	case SignalCancel, SignalTerminate:
+		return s, nil
+	}
+	return "", ErrInvalidSessionSignal
+}
+
+// SignalResult reports, for each matched PID, whether the server accepted the signal.
+type SignalResult struct {
+	PID       int
+	Signalled bool
+}
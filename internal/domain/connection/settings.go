@@ -0,0 +1,89 @@
+package connection
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrInvalidSettingName = errors.New("invalid configuration parameter name")
+	ErrNoSettingChanges   = errors.New("at least one configuration change is required")
+)
+
+// Setting is a row of pg_settings.
+type Setting struct {
+	Name           string
+	Value          string
+	Unit           string
+	Category       string
+	Description    string
+	Context        string // internal, postmaster, sighup, superuser-backend, backend, superuser, user
+	VarType        string // bool, enum, integer, real, string
+	Source         string
+	BootValue      string
+	ResetValue     string
+	MinValue       string
+	MaxValue       string
+	EnumValues     []string
+	PendingRestart bool
+}
+
+// RequiresRestart reports whether changes to the parameter only apply after a server restart.
+func (s Setting) RequiresRestart() bool {
+	return s.Context == "postmaster"
+}
+
+// ReadOnly reports whether the parameter cannot be changed at all (ex: block_size).
+func (s Setting) ReadOnly() bool {
+	return s.Context == "internal"
+}
+
+// SettingGroup is the set of parameters of one pg_settings category.
+type SettingGroup struct {
+	Category string
+	Settings []Setting
+}
+
+// GroupSettings arranges settings by category, both sorted by name.
+func GroupSettings(settings []Setting) []SettingGroup {
+	index := make(map[string]int)
+	groups := make([]SettingGroup, 0)
+	for _, s := range settings {
+		i, ok := index[s.Category]
+		if !ok {
+			i = len(groups)
+			index[s.Category] = i
+			groups = append(groups, SettingGroup{Category: s.Category})
+		}
+		groups[i].Settings = append(groups[i].Settings, s)
+	}
+
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Category < groups[j].Category })
+	for _, g := range groups {
+		sort.Slice(g.Settings, func(i, j int) bool { return g.Settings[i].Name < g.Settings[j].Name })
+	}
+	return groups
+}
+
+// settingNameRegex accepts built-in parameters and custom "extension.name" ones.
+var settingNameRegex = regexp.MustCompile(`^[a-z_][a-z0-9_]*(\.[a-z_][a-z0-9_]*)?$`)
+
+// SettingChange is an ALTER SYSTEM statement: SET when Value is not nil, RESET otherwise.
+type SettingChange struct {
+	Name  string
+	Value *string
+}
+
+func NewSettingChange(name string, value *string) (SettingChange, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if !settingNameRegex.MatchString(name) {
+		return SettingChange{}, ErrInvalidSettingName
+	}
+	return SettingChange{Name: name, Value: value}, nil
+}
+
+func (c SettingChange) IsReset() bool {
+	return c.Value == nil
+}
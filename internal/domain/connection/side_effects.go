@@ -0,0 +1,202 @@
+package connection
+
+import (
+	"strings"
+	"unicode"
+)
+
+// sideEffectFunctions are the built-in functions that change the server, its sessions or its
+// data even when called from a SELECT, which default_transaction_read_only does not stop.
+var sideEffectFunctions = map[string]bool{
+	"pg_terminate_backend":                true,
+	"pg_cancel_backend":                   true,
+	"pg_reload_conf":                      true,
+	"pg_rotate_logfile":                   true,
+	"pg_switch_wal":                       true,
+	"pg_promote":                          true,
+	"pg_wal_replay_pause":                 true,
+	"pg_wal_replay_resume":                true,
+	"pg_create_restore_point":             true,
+	"pg_log_backend_memory_contexts":      true,
+	"pg_import_system_collations":         true,
+	"pg_notify":                           true,
+	"pg_logical_emit_message":             true,
+	"pg_replication_slot_advance":         true,
+	"pg_drop_replication_slot":            true,
+	"pg_stat_statements_reset":            true,
+	"set_config":                          true,
+	"setval":                              true,
+	"nextval":                             true,
+	"lo_import":                           true,
+	"lo_export":                           true,
+	"lo_unlink":                           true,
+	"lo_create":                           true,
+	"lo_creat":                            true,
+	"lo_put":                              true,
+	"lo_from_bytea":                       true,
+	"lo_truncate":                         true,
+	"pg_file_write":                       true,
+	"pg_file_rename":                      true,
+	"pg_file_unlink":                      true,
+	"pg_file_sync":                        true,
+	"query_to_xml":                        true,
+	"query_to_xmlschema":                  true,
+	"query_to_xml_and_xmlschema":          true,
+	"cursor_to_xml":                       true,
+	"pg_create_physical_replication_slot": true,
+	"pg_create_logical_replication_slot":  true,
+}
+
+// sideEffectPrefixes cover the families of such functions, ex: dblink_exec runs any statement
+// on another server, possibly the same one.
+var sideEffectPrefixes = []string{
+	"dblink",
+	"pg_advisory_",
+	"pg_try_advisory_",
+	"pg_stat_reset",
+	"pg_replication_origin_",
+	"pg_copy_",
+	"pg_logical_slot_",
+}
+
+// CallsSideEffectFunction reports whether the statement calls a built-in function that changes
+// the server, such as pg_terminate_backend or set_config, under any schema or quoting. String
+// literals are searched too, since functions like dblink_exec and query_to_xml run the SQL they
+// receive. It is a deny-list: a user-defined function that calls one of them is not detected.
+func CallsSideEffectFunction(sql string) bool {
+	tokens, literals := sqlTokens(sql)
+	for i := 0; i+1 < len(tokens); i++ {
+		if tokens[i+1] == "(" && isSideEffectFunction(tokens[i]) {
+			return true
+		}
+	}
+	for _, literal := range literals {
+		if CallsSideEffectFunction(literal) {
+			return true
+		}
+	}
+	return false
+}
+
+func isSideEffectFunction(name string) bool {
+	if sideEffectFunctions[name] {
+		return true
+	}
+	for _, prefix := range sideEffectPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// sqlTokens splits the statement into lowercased identifiers and single-character
+// punctuation, dropping comments, and returns the contents of its string literals apart.
+// Quoted identifiers are lowercased as well, since the built-in names are all lowercase.
+func sqlTokens(sql string) (tokens, literals []string) {
+	runes := []rune(sql)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '-' && i+1 < len(runes) && runes[i+1] == '-':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			// Block comments nest in PostgreSQL.
+			depth := 0
+			for i < len(runes) {
+				if runes[i] == '/' && i+1 < len(runes) && runes[i+1] == '*' {
+					depth++
+					i += 2
+				} else if runes[i] == '*' && i+1 < len(runes) && runes[i+1] == '/' {
+					depth--
+					i += 2
+					if depth == 0 {
+						break
+					}
+				} else {
+					i++
+				}
+			}
+		case r == '\'':
+			var literal []rune
+			literal, i = quoted(runes, i, '\'', false)
+			literals = append(literals, string(literal))
+		case r == '"':
+			var name []rune
+			name, i = quoted(runes, i, '"', false)
+			tokens = append(tokens, string(name))
+		case r == '$' && dollarTag(runes, i) != "":
+			tag := dollarTag(runes, i)
+			start := i + len([]rune(tag))
+			end := strings.Index(string(runes[start:]), tag)
+			if end < 0 {
+				literals = append(literals, string(runes[start:]))
+				i = len(runes)
+				break
+			}
+			body := []rune(string(runes[start:])[:end])
+			literals = append(literals, string(body))
+			i = start + len(body) + len([]rune(tag))
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_' || runes[i] == '$') {
+				i++
+			}
+			word := strings.ToLower(string(runes[start:i]))
+			// E'...' strings take backslash escapes; the prefix is not an identifier.
+			if (word == "e" || word == "x" || word == "b" || word == "n") && i < len(runes) && runes[i] == '\'' {
+				var literal []rune
+				literal, i = quoted(runes, i, '\'', word == "e")
+				literals = append(literals, string(literal))
+				break
+			}
+			tokens = append(tokens, word)
+		default:
+			tokens = append(tokens, string(r))
+			i++
+		}
+	}
+	return tokens, literals
+}
+
+// quoted reads the quoted text starting at runes[i], where a doubled quote stands for one, and
+// returns it lowercased with the position after the closing quote. With escapes, as in E'...'
+// strings, a backslash escapes the next character.
+func quoted(runes []rune, i int, quote rune, escapes bool) ([]rune, int) {
+	var text []rune
+	for i++; i < len(runes); i++ {
+		if escapes && runes[i] == '\\' && i+1 < len(runes) {
+			i++
+			text = append(text, runes[i])
+			continue
+		}
+		if runes[i] == quote {
+			if i+1 < len(runes) && runes[i+1] == quote {
+				text = append(text, quote)
+				i++
+				continue
+			}
+			return []rune(strings.ToLower(string(text))), i + 1
+		}
+		text = append(text, runes[i])
+	}
+	return []rune(strings.ToLower(string(text))), i
+}
+
+// dollarTag returns the $tag$ opening a dollar-quoted string at runes[i], or "".
+func dollarTag(runes []rune, i int) string {
+	for j := i + 1; j < len(runes); j++ {
+		switch {
+		case runes[j] == '$':
+			return string(runes[i : j+1])
+		case unicode.IsLetter(runes[j]) || runes[j] == '_' || (j > i+1 && unicode.IsDigit(runes[j])):
+		default:
+			return ""
+		}
+	}
+	return ""
+}
@@ -0,0 +1,87 @@
+package connection
+
+import "strings"
+
+// The helpers below scan SQL text byte by byte. Every delimiter they look for is ASCII, which
+// never occurs inside a multi-byte UTF-8 sequence, so the offsets they return are safe to slice.
+
+// OpensEscapeString reports whether the quote at i opens an E'...' string, where a backslash
+// escapes the next character.
+func OpensEscapeString(sql string, i int) bool {
+	return i > 0 && (sql[i-1] == 'E' || sql[i-1] == 'e') && (i < 2 || !IsIdentChar(sql[i-2]))
+}
+
+// SkipQuoted returns the offset of the quote closing the one at start; a doubled quote, or a
+// backslash in escape strings, does not close it.
+func SkipQuoted(sql string, start int, quote byte, escapes bool) int {
+	for i := start + 1; i < len(sql); i++ {
+		switch {
+		case escapes && sql[i] == '\\':
+			i++
+		case sql[i] == quote:
+			if i+1 < len(sql) && sql[i+1] == quote {
+				i++
+				continue
+			}
+			return i
+		}
+	}
+	return len(sql)
+}
+
+// SkipBlockComment returns the offset of the end of the comment opened at start. As in
+// PostgreSQL, block comments nest.
+func SkipBlockComment(sql string, start int) int {
+	depth := 0
+	for i := start; i < len(sql)-1; i++ {
+		switch {
+		case sql[i] == '/' && sql[i+1] == '*':
+			depth++
+			i++
+		case sql[i] == '*' && sql[i+1] == '/':
+			depth--
+			i++
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return len(sql)
+}
+
+// SkipDollarQuoted returns the offset of the end of the $tag$...$tag$ string opened at start,
+// or start itself when the $ does not open one.
+func SkipDollarQuoted(sql string, start int) int {
+	if start > 0 && IsIdentChar(sql[start-1]) {
+		return start
+	}
+
+	end := start + 1
+	for end < len(sql) && sql[end] != '$' {
+		if !IsIdentChar(sql[end]) || (end == start+1 && !IsIdentStart(sql[end])) {
+			return start
+		}
+		end++
+	}
+	if end >= len(sql) {
+		return start
+	}
+
+	tag := sql[start : end+1]
+	closing := strings.Index(sql[end+1:], tag)
+	if closing < 0 {
+		return len(sql)
+	}
+	return end + closing + len(tag)
+}
+
+// IsIdentStart reports whether c may start an unquoted identifier; bytes of multi-byte
+// characters count as letters, as in the PostgreSQL lexer.
+func IsIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
+
+// IsIdentChar reports whether c may continue an unquoted identifier.
+func IsIdentChar(c byte) bool {
+	return IsIdentStart(c) || (c >= '0' && c <= '9')
+}
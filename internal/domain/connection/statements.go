@@ -0,0 +1,51 @@
+package connection
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrInvalidStatementOrder = errors.New("statements can be ordered by: total_time, mean_time, calls, rows, shared_blks_read")
+
+// StatementOrder is the ranking criterion for normalized statements.
+type StatementOrder string
+
+const (
+	StatementOrderTotalTime      StatementOrder = "total_time"
+	StatementOrderMeanTime       StatementOrder = "mean_time"
+	StatementOrderCalls          StatementOrder = "calls"
+	StatementOrderRows           StatementOrder = "rows"
+	StatementOrderSharedBlksRead StatementOrder = "shared_blks_read"
+)
+
+func NewStatementOrder(order string) (StatementOrder, error) {
+	o := StatementOrder(strings.ToLower(strings.TrimSpace(order)))
+	switch o {
+	case StatementOrderTotalTime, StatementOrderMeanTime, StatementOrderCalls, StatementOrderRows, StatementOrderSharedBlksRead:
+		return o, nil
+	}
+	return "", ErrInvalidStatementOrder
+}
+
+// StatementStats aggregates the executions of a normalized statement (constants replaced by $n).
+type StatementStats struct {
+	QueryID         int64
+	Query           string
+	User            string
+	Database        string
+	Calls           int64
+	TotalTime       float64 // ms
+	MeanTime        float64 // ms
+	MinTime         float64 // ms
+	MaxTime         float64 // ms
+	StddevTime      float64 // ms
+	Rows            int64
+	SharedBlksHit   int64
+	SharedBlksRead  int64
+	TempBlksWritten int64
+}
+
+// CacheHitRatio returns the share of shared blocks found in the buffer cache, from 0 to 1.
+func (s StatementStats) CacheHitRatio() float64 {
+	return ratio(s.SharedBlksHit, s.SharedBlksHit+s.SharedBlksRead)
+}
@@ -0,0 +1,18 @@
+package connection
+
+import "time"
+
+// ServerStats is a snapshot of the server-wide cumulative counters. Counters only make sense
+// as differences between two snapshots; see metric.NewSample.
+type ServerStats struct {
+	CollectedAt    time.Time
+	TotalSessions  int
+	ActiveSessions int
+	MaxConnections int
+	Transactions   int64 // commits + rollbacks
+	BlocksHit      int64
+	BlocksRead     int64
+	Deadlocks      int64
+	TempBytes      int64
+	DatabaseSizes  map[string]int64
+}
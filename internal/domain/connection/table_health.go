@@ -0,0 +1,74 @@
+package connection
+
+import "time"
+
+// TableHealth reúne as estatísticas de uso, manutenção e I/O de uma tabela.
+type TableHealth struct {
+	Schema string
+	Name   string
+
+	LiveTuples       int64
+	DeadTuples       int64
+	ModsSinceAnalyze int64
+
+	SeqScans           int64
+	SeqTuplesRead      int64
+	IndexScans         int64
+	IndexTuplesFetched int64
+
+	Inserts    int64
+	Updates    int64
+	HotUpdates int64
+	Deletes    int64
+
+	LastVacuum       *time.Time
+	LastAutovacuum   *time.Time
+	LastAnalyze      *time.Time
+	LastAutoanalyze  *time.Time
+	VacuumCount      int64
+	AutovacuumCount  int64
+	AnalyzeCount     int64
+	AutoanalyzeCount int64
+
+	HeapSize  int64
+	ToastSize int64
+	IndexSize int64
+	TotalSize int64
+
+	HeapBlocksRead  int64
+	HeapBlocksHit   int64
+	IndexBlocksRead int64
+	IndexBlocksHit  int64
+	ToastBlocksRead int64
+	ToastBlocksHit  int64
+}
+
+// DeadTupleRatio returns the share of dead tuples among all tuples, from 0 to 1.
+func (h TableHealth) DeadTupleRatio() float64 {
+	return ratio(h.DeadTuples, h.LiveTuples+h.DeadTuples)
+}
+
+// IndexScanRatio returns the share of scans served by an index, from 0 to 1.
+func (h TableHealth) IndexScanRatio() float64 {
+	return ratio(h.IndexScans, h.SeqScans+h.IndexScans)
+}
+
+// CacheHitRatio returns the share of heap, index and toast block reads served by shared buffers, from 0 to 1.
+func (h TableHealth) CacheHitRatio() float64 {
+	hits := h.HeapBlocksHit + h.IndexBlocksHit + h.ToastBlocksHit
+	reads := h.HeapBlocksRead + h.IndexBlocksRead + h.ToastBlocksRead
+	return ratio(hits, hits+reads)
+}
+
+// EstimatedBloat approximates the heap bytes held by dead tuples. It is a cheap estimate
+// based on the statistics collector; pgstattuple gives exact numbers at a much higher cost.
+func (h TableHealth) EstimatedBloat() int64 {
+	return int64(float64(h.HeapSize) * h.DeadTupleRatio())
+}
+
+func ratio(part, total int64) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return float64(part) / float64(total)
+}
@@ -0,0 +1,88 @@
+package connection
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrInvalidTunnelAuth   = errors.New("supported SSH authentication methods are: password, private_key")
+	ErrIncompleteTunnel    = errors.New("an SSH tunnel requires a host, a user and a password or private key")
+	ErrMissingKnownHosts   = errors.New("an SSH tunnel requires known_hosts entries to verify the bastion")
+	ErrTunnelUnreachable   = errors.New("could not open the SSH tunnel")
+	ErrHostKeyVerification = errors.New("the SSH host key of the bastion could not be verified")
+)
+
+const DefaultSSHPort = 22
+
+type TunnelAuth string
+
+const (
+	TunnelAuthPassword   TunnelAuth = "password"
+	TunnelAuthPrivateKey TunnelAuth = "private_key"
+)
+
+func NewTunnelAuth(auth string) (TunnelAuth, error) {
+	a := TunnelAuth(strings.ToLower(strings.TrimSpace(auth)))
+	switch a {
+	case TunnelAuthPassword, TunnelAuthPrivateKey:
+		return a, nil
+	}
+	return "", ErrInvalidTunnelAuth
+}
+
+// SSHTunnel is the bastion the sessions of a connection are dialed through. Host and Port of
+// the connection are then resolved and reached from the bastion.
+type SSHTunnel struct {
+	Host     string
+	Port     int
+	Username string
+	Auth     TunnelAuth
+	// Secret is the password or the PEM encoded private key, encrypted like the password of
+	// the connection.
+	Secret string
+	// Passphrase unlocks an encrypted private key; encrypted, empty when the key has none.
+	Passphrase string
+	// KnownHosts holds known_hosts lines, as printed by ssh-keyscan, that the host key of the
+	// bastion must match.
+	KnownHosts string
+}
+
+// NewSSHTunnel validates the tunnel; the secrets must already be encrypted. Port 0 means 22.
+func NewSSHTunnel(host string, port int, username, auth, encryptedSecret, encryptedPassphrase, knownHosts string) (*SSHTunnel, error) {
+	host = strings.TrimSpace(host)
+	username = strings.TrimSpace(username)
+	if host == "" || username == "" || encryptedSecret == "" {
+		return nil, ErrIncompleteTunnel
+	}
+
+	if port == 0 {
+		port = DefaultSSHPort
+	}
+	if port < 0 || port > 65535 {
+		return nil, ErrInvalidPort
+	}
+
+	a, err := NewTunnelAuth(auth)
+	if err != nil {
+		return nil, err
+	}
+	if a == TunnelAuthPassword {
+		encryptedPassphrase = ""
+	}
+
+	knownHosts = strings.TrimSpace(knownHosts)
+	if knownHosts == "" {
+		return nil, ErrMissingKnownHosts
+	}
+
+	return &SSHTunnel{
+		Host:       host,
+		Port:       port,
+		Username:   username,
+		Auth:       a,
+		Secret:     encryptedSecret,
+		Passphrase: encryptedPassphrase,
+		KnownHosts: knownHosts,
+	}, nil
+}
@@ -0,0 +1,110 @@
+// Package history models the record of the statements users run through Mesa, kept in the
+// metadata store so they can be searched later.
+package history
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/google/uuid"
+)
+
+var ErrInvalidSource = errors.New("supported history sources are: browse, edit, explain, saved_query, admin")
+
+// MaxStatementLength limits the SQL kept per entry; longer statements are cut.
+const MaxStatementLength = 16 * 1024
+
+// Source is the feature that ran the statement.
+type Source string
+
+const (
+	SourceBrowse     Source = "browse"
+	SourceEdit       Source = "edit"
+	SourceExplain    Source = "explain"
+	SourceSavedQuery Source = "saved_query"
+	SourceAdmin      Source = "admin"
+)
+
+func NewSource(source string) (Source, error) {
+	s := Source(strings.ToLower(strings.TrimSpace(source)))
+	switch s {
+	case SourceBrowse, SourceEdit, SourceExplain, SourceSavedQuery, SourceAdmin:
+		return s, nil
+	}
+	return "", ErrInvalidSource
+}
+
+type Entry struct {
+	ID           uuid.UUID
+	ConnectionID uuid.UUID
+	Database     string
+	Source       Source
+	Statement    string
+	Duration     time.Duration
+	// RowsReturned is set for statements that return rows and RowsAffected for the others.
+	RowsReturned *int64
+	RowsAffected *int64
+	Error        string
+	ExecutedAt   time.Time
+}
+
+func NewEntry(connectionID uuid.UUID, database string, source Source, executed connection.ExecutedStatement) Entry {
+	statement := executed.SQL
+	if redacts(source, statement) {
+		statement = executed.RedactedSQL
+	}
+	if len(statement) > MaxStatementLength {
+		statement = strings.ToValidUTF8(statement[:MaxStatementLength], "")
+	}
+
+	entry := Entry{
+		ID:           uuid.New(),
+		ConnectionID: connectionID,
+		Database:     database,
+		Source:       source,
+		Statement:    statement,
+		Duration:     executed.Duration,
+		RowsReturned: executed.RowsReturned,
+		RowsAffected: executed.RowsAffected,
+		ExecutedAt:   executed.StartedAt.UTC(),
+	}
+	if executed.Err != nil {
+		entry.Error = executed.Err.Error()
+	}
+	return entry
+}
+
+// Filter narrows the history. Zero values match everything; entries come most recent first.
+type Filter struct {
+	ConnectionID *uuid.UUID
+	Database     string
+	Source       *Source
+	// Search matches a part of the statement, ignoring case.
+	Search string
+	Since  *time.Time
+	Until  *time.Time
+	// FailedOnly keeps the statements that returned an error.
+	FailedOnly bool
+	Limit      int
+}
+
+type Repository interface {
+	SaveEntries(ctx context.Context, entries []Entry) error
+	ListEntries(ctx context.Context, filter Filter) ([]Entry, error)
+	DeleteEntriesBefore(ctx context.Context, before time.Time) error
+	// TrimEntries keeps the keep most recent entries of each connection and deletes the rest.
+	TrimEntries(ctx context.Context, keep int) error
+}
+
+// redacts reports whether the entry keeps the statement without its literals. Admin commands
+// always do, since the values they set (passwords, connection strings, settings) are not the
+// user's own SQL. The other sources record the SQL the user wrote and only drop the literals
+// when it mentions a password, ex: dblink_connect('... password=...'). This is deliberately
+// narrow: other secrets in a statement the user wrote, such as the key of pgp_sym_encrypt,
+// are kept as written.
+func redacts(source Source, statement string) bool {
+	return source == SourceAdmin || strings.Contains(strings.ToUpper(statement), "PASSWORD")
+}
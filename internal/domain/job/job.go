@@ -0,0 +1,138 @@
+// Package job models long-running commands that execute in the background, outside the
+// request lifecycle, and whose state is kept in the metadata store.
+package job
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrJobNotFound  = errors.New("job not found")
+	ErrJobFinished  = errors.New("job has already finished")
+	ErrInvalidState = errors.New("supported job statuses are: queued, running, succeeded, failed, cancelled")
+)
+
+// MaxLogLines limits how many log lines are kept per job; older lines are dropped first.
+const MaxLogLines = 500
+
+type Status string
+
+const (
+	StatusQueued    Status = "queued"
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+	StatusCancelled Status = "cancelled"
+)
+
+func NewStatus(status string) (Status, error) {
+	s := Status(strings.ToLower(strings.TrimSpace(status)))
+	switch s {
+	case StatusQueued, StatusRunning, StatusSucceeded, StatusFailed, StatusCancelled:
+		return s, nil
+	}
+	return "", ErrInvalidState
+}
+
+// Finished reports whether the job reached a final status.
+func (s Status) Finished() bool {
+	return s == StatusSucceeded || s == StatusFailed || s == StatusCancelled
+}
+
+type Job struct {
+	ID           uuid.UUID
+	ConnectionID uuid.UUID
+	// Kind is the command the job runs, ex: vacuum, backup.
+	Kind     string
+	Database string
+	Target   string
+	Status   Status
+	// Progress is the completed percentage, nil while the task cannot estimate it.
+	Progress *float64
+	Phase    string
+	Log      []string
+	Error    string
+	// Result references what the job produced, ex: the ID of a backup.
+	Result     string
+	CreatedAt  time.Time
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+}
+
+func New(connectionID uuid.UUID, kind, database, target string) *Job {
+	return &Job{
+		ID:           uuid.New(),
+		ConnectionID: connectionID,
+		Kind:         kind,
+		Database:     database,
+		Target:       target,
+		Status:       StatusQueued,
+		Log:          make([]string, 0),
+		CreatedAt:    time.Now().UTC(),
+	}
+}
+
+func (j *Job) Start(now time.Time) {
+	j.Status = StatusRunning
+	j.StartedAt = &now
+}
+
+// Finish moves the job to its final status: cancelled when cancellation was requested,
+// otherwise succeeded, or failed when err is not nil.
+func (j *Job) Finish(result string, err error, cancelled bool, now time.Time) {
+	j.FinishedAt = &now
+	switch {
+	case cancelled:
+		j.Status = StatusCancelled
+	case err != nil:
+		j.Status = StatusFailed
+		j.Error = err.Error()
+	default:
+		j.Status = StatusSucceeded
+		j.Result = result
+		complete := 100.0
+		j.Progress = &complete
+	}
+}
+
+func (j *Job) AppendLog(line string) {
+	if len(j.Log) >= MaxLogLines {
+		j.Log = j.Log[1:]
+	}
+	j.Log = append(j.Log, line)
+}
+
+// Snapshot returns a copy that does not share the log with the job.
+func (j *Job) Snapshot() Job {
+	copied := *j
+	copied.Log = append(make([]string, 0, len(j.Log)), j.Log...)
+	if j.Progress != nil {
+		progress := *j.Progress
+		copied.Progress = &progress
+	}
+	return copied
+}
+
+// Filter narrows the job listing. Zero values match everything.
+type Filter struct {
+	ConnectionID *uuid.UUID
+	Status       *Status
+	Limit        int
+}
+
+type Repository interface {
+	SaveJob(ctx context.Context, j Job) error
+	// FindJob returns nil when the job does not exist.
+	FindJob(ctx context.Context, id uuid.UUID) (*Job, error)
+	// ListJobs returns the jobs matching the filter, most recent first.
+	ListJobs(ctx context.Context, filter Filter) ([]Job, error)
+	// InterruptJobs fails the jobs left queued or running by a previous process, since their
+	// tasks only live in memory, and returns how many there were.
+	InterruptJobs(ctx context.Context, reason string, now time.Time) (int64, error)
+	DeleteJobsFinishedBefore(ctx context.Context, before time.Time) error
+}
@@ -0,0 +1,195 @@
+// Package metric models the time series sampled from each connection for charts.
+package metric
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/google/uuid"
+)
+
+var ErrInvalidResolution = errors.New("supported resolutions are: raw, 5m, 1h")
+
+// Resolution identifies a tier of the series. Raw samples are downsampled into 5 minute and
+// then hourly buckets so long ranges stay cheap to store and query.
+type Resolution string
+
+const (
+	ResolutionRaw         Resolution = "raw"
+	ResolutionFiveMinutes Resolution = "5m"
+	ResolutionHour        Resolution = "1h"
+)
+
+func NewResolution(resolution string) (Resolution, error) {
+	r := Resolution(strings.ToLower(strings.TrimSpace(resolution)))
+	switch r {
+	case ResolutionRaw, ResolutionFiveMinutes, ResolutionHour:
+		return r, nil
+	}
+	return "", ErrInvalidResolution
+}
+
+// Bucket returns the width of a bucket, zero for raw samples.
+func (r Resolution) Bucket() time.Duration {
+	switch r {
+	case ResolutionFiveMinutes:
+		return 5 * time.Minute
+	case ResolutionHour:
+		return time.Hour
+	default:
+		return 0
+	}
+}
+
+// ResolutionFor picks the finest resolution that keeps a range at a chartable number of points.
+func ResolutionFor(from, to time.Time) Resolution {
+	switch span := to.Sub(from); {
+	case span <= 6*time.Hour:
+		return ResolutionRaw
+	case span <= 7*24*time.Hour:
+		return ResolutionFiveMinutes
+	default:
+		return ResolutionHour
+	}
+}
+
+// Sample is one point of the series. Sessions and sizes are gauges; TPS and CacheHitRatio are
+// rates over the sampled period; Deadlocks and TempBytes are the increase during the period.
+type Sample struct {
+	ConnectionID   uuid.UUID
+	Resolution     Resolution
+	Time           time.Time
+	TotalSessions  int
+	ActiveSessions int
+	MaxConnections int
+	TPS            float64
+	CacheHitRatio  float64
+	Deadlocks      int64
+	TempBytes      int64
+	DatabaseSizes  map[string]int64
+}
+
+// NewSample derives a raw sample from two consecutive snapshots. Without a previous snapshot,
+// or after a statistics reset, rates fall back to zero and the hit ratio to the cumulative one.
+func NewSample(connectionID uuid.UUID, prev *connection.ServerStats, curr connection.ServerStats) Sample {
+	sample := Sample{
+		ConnectionID:   connectionID,
+		Resolution:     ResolutionRaw,
+		Time:           curr.CollectedAt.UTC().Truncate(time.Second),
+		TotalSessions:  curr.TotalSessions,
+		ActiveSessions: curr.ActiveSessions,
+		MaxConnections: curr.MaxConnections,
+		DatabaseSizes:  curr.DatabaseSizes,
+	}
+
+	comparable := prev != nil &&
+		curr.CollectedAt.After(prev.CollectedAt) &&
+		curr.Transactions >= prev.Transactions &&
+		curr.BlocksHit >= prev.BlocksHit &&
+		curr.BlocksRead >= prev.BlocksRead &&
+		curr.Deadlocks >= prev.Deadlocks &&
+		curr.TempBytes >= prev.TempBytes
+
+	if !comparable {
+		sample.CacheHitRatio = hitRatio(curr.BlocksHit, curr.BlocksRead)
+		return sample
+	}
+
+	elapsed := curr.CollectedAt.Sub(prev.CollectedAt).Seconds()
+	sample.TPS = float64(curr.Transactions-prev.Transactions) / elapsed
+	sample.Deadlocks = curr.Deadlocks - prev.Deadlocks
+	sample.TempBytes = curr.TempBytes - prev.TempBytes
+
+	hits, reads := curr.BlocksHit-prev.BlocksHit, curr.BlocksRead-prev.BlocksRead
+	if hits+reads == 0 {
+		// Nothing touched the buffers in this period: keep the cumulative ratio instead of 0.
+		sample.CacheHitRatio = hitRatio(curr.BlocksHit, curr.BlocksRead)
+	} else {
+		sample.CacheHitRatio = hitRatio(hits, reads)
+	}
+
+	return sample
+}
+
+// Downsample aggregates samples into buckets of the target resolution. Gauges and rates are
+// averaged, increases are summed and database sizes keep the last value of each bucket.
+func Downsample(samples []Sample, target Resolution) []Sample {
+	bucket := target.Bucket()
+	if bucket == 0 || len(samples) == 0 {
+		return nil
+	}
+
+	sorted := append([]Sample(nil), samples...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Time.Before(sorted[j].Time) })
+
+	var result []Sample
+	var group []Sample
+	flush := func() {
+		if len(group) > 0 {
+			result = append(result, aggregate(group, target))
+			group = group[:0]
+		}
+	}
+
+	for _, s := range sorted {
+		if len(group) > 0 && !s.Time.Truncate(bucket).Equal(group[0].Time.Truncate(bucket)) {
+			flush()
+		}
+		group = append(group, s)
+	}
+	flush()
+
+	return result
+}
+
+func aggregate(group []Sample, target Resolution) Sample {
+	last := group[len(group)-1]
+	out := Sample{
+		ConnectionID:  last.ConnectionID,
+		Resolution:    target,
+		Time:          group[0].Time.Truncate(target.Bucket()),
+		DatabaseSizes: last.DatabaseSizes,
+	}
+
+	var total, active, tps, hit float64
+	for _, s := range group {
+		total += float64(s.TotalSessions)
+		active += float64(s.ActiveSessions)
+		tps += s.TPS
+		hit += s.CacheHitRatio
+		out.Deadlocks += s.Deadlocks
+		out.TempBytes += s.TempBytes
+		if s.MaxConnections > out.MaxConnections {
+			out.MaxConnections = s.MaxConnections
+		}
+	}
+
+	n := float64(len(group))
+	out.TotalSessions = int(math.Round(total / n))
+	out.ActiveSessions = int(math.Round(active / n))
+	out.TPS = tps / n
+	out.CacheHitRatio = hit / n
+
+	return out
+}
+
+func hitRatio(hits, reads int64) float64 {
+	if hits+reads <= 0 {
+		return 0
+	}
+	return float64(hits) / float64(hits+reads)
+}
+
+type Repository interface {
+	Save(ctx context.Context, samples ...Sample) error
+	// Range returns the samples of a connection with from <= time < to, oldest first.
+	Range(ctx context.Context, connectionID uuid.UUID, resolution Resolution, from, to time.Time) ([]Sample, error)
+	// Latest returns the time of the newest sample of a resolution, or nil if there is none.
+	Latest(ctx context.Context, connectionID uuid.UUID, resolution Resolution) (*time.Time, error)
+	DeleteBefore(ctx context.Context, resolution Resolution, before time.Time) error
+}
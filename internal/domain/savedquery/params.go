@@ -0,0 +1,226 @@
+package savedquery
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidParamType  = errors.New("supported parameter types are: text, integer, numeric, boolean, date, timestamp, uuid")
+	ErrInvalidParamName  = errors.New("parameter names must start with a letter or underscore and contain only letters, digits and underscores")
+	ErrDuplicateParam    = errors.New("parameter is declared more than once")
+	ErrUndeclaredParam   = errors.New("the SQL uses a parameter that is not declared")
+	ErrUnusedParam       = errors.New("a declared parameter is not used in the SQL")
+	ErrPositionalParam   = errors.New("positional parameters ($1) are not supported, use named parameters (:name)")
+	ErrMissingParam      = errors.New("missing value for parameter")
+	ErrUnknownParam      = errors.New("value given for a parameter the query does not declare")
+	ErrInvalidParamValue = errors.New("invalid value for parameter")
+)
+
+var paramName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+type ParamType string
+
+const (
+	ParamText      ParamType = "text"
+	ParamInteger   ParamType = "integer"
+	ParamNumeric   ParamType = "numeric"
+	ParamBoolean   ParamType = "boolean"
+	ParamDate      ParamType = "date"
+	ParamTimestamp ParamType = "timestamp"
+	ParamUUID      ParamType = "uuid"
+)
+
+func NewParamType(paramType string) (ParamType, error) {
+	t := ParamType(strings.ToLower(strings.TrimSpace(paramType)))
+	switch t {
+	case ParamText, ParamInteger, ParamNumeric, ParamBoolean, ParamDate, ParamTimestamp, ParamUUID:
+		return t, nil
+	}
+	return "", ErrInvalidParamType
+}
+
+// Param is a named parameter of the SQL, written as :name. A parameter without Default must
+// be given a value on every execution; null binds SQL NULL.
+type Param struct {
+	Name        string
+	Type        ParamType
+	Description string
+	Default     *string
+}
+
+func NewParam(name, paramType, description string, def *string) (Param, error) {
+	name = strings.TrimSpace(name)
+	if !paramName.MatchString(name) {
+		return Param{}, fmt.Errorf("%w: %q", ErrInvalidParamName, name)
+	}
+
+	t, err := NewParamType(paramType)
+	if err != nil {
+		return Param{}, err
+	}
+
+	p := Param{Name: name, Type: t, Description: strings.TrimSpace(description), Default: def}
+	if def != nil {
+		if _, err := p.convert(*def); err != nil {
+			return Param{}, err
+		}
+	}
+	return p, nil
+}
+
+// bind converts the value of the parameter, as decoded from JSON, into the argument sent to
+// the server. The value is never written into the SQL.
+func (p Param) bind(value any, given bool) (any, error) {
+	if !given {
+		if p.Default == nil {
+			return nil, fmt.Errorf("%w: %s", ErrMissingParam, p.Name)
+		}
+		value = *p.Default
+	}
+	if value == nil {
+		return nil, nil
+	}
+	return p.convert(value)
+}
+
+func (p Param) convert(value any) (any, error) {
+	invalid := fmt.Errorf("%w: %s expects %s, got %v", ErrInvalidParamValue, p.Name, p.Type, value)
+
+	switch p.Type {
+	case ParamText:
+		if s, ok := value.(string); ok {
+			return s, nil
+		}
+
+	case ParamInteger:
+		switch v := value.(type) {
+		case json.Number:
+			if n, err := v.Int64(); err == nil {
+				return n, nil
+			}
+		case float64:
+			// 2^63 itself is representable as a float64 but overflows int64.
+			if v == math.Trunc(v) && v >= -(1<<63) && v < 1<<63 {
+				return int64(v), nil
+			}
+		case string:
+			if n, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64); err == nil {
+				return n, nil
+			}
+		}
+
+	case ParamNumeric:
+		// Sent as text so the server parses it without the rounding of a float64; values
+		// decoded with json.Number keep every digit the client sent.
+		switch v := value.(type) {
+		case json.Number:
+			if _, err := v.Float64(); err == nil {
+				return v.String(), nil
+			}
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64), nil
+		case string:
+			s := strings.TrimSpace(v)
+			if _, err := strconv.ParseFloat(s, 64); err == nil {
+				return s, nil
+			}
+		}
+
+	case ParamBoolean:
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+			if b, err := strconv.ParseBool(strings.TrimSpace(v)); err == nil {
+				return b, nil
+			}
+		}
+
+	case ParamDate:
+		if s, ok := value.(string); ok {
+			if t, err := time.Parse(time.DateOnly, strings.TrimSpace(s)); err == nil {
+				return t, nil
+			}
+		}
+
+	case ParamTimestamp:
+		if s, ok := value.(string); ok {
+			if t, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(s)); err == nil {
+				return t, nil
+			}
+		}
+
+	case ParamUUID:
+		if s, ok := value.(string); ok {
+			if id, err := uuid.Parse(strings.TrimSpace(s)); err == nil {
+				return id.String(), nil
+			}
+		}
+	}
+
+	return nil, invalid
+}
+
+// placeholder is an occurrence of :name in the SQL, at byte offsets [start, end).
+type placeholder struct {
+	name       string
+	start, end int
+}
+
+// placeholders finds the :name parameters of the SQL, skipping string literals, quoted
+// identifiers, dollar-quoted bodies, comments and :: casts.
+func placeholders(sql string) ([]placeholder, error) {
+	var found []placeholder
+
+	for i := 0; i < len(sql); i++ {
+		c := sql[i]
+		switch {
+		case c == '\'':
+			i = connection.SkipQuoted(sql, i, '\'', connection.OpensEscapeString(sql, i))
+
+		case c == '"':
+			i = connection.SkipQuoted(sql, i, '"', false)
+
+		case c == '-' && i+1 < len(sql) && sql[i+1] == '-':
+			for i < len(sql) && sql[i] != '\n' {
+				i++
+			}
+
+		case c == '/' && i+1 < len(sql) && sql[i+1] == '*':
+			i = connection.SkipBlockComment(sql, i)
+
+		case c == '$':
+			if i+1 < len(sql) && sql[i+1] >= '0' && sql[i+1] <= '9' && (i == 0 || !connection.IsIdentChar(sql[i-1])) {
+				return nil, ErrPositionalParam
+			}
+			i = connection.SkipDollarQuoted(sql, i)
+
+		case c == ':':
+			if i+1 < len(sql) && sql[i+1] == ':' {
+				i++
+				continue
+			}
+			end := i + 1
+			if end >= len(sql) || !connection.IsIdentStart(sql[end]) {
+				continue
+			}
+			for end < len(sql) && connection.IsIdentChar(sql[end]) {
+				end++
+			}
+			found = append(found, placeholder{name: sql[i+1 : end], start: i, end: end})
+			i = end - 1
+		}
+	}
+
+	return found, nil
+}
@@ -0,0 +1,221 @@
+// Package savedquery models the library of SQL snippets shared by the team, with typed named
+// parameters bound on execution.
+package savedquery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrSavedQueryNotFound = errors.New("saved query not found")
+	ErrEmptyName          = errors.New("saved query name must not be empty")
+	ErrEmptySQL           = errors.New("saved query SQL must not be empty")
+	ErrScopeConflict      = errors.New("a saved query is scoped to a connection or to a driver, not both")
+	ErrOutOfScope         = errors.New("the saved query is not available for this connection")
+)
+
+// Query is a saved SQL statement. ConnectionID restricts it to one connection and Driver to
+// the connections of a driver; with neither it runs on any connection.
+type Query struct {
+	ID           uuid.UUID
+	Name         string
+	Description  string
+	SQL          string
+	ConnectionID *uuid.UUID
+	Driver       *connection.Driver
+	Tags         []string
+	Params       []Param
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func New(name, description, sql string, connectionID *uuid.UUID, driver *connection.Driver, tags []string, params []Param) (*Query, error) {
+	now := time.Now()
+	q := &Query{
+		ID:        uuid.New(),
+		CreatedAt: now,
+	}
+	if err := q.Update(name, description, sql, connectionID, driver, tags, params); err != nil {
+		return nil, err
+	}
+	q.UpdatedAt = now
+	return q, nil
+}
+
+// Update replaces the definition, checking that every :name in the SQL is declared once in
+// params and that every declared parameter is used.
+func (q *Query) Update(name, description, sql string, connectionID *uuid.UUID, driver *connection.Driver, tags []string, params []Param) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ErrEmptyName
+	}
+
+	statement, err := connection.NewStatement(sql)
+	if err != nil {
+		return ErrEmptySQL
+	}
+
+	if connectionID != nil && driver != nil {
+		return ErrScopeConflict
+	}
+
+	declared := make(map[string]bool, len(params))
+	for _, p := range params {
+		if declared[p.Name] {
+			return fmt.Errorf("%w: %s", ErrDuplicateParam, p.Name)
+		}
+		declared[p.Name] = true
+	}
+
+	found, err := placeholders(statement)
+	if err != nil {
+		return err
+	}
+	used := make(map[string]bool, len(found))
+	for _, ph := range found {
+		if !declared[ph.name] {
+			return fmt.Errorf("%w: %s", ErrUndeclaredParam, ph.name)
+		}
+		used[ph.name] = true
+	}
+	for _, p := range params {
+		if !used[p.Name] {
+			return fmt.Errorf("%w: %s", ErrUnusedParam, p.Name)
+		}
+	}
+
+	q.Name = name
+	q.Description = strings.TrimSpace(description)
+	q.SQL = statement
+	q.ConnectionID = connectionID
+	q.Driver = driver
+	q.Tags = normalizeTags(tags)
+	q.Params = params
+	q.UpdatedAt = time.Now()
+	return nil
+}
+
+// AppliesTo reports whether the query may run on the connection.
+func (q *Query) AppliesTo(conn connection.Connection) bool {
+	switch {
+	case q.ConnectionID != nil:
+		return *q.ConnectionID == conn.ID
+	case q.Driver != nil:
+		return *q.Driver == conn.Driver
+	}
+	return true
+}
+
+// Bind rewrites the :name parameters as positional placeholders ($1, $2...) and returns the
+// arguments in the same order. Values are decoded JSON and are checked against the type of
+// their parameter; a parameter used several times is sent once.
+func (q *Query) Bind(values map[string]any) (string, []any, error) {
+	byName := make(map[string]Param, len(q.Params))
+	for _, p := range q.Params {
+		byName[p.Name] = p
+	}
+	for name := range values {
+		if _, ok := byName[name]; !ok {
+			return "", nil, fmt.Errorf("%w: %s", ErrUnknownParam, name)
+		}
+	}
+
+	found, err := placeholders(q.SQL)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var (
+		b        strings.Builder
+		args     []any
+		position = make(map[string]int)
+		last     int
+	)
+	for _, ph := range found {
+		n, ok := position[ph.name]
+		if !ok {
+			value, given := values[ph.name]
+			arg, err := byName[ph.name].bind(value, given)
+			if err != nil {
+				return "", nil, err
+			}
+			args = append(args, arg)
+			n = len(args)
+			position[ph.name] = n
+		}
+
+		b.WriteString(q.SQL[last:ph.start])
+		b.WriteString("$" + strconv.Itoa(n))
+		last = ph.end
+	}
+	b.WriteString(q.SQL[last:])
+
+	return b.String(), args, nil
+}
+
+func normalizeTags(tags []string) []string {
+	seen := make(map[string]bool, len(tags))
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		result = append(result, tag)
+	}
+	return result
+}
+
+// Filter narrows the library. Zero values match everything.
+type Filter struct {
+	// Connection keeps only the queries that may run on it.
+	Connection *connection.Connection
+	Tag        string
+	// Search matches the name, description or SQL, ignoring case.
+	Search string
+}
+
+func (f Filter) Matches(q *Query) bool {
+	if f.Connection != nil && !q.AppliesTo(*f.Connection) {
+		return false
+	}
+
+	if tag := strings.ToLower(strings.TrimSpace(f.Tag)); tag != "" {
+		tagged := false
+		for _, t := range q.Tags {
+			if t == tag {
+				tagged = true
+				break
+			}
+		}
+		if !tagged {
+			return false
+		}
+	}
+
+	if search := strings.ToLower(strings.TrimSpace(f.Search)); search != "" {
+		return strings.Contains(strings.ToLower(q.Name), search) ||
+			strings.Contains(strings.ToLower(q.Description), search) ||
+			strings.Contains(strings.ToLower(q.SQL), search)
+	}
+
+	return true
+}
+
+type Repository interface {
+	SaveQuery(ctx context.Context, q *Query) error
+	// FindQuery returns nil when the query does not exist.
+	FindQuery(ctx context.Context, id uuid.UUID) (*Query, error)
+	// ListQueries returns every saved query ordered by name.
+	ListQueries(ctx context.Context) ([]*Query, error)
+	DeleteQuery(ctx context.Context, id uuid.UUID) error
+}
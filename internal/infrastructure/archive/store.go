@@ -0,0 +1,412 @@
+// Package archive stores backups as zip files on the local filesystem, one directory per
+// connection. Each backup is a <id>.zip archive next to a <id>.json file with its metadata;
+// the metadata file is only written once the archive is complete.
+package archive
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/domain/backup"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/google/uuid"
+)
+
+// formatVersion is bumped whenever the layout of the archive changes.
+const formatVersion = 1
+
+const manifestEntry = "manifest.json"
+
+// manifest describes the contents of an archive.
+type manifest struct {
+	Version  int             `json:"version"`
+	Database string          `json:"database"`
+	Tables   []manifestTable `json:"tables"`
+}
+
+type manifestTable struct {
+	Schema  string   `json:"schema"`
+	Name    string   `json:"name"`
+	Columns []string `json:"columns"`
+	Entry   string   `json:"entry"`
+}
+
+// metadata is the content of the <id>.json file.
+type metadata struct {
+	ID           uuid.UUID `json:"id"`
+	ConnectionID uuid.UUID `json:"connection_id"`
+	Database     string    `json:"database"`
+	Tables       []string  `json:"tables"`
+	Size         int64     `json:"size"`
+	Checksum     string    `json:"checksum"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// Store implements backup.Store on top of a directory.
+type Store struct {
+	dir string
+}
+
+// NewStore removes the partial archives and metadata files left by a server that stopped
+// mid-write; the store is created before any backup job runs, so none of them is still being
+// written.
+func NewStore(dir string) (*Store, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("creating backup directory: %w", err)
+	}
+
+	partials, err := filepath.Glob(filepath.Join(dir, "*", "*.partial"))
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range partials {
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("removing partial file: %w", err)
+		}
+	}
+
+	return &Store{dir: dir}, nil
+}
+
+func (s *Store) Create(_ context.Context, b backup.Backup) (backup.Archive, error) {
+	dir := s.connectionDir(b.ConnectionID)
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("creating backup directory: %w", err)
+	}
+
+	path := s.archivePath(b.ConnectionID, b.ID)
+	file, err := os.OpenFile(path+".partial", os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o640)
+	if err != nil {
+		return nil, fmt.Errorf("creating archive: %w", err)
+	}
+
+	a := &archive{
+		store:    s,
+		backup:   b,
+		path:     path,
+		file:     file,
+		checksum: sha256.New(),
+		manifest: manifest{Version: formatVersion, Database: b.Database, Tables: make([]manifestTable, 0)},
+	}
+	a.zip = zip.NewWriter(io.MultiWriter(file, a.checksum))
+	return a, nil
+}
+
+func (s *Store) Find(_ context.Context, connectionID, id uuid.UUID) (*backup.Backup, error) {
+	b, err := s.readMetadata(s.metadataPath(connectionID, id))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	return b, err
+}
+
+func (s *Store) Open(ctx context.Context, connectionID, id uuid.UUID) (backup.Reader, error) {
+	b, err := s.Find(ctx, connectionID, id)
+	if err != nil {
+		return nil, err
+	}
+	if b == nil {
+		return nil, backup.ErrBackupNotFound
+	}
+
+	path := s.archivePath(connectionID, id)
+	checksum, err := fileChecksum(path)
+	if err != nil {
+		return nil, err
+	}
+	if checksum != b.Checksum {
+		return nil, fmt.Errorf("%w: %s", backup.ErrChecksumMismatch, filepath.Base(path))
+	}
+
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening archive: %w", err)
+	}
+
+	r := &reader{zip: zr, entries: make(map[string]*zip.File, len(zr.File))}
+	for _, f := range zr.File {
+		r.entries[f.Name] = f
+	}
+
+	content, err := r.readEntry(manifestEntry)
+	if err == nil {
+		err = json.Unmarshal([]byte(content), &r.manifest)
+	}
+	if err == nil && r.manifest.Version != formatVersion {
+		err = fmt.Errorf("unsupported archive version %d", r.manifest.Version)
+	}
+	if err != nil {
+		zr.Close()
+		return nil, fmt.Errorf("reading manifest: %w", err)
+	}
+
+	return r, nil
+}
+
+func (s *Store) List(_ context.Context, connectionID uuid.UUID) ([]backup.Backup, error) {
+	paths, err := filepath.Glob(filepath.Join(s.connectionDir(connectionID), "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	backups := make([]backup.Backup, 0, len(paths))
+	for _, path := range paths {
+		b, err := s.readMetadata(path)
+		if err != nil {
+			return nil, err
+		}
+		backups = append(backups, *b)
+	}
+
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].CreatedAt.After(backups[j].CreatedAt)
+	})
+
+	return backups, nil
+}
+
+// Delete removes the metadata first, so a failure half-way never lists a missing archive.
+func (s *Store) Delete(_ context.Context, connectionID, id uuid.UUID) error {
+	err := os.Remove(s.metadataPath(connectionID, id))
+	if errors.Is(err, fs.ErrNotExist) {
+		return backup.ErrBackupNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("deleting backup metadata: %w", err)
+	}
+
+	if err := os.Remove(s.archivePath(connectionID, id)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("deleting archive: %w", err)
+	}
+	return nil
+}
+
+func (s *Store) connectionDir(connectionID uuid.UUID) string {
+	return filepath.Join(s.dir, connectionID.String())
+}
+
+func (s *Store) archivePath(connectionID, id uuid.UUID) string {
+	return filepath.Join(s.connectionDir(connectionID), id.String()+".zip")
+}
+
+func (s *Store) metadataPath(connectionID, id uuid.UUID) string {
+	return filepath.Join(s.connectionDir(connectionID), id.String()+".json")
+}
+
+func (s *Store) readMetadata(path string) (*backup.Backup, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var m metadata
+	if err := json.Unmarshal(content, &m); err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", filepath.Base(path), err)
+	}
+
+	return &backup.Backup{
+		ID:           m.ID,
+		ConnectionID: m.ConnectionID,
+		Database:     m.Database,
+		Tables:       m.Tables,
+		Size:         m.Size,
+		Checksum:     m.Checksum,
+		CreatedAt:    m.CreatedAt,
+	}, nil
+}
+
+// writeMetadata writes through a temporary file so that readers never see a partial file.
+func (s *Store) writeMetadata(b backup.Backup) error {
+	content, err := json.MarshalIndent(metadata{
+		ID:           b.ID,
+		ConnectionID: b.ConnectionID,
+		Database:     b.Database,
+		Tables:       b.Tables,
+		Size:         b.Size,
+		Checksum:     b.Checksum,
+		CreatedAt:    b.CreatedAt,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	path := s.metadataPath(b.ConnectionID, b.ID)
+	if err := os.WriteFile(path+".partial", content, 0o640); err != nil {
+		return fmt.Errorf("writing backup metadata: %w", err)
+	}
+	return os.Rename(path+".partial", path)
+}
+
+// archive is a backup.Archive being written.
+type archive struct {
+	store    *Store
+	backup   backup.Backup
+	path     string
+	file     *os.File
+	zip      *zip.Writer
+	checksum hash.Hash
+	manifest manifest
+}
+
+func (a *archive) WriteSection(section connection.DumpSection, script string) error {
+	w, err := a.zip.Create(string(section) + ".sql")
+	if err != nil {
+		return fmt.Errorf("writing %s: %w", section, err)
+	}
+	if _, err := io.WriteString(w, script); err != nil {
+		return fmt.Errorf("writing %s: %w", section, err)
+	}
+	return nil
+}
+
+func (a *archive) WriteTable(table connection.DumpTable) (io.Writer, error) {
+	entry := fmt.Sprintf("data/%04d.copy", len(a.manifest.Tables)+1)
+	w, err := a.zip.Create(entry)
+	if err != nil {
+		return nil, fmt.Errorf("writing %s.%s: %w", table.Schema, table.Name, err)
+	}
+
+	a.manifest.Tables = append(a.manifest.Tables, manifestTable{
+		Schema:  table.Schema,
+		Name:    table.Name,
+		Columns: table.Columns,
+		Entry:   entry,
+	})
+	return w, nil
+}
+
+func (a *archive) Commit() (*backup.Backup, error) {
+	if err := a.finish(); err != nil {
+		a.Abort()
+		return nil, err
+	}
+
+	info, err := os.Stat(a.path + ".partial")
+	if err != nil {
+		a.Abort()
+		return nil, err
+	}
+	if err := os.Rename(a.path+".partial", a.path); err != nil {
+		a.Abort()
+		return nil, fmt.Errorf("saving archive: %w", err)
+	}
+
+	b := a.backup
+	b.Size = info.Size()
+	b.Checksum = hex.EncodeToString(a.checksum.Sum(nil))
+	if err := a.store.writeMetadata(b); err != nil {
+		os.Remove(a.path)
+		return nil, err
+	}
+
+	return &b, nil
+}
+
+func (a *archive) finish() error {
+	w, err := a.zip.Create(manifestEntry)
+	if err != nil {
+		return fmt.Errorf("writing manifest: %w", err)
+	}
+	if err := json.NewEncoder(w).Encode(a.manifest); err != nil {
+		return fmt.Errorf("writing manifest: %w", err)
+	}
+	if err := a.zip.Close(); err != nil {
+		return fmt.Errorf("closing archive: %w", err)
+	}
+	if err := a.file.Sync(); err != nil {
+		return fmt.Errorf("syncing archive: %w", err)
+	}
+	return a.file.Close()
+}
+
+func (a *archive) Abort() error {
+	a.file.Close()
+	if err := os.Remove(a.path + ".partial"); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// reader is a backup.Reader over an opened archive.
+type reader struct {
+	zip      *zip.ReadCloser
+	entries  map[string]*zip.File
+	manifest manifest
+}
+
+func (r *reader) Section(section connection.DumpSection) (string, error) {
+	return r.readEntry(string(section) + ".sql")
+}
+
+func (r *reader) Tables() []connection.DumpTable {
+	tables := make([]connection.DumpTable, len(r.manifest.Tables))
+	for i, table := range r.manifest.Tables {
+		tables[i] = connection.DumpTable{Schema: table.Schema, Name: table.Name, Columns: table.Columns}
+	}
+	return tables
+}
+
+func (r *reader) OpenTable(i int) (io.ReadCloser, error) {
+	if i < 0 || i >= len(r.manifest.Tables) {
+		return nil, fmt.Errorf("table %d is not in the archive", i)
+	}
+
+	entry, ok := r.entries[r.manifest.Tables[i].Entry]
+	if !ok {
+		return nil, fmt.Errorf("archive entry %s is missing", r.manifest.Tables[i].Entry)
+	}
+	return entry.Open()
+}
+
+func (r *reader) Close() error {
+	return r.zip.Close()
+}
+
+func (r *reader) readEntry(name string) (string, error) {
+	entry, ok := r.entries[name]
+	if !ok {
+		return "", fmt.Errorf("archive entry %s is missing", name)
+	}
+
+	rc, err := entry.Open()
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+
+	var b strings.Builder
+	if _, err := io.Copy(&b, rc); err != nil {
+		return "", fmt.Errorf("reading %s: %w", name, err)
+	}
+	return b.String(), nil
+}
+
+func fileChecksum(path string) (string, error) {
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", fmt.Errorf("%w: archive file is missing", backup.ErrBackupNotFound)
+	}
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return "", fmt.Errorf("reading archive: %w", err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
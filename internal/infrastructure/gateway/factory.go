@@ -12,10 +12,11 @@ type Factory struct {
 	gateways map[connection.Driver]connection.Gateway
 }
 
-func NewFactory() *Factory {
+// NewFactory recebe o tunnels usado pelas conexões atrás de um bastion SSH.
+func NewFactory(tunnels postgres.TunnelDialer) *Factory {
 	return &Factory{
 		gateways: map[connection.Driver]connection.Gateway{
-			connection.PostgresDriver: postgres.NewGateway(),
+			connection.PostgresDriver: postgres.NewGateway(tunnels),
 		},
 	}
 }
@@ -0,0 +1,114 @@
+// Package notify delivers alert events to HTTP webhooks and Slack-compatible incoming webhooks.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/domain/alert"
+)
+
+const requestTimeout = 10 * time.Second
+
+// Dispatcher sends each event to the channel configured on its rule.
+type Dispatcher struct {
+	client *http.Client
+}
+
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{client: &http.Client{Timeout: requestTimeout}}
+}
+
+func (d *Dispatcher) Notify(ctx context.Context, event alert.Event) error {
+	var payload any
+	switch event.Rule.Channel {
+	case alert.ChannelWebhook:
+		payload = newWebhookPayload(event)
+	case alert.ChannelSlack:
+		payload = newSlackPayload(event)
+	default:
+		return alert.ErrInvalidChannel
+	}
+
+	return d.post(ctx, event.Rule.Target, payload)
+}
+
+func (d *Dispatcher) post(ctx context.Context, target string, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "mesa-alerts")
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("notification to %s rejected with %s: %s", target, resp.Status, bytes.TrimSpace(snippet))
+	}
+	return nil
+}
+
+type webhookPayload struct {
+	Status         alert.EventKind `json:"status"`
+	RuleID         string          `json:"rule_id"`
+	RuleName       string          `json:"rule_name"`
+	Condition      alert.Condition `json:"condition"`
+	Threshold      float64         `json:"threshold"`
+	ConnectionID   string          `json:"connection_id"`
+	ConnectionName string          `json:"connection_name"`
+	Value          float64         `json:"value"`
+	Message        string          `json:"message"`
+	FiredAt        time.Time       `json:"fired_at"`
+	At             time.Time       `json:"at"`
+}
+
+func newWebhookPayload(event alert.Event) webhookPayload {
+	return webhookPayload{
+		Status:         event.Kind,
+		RuleID:         event.Rule.ID.String(),
+		RuleName:       event.Rule.Name,
+		Condition:      event.Rule.Condition,
+		Threshold:      event.Rule.Threshold,
+		ConnectionID:   event.ConnectionID.String(),
+		ConnectionName: event.ConnectionName,
+		Value:          event.Value,
+		Message:        event.Message,
+		FiredAt:        event.FiredAt,
+		At:             event.At,
+	}
+}
+
+// slackPayload is the minimal body accepted by Slack incoming webhooks and compatible services
+// (Mattermost, Rocket.Chat, Discord's /slack endpoint).
+type slackPayload struct {
+	Text string `json:"text"`
+}
+
+func newSlackPayload(event alert.Event) slackPayload {
+	if event.Kind == alert.EventResolved {
+		return slackPayload{Text: fmt.Sprintf(
+			":white_check_mark: *[RESOLVED] %s* on `%s` after %s\n%s",
+			event.Rule.Name, event.ConnectionName, event.At.Sub(event.FiredAt).Round(time.Second), event.Message,
+		)}
+	}
+	return slackPayload{Text: fmt.Sprintf(
+		":rotating_light: *[FIRING] %s* on `%s`\n%s",
+		event.Rule.Name, event.ConnectionName, event.Message,
+	)}
+}
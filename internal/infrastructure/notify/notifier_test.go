@@ -0,0 +1,140 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/domain/alert"
+	"github.com/google/uuid"
+)
+
+func TestDispatcherNotify(t *testing.T) {
+	firedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	event := func(kind alert.EventKind, channel alert.Channel) alert.Event {
+		return alert.Event{
+			Kind: kind,
+			Rule: alert.Rule{
+				ID:        uuid.MustParse("11111111-1111-1111-1111-111111111111"),
+				Name:      "too many connections",
+				Condition: alert.ConditionConnectionUsage,
+				Threshold: 80,
+				Channel:   channel,
+			},
+			ConnectionID:   uuid.MustParse("22222222-2222-2222-2222-222222222222"),
+			ConnectionName: "prod",
+			Value:          93.5,
+			Message:        "93.5% of max_connections in use",
+			FiredAt:        firedAt,
+			At:             firedAt.Add(90 * time.Second),
+		}
+	}
+
+	tests := []struct {
+		name    string
+		event   alert.Event
+		status  int
+		delay   time.Duration
+		want    map[string]any
+		wantErr string
+	}{
+		{
+			name:   "webhook",
+			event:  event(alert.EventFiring, alert.ChannelWebhook),
+			status: http.StatusOK,
+			want: map[string]any{
+				"status":          "firing",
+				"rule_id":         "11111111-1111-1111-1111-111111111111",
+				"rule_name":       "too many connections",
+				"condition":       "connection_usage",
+				"threshold":       80.0,
+				"connection_id":   "22222222-2222-2222-2222-222222222222",
+				"connection_name": "prod",
+				"value":           93.5,
+				"message":         "93.5% of max_connections in use",
+				"fired_at":        "2026-01-02T03:04:05Z",
+				"at":              "2026-01-02T03:05:35Z",
+			},
+		},
+		{
+			name:   "slack firing",
+			event:  event(alert.EventFiring, alert.ChannelSlack),
+			status: http.StatusOK,
+			want: map[string]any{
+				"text": ":rotating_light: *[FIRING] too many connections* on `prod`\n93.5% of max_connections in use",
+			},
+		},
+		{
+			name:   "slack resolved",
+			event:  event(alert.EventResolved, alert.ChannelSlack),
+			status: http.StatusOK,
+			want: map[string]any{
+				"text": ":white_check_mark: *[RESOLVED] too many connections* on `prod` after 1m30s\n93.5% of max_connections in use",
+			},
+		},
+		{
+			name:    "rejected",
+			event:   event(alert.EventFiring, alert.ChannelSlack),
+			status:  http.StatusNotFound,
+			wantErr: "404 Not Found: no_service",
+		},
+		{
+			name:    "timeout",
+			event:   event(alert.EventFiring, alert.ChannelWebhook),
+			status:  http.StatusOK,
+			delay:   time.Second,
+			wantErr: "Client.Timeout exceeded",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got map[string]any
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+					t.Errorf("got %s with Content-Type %q", r.Method, r.Header.Get("Content-Type"))
+				}
+				body, _ := io.ReadAll(r.Body)
+				if err := json.Unmarshal(body, &got); err != nil {
+					t.Errorf("decoding payload %s: %v", body, err)
+				}
+				select {
+				case <-time.After(tt.delay):
+				case <-r.Context().Done():
+					return
+				}
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte("no_service\n"))
+			}))
+			defer server.Close()
+
+			d := NewDispatcher()
+			d.client.Timeout = 100 * time.Millisecond
+			tt.event.Rule.Target = server.URL
+
+			err := d.Notify(context.Background(), tt.event)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Notify() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Notify() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("payload = %v, want %v", got, tt.want)
+			}
+			for key, want := range tt.want {
+				if got[key] != want {
+					t.Errorf("payload[%q] = %#v, want %#v", key, got[key], want)
+				}
+			}
+		})
+	}
+}
@@ -5,10 +5,15 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"log"
+	"log/slog"
 
 	"github.com/felipemalacarne/mesa/internal/config"
+	"github.com/felipemalacarne/mesa/internal/domain/alert"
 	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/history"
+	"github.com/felipemalacarne/mesa/internal/domain/job"
+	"github.com/felipemalacarne/mesa/internal/domain/metric"
+	"github.com/felipemalacarne/mesa/internal/domain/savedquery"
 	"github.com/felipemalacarne/mesa/internal/infrastructure/postgres"
 	"github.com/felipemalacarne/mesa/internal/infrastructure/sqlite"
 	"github.com/golang-migrate/migrate/v4"
@@ -20,6 +25,12 @@ import (
 
 type Store struct {
 	ConnectionRepo connection.Repository
+	MetricRepo     metric.Repository
+	AlertRepo      alert.Repository
+	HealthRepo     connection.HealthRepository
+	JobRepo        job.Repository
+	SavedQueryRepo savedquery.Repository
+	HistoryRepo    history.Repository
 	Close          func()
 }
 
@@ -41,10 +52,16 @@ func newSQLiteStore(dsn string) (*Store, error) {
 		return nil, fmt.Errorf("failed to run sqlite migrations: %w", err)
 	}
 
-	log.Println("Connected to SQLite and migrations applied.")
+	slog.Info("connected to SQLite and migrations applied")
 
 	return &Store{
 		ConnectionRepo: sqlite.NewConnectionRepository(db),
+		MetricRepo:     sqlite.NewMetricRepository(db),
+		AlertRepo:      sqlite.NewAlertRepository(db),
+		HealthRepo:     sqlite.NewHealthRepository(db),
+		JobRepo:        sqlite.NewJobRepository(db),
+		SavedQueryRepo: sqlite.NewSavedQueryRepository(db),
+		HistoryRepo:    sqlite.NewHistoryRepository(db),
 		Close:          func() { db.Close() },
 	}, nil
 }
@@ -63,10 +80,16 @@ func newPostgresStore(ctx context.Context, dsn string) (*Store, error) {
 		return nil, fmt.Errorf("failed to run postgres migrations: %w", err)
 	}
 
-	log.Println("Connected to Postgres and migrations applied.")
+	slog.Info("connected to Postgres and migrations applied")
 
 	return &Store{
 		ConnectionRepo: postgres.NewConnectionRepository(pool),
+		MetricRepo:     postgres.NewMetricRepository(pool),
+		AlertRepo:      postgres.NewAlertRepository(pool),
+		HealthRepo:     postgres.NewHealthRepository(pool),
+		JobRepo:        postgres.NewJobRepository(pool),
+		SavedQueryRepo: postgres.NewSavedQueryRepository(pool),
+		HistoryRepo:    postgres.NewHistoryRepository(pool),
 		Close:          func() { pool.Close() },
 	}, nil
 }
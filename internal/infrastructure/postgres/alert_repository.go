@@ -0,0 +1,182 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/domain/alert"
+	"github.com/felipemalacarne/mesa/internal/infrastructure/postgres/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var errNullAlertEvaluatedAt = errors.New("alert state evaluated_at is NULL")
+
+type AlertRepository struct {
+	queries *sqlc.Queries
+}
+
+func NewAlertRepository(pool *pgxpool.Pool) *AlertRepository {
+	return &AlertRepository{
+		queries: sqlc.New(pool),
+	}
+}
+
+func (r *AlertRepository) SaveRule(ctx context.Context, rule *alert.Rule) error {
+	var connectionID pgtype.UUID
+	if rule.ConnectionID != nil {
+		connectionID = pgtype.UUID{Bytes: *rule.ConnectionID, Valid: true}
+	}
+
+	return r.queries.UpsertAlertRule(ctx, sqlc.UpsertAlertRuleParams{
+		ID:                pgtype.UUID{Bytes: rule.ID, Valid: true},
+		Name:              rule.Name,
+		ConnectionID:      connectionID,
+		Condition:         string(rule.Condition),
+		Threshold:         rule.Threshold,
+		ConsecutiveChecks: int32(rule.ConsecutiveChecks),
+		Channel:           string(rule.Channel),
+		Target:            rule.Target,
+		Enabled:           rule.Enabled,
+		UpdatedAt:         pgtype.Timestamptz{Time: rule.UpdatedAt, Valid: true},
+		CreatedAt:         pgtype.Timestamptz{Time: rule.CreatedAt, Valid: true},
+	})
+}
+
+func (r *AlertRepository) FindRule(ctx context.Context, id uuid.UUID) (*alert.Rule, error) {
+	record, err := r.queries.GetAlertRule(ctx, pgtype.UUID{Bytes: id, Valid: true})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainAlertRule(record)
+}
+
+func (r *AlertRepository) ListRules(ctx context.Context) ([]*alert.Rule, error) {
+	rows, err := r.queries.ListAlertRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]*alert.Rule, 0, len(rows))
+	for _, record := range rows {
+		rule, err := toDomainAlertRule(record)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+func (r *AlertRepository) DeleteRule(ctx context.Context, id uuid.UUID) error {
+	return r.queries.DeleteAlertRule(ctx, pgtype.UUID{Bytes: id, Valid: true})
+}
+
+func (r *AlertRepository) SaveState(ctx context.Context, state alert.State) error {
+	return r.queries.UpsertAlertState(ctx, sqlc.UpsertAlertStateParams{
+		RuleID:              pgtype.UUID{Bytes: state.RuleID, Valid: true},
+		ConnectionID:        pgtype.UUID{Bytes: state.ConnectionID, Valid: true},
+		Status:              string(state.Status),
+		ConsecutiveBreaches: int32(state.ConsecutiveBreaches),
+		Value:               state.Value,
+		Message:             state.Message,
+		FiredAt:             timestamptzFrom(state.FiredAt),
+		ResolvedAt:          timestamptzFrom(state.ResolvedAt),
+		EvaluatedAt:         pgtype.Timestamptz{Time: state.EvaluatedAt, Valid: true},
+	})
+}
+
+func (r *AlertRepository) ListStates(ctx context.Context) ([]alert.State, error) {
+	rows, err := r.queries.ListAlertStates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]alert.State, 0, len(rows))
+	for _, record := range rows {
+		ruleID, err := uuidFromPg(record.RuleID)
+		if err != nil {
+			return nil, err
+		}
+		connectionID, err := uuidFromPg(record.ConnectionID)
+		if err != nil {
+			return nil, err
+		}
+		evaluatedAt, err := timeFromPg(record.EvaluatedAt, errNullAlertEvaluatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		states = append(states, alert.State{
+			RuleID:              ruleID,
+			ConnectionID:        connectionID,
+			Status:              alert.Status(record.Status),
+			ConsecutiveBreaches: int(record.ConsecutiveBreaches),
+			Value:               record.Value,
+			Message:             record.Message,
+			FiredAt:             timePtrFromPg(record.FiredAt),
+			ResolvedAt:          timePtrFromPg(record.ResolvedAt),
+			EvaluatedAt:         evaluatedAt,
+		})
+	}
+
+	return states, nil
+}
+
+func toDomainAlertRule(record sqlc.AlertRule) (*alert.Rule, error) {
+	id, err := uuidFromPg(record.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	condition, err := alert.NewCondition(record.Condition)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, err := alert.NewChannel(record.Channel)
+	if err != nil {
+		return nil, err
+	}
+
+	var connectionID *uuid.UUID
+	if record.ConnectionID.Valid {
+		cid := uuid.UUID(record.ConnectionID.Bytes)
+		connectionID = &cid
+	}
+
+	return &alert.Rule{
+		ID:                id,
+		Name:              record.Name,
+		ConnectionID:      connectionID,
+		Condition:         condition,
+		Threshold:         record.Threshold,
+		ConsecutiveChecks: int(record.ConsecutiveChecks),
+		Channel:           channel,
+		Target:            record.Target,
+		Enabled:           record.Enabled,
+		UpdatedAt:         record.UpdatedAt.Time,
+		CreatedAt:         record.CreatedAt.Time,
+	}, nil
+}
+
+func timestamptzFrom(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
+
+func timePtrFromPg(value pgtype.Timestamptz) *time.Time {
+	if !value.Valid {
+		return nil
+	}
+	return &value.Time
+}
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -12,6 +13,18 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// sshTunnel is how the tunnel is stored in the ssh_tunnel JSON column; the secrets stay
+// encrypted.
+type sshTunnel struct {
+	Host       string `json:"host"`
+	Port       int    `json:"port"`
+	Username   string `json:"username"`
+	Auth       string `json:"auth"`
+	Secret     string `json:"secret"`
+	Passphrase string `json:"passphrase,omitempty"`
+	KnownHosts string `json:"known_hosts"`
+}
+
 type ConnectionRepository struct {
 	queries *sqlc.Queries
 }
@@ -49,30 +62,97 @@ func toDomainConnection(record sqlc.Connection) (*connection.Connection, error)
 		return nil, err
 	}
 
+	environment, err := connection.NewEnvironment(record.Environment)
+	if err != nil {
+		return nil, err
+	}
+
+	protection, err := connection.NewProtection(record.Protection)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := []string{}
+	if err := json.Unmarshal(record.Tags, &tags); err != nil {
+		return nil, err
+	}
+
+	var tunnel *connection.SSHTunnel
+	if record.SshTunnel != nil {
+		var stored sshTunnel
+		if err := json.Unmarshal(record.SshTunnel, &stored); err != nil {
+			return nil, err
+		}
+		tunnel = &connection.SSHTunnel{
+			Host:       stored.Host,
+			Port:       stored.Port,
+			Username:   stored.Username,
+			Auth:       connection.TunnelAuth(stored.Auth),
+			Secret:     stored.Secret,
+			Passphrase: stored.Passphrase,
+			KnownHosts: stored.KnownHosts,
+		}
+	}
+
 	return &connection.Connection{
-		ID:        id,
-		Name:      record.Name,
-		Driver:    *parsedDriver,
-		Host:      record.Host,
-		Port:      int(record.Port),
-		Username:  record.Username,
-		Password:  record.Password,
-		UpdatedAt: updatedAt,
-		CreatedAt: createdAt,
+		ID:          id,
+		Name:        record.Name,
+		Driver:      *parsedDriver,
+		Host:        record.Host,
+		Port:        int(record.Port),
+		Username:    record.Username,
+		Password:    record.Password,
+		Environment: environment,
+		Tags:        tags,
+		Color:       record.Color,
+		Description: record.Description,
+		Protection:  protection,
+		ReadOnly:    record.ReadOnly,
+		Tunnel:      tunnel,
+		UpdatedAt:   updatedAt,
+		CreatedAt:   createdAt,
 	}, nil
 }
 
 func (r *ConnectionRepository) Save(ctx context.Context, conn *connection.Connection) error {
+	tags, err := json.Marshal(conn.Tags)
+	if err != nil {
+		return err
+	}
+
+	var tunnel []byte
+	if t := conn.Tunnel; t != nil {
+		tunnel, err = json.Marshal(sshTunnel{
+			Host:       t.Host,
+			Port:       t.Port,
+			Username:   t.Username,
+			Auth:       string(t.Auth),
+			Secret:     t.Secret,
+			Passphrase: t.Passphrase,
+			KnownHosts: t.KnownHosts,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	return r.queries.UpsertConnection(ctx, sqlc.UpsertConnectionParams{
-		ID:        pgtype.UUID{Bytes: conn.ID, Valid: true},
-		Name:      conn.Name,
-		Driver:    string(conn.Driver),
-		Host:      conn.Host,
-		Port:      int32(conn.Port),
-		Username:  conn.Username,
-		Password:  conn.Password,
-		UpdatedAt: pgtype.Timestamptz{Time: conn.UpdatedAt, Valid: true},
-		CreatedAt: pgtype.Timestamptz{Time: conn.CreatedAt, Valid: true},
+		ID:          pgtype.UUID{Bytes: conn.ID, Valid: true},
+		Name:        conn.Name,
+		Driver:      string(conn.Driver),
+		Host:        conn.Host,
+		Port:        int32(conn.Port),
+		Username:    conn.Username,
+		Password:    conn.Password,
+		Environment: string(conn.Environment),
+		Tags:        tags,
+		Color:       conn.Color,
+		Description: conn.Description,
+		Protection:  string(conn.Protection),
+		ReadOnly:    conn.ReadOnly,
+		SshTunnel:   tunnel,
+		UpdatedAt:   pgtype.Timestamptz{Time: conn.UpdatedAt, Valid: true},
+		CreatedAt:   pgtype.Timestamptz{Time: conn.CreatedAt, Valid: true},
 	})
 }
 
@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func (h *Gateway) CreateDatabase(ctx context.Context, conn connection.Connection, password string, dbName connection.Identifier, opts connection.DatabaseOptions) error {
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+
+	db, err := h.connect(conn, password, postgresDBName())
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "CREATE DATABASE %s WITH OWNER %s", dbName.Quoted(), opts.Owner.Quoted())
+	if opts.Template != nil {
+		fmt.Fprintf(&b, " TEMPLATE %s", opts.Template.Quoted())
+	}
+	if opts.Encoding != "" {
+		fmt.Fprintf(&b, " ENCODING %s", quoteLiteral(opts.Encoding))
+	}
+	if opts.Locale != "" {
+		fmt.Fprintf(&b, " LOCALE %s", quoteLiteral(opts.Locale))
+	}
+	if opts.LcCollate != "" {
+		fmt.Fprintf(&b, " LC_COLLATE %s", quoteLiteral(opts.LcCollate))
+	}
+	if opts.LcCtype != "" {
+		fmt.Fprintf(&b, " LC_CTYPE %s", quoteLiteral(opts.LcCtype))
+	}
+	if opts.Tablespace != nil {
+		fmt.Fprintf(&b, " TABLESPACE %s", opts.Tablespace.Quoted())
+	}
+	if opts.ConnLimit != nil {
+		fmt.Fprintf(&b, " CONNECTION LIMIT %d", *opts.ConnLimit)
+	}
+
+	if _, err = db.ExecContext(ctx, b.String()); err != nil {
+		return databaseError("creating database", err)
+	}
+	return nil
+}
+
+// DropDatabase drops dbName. With force, PostgreSQL 13+ terminates the sessions connected
+// to it first; without it, a database in use fails with ErrDatabaseInUse.
+func (h *Gateway) DropDatabase(ctx context.Context, conn connection.Connection, password string, dbName connection.Identifier, force bool) error {
+	db, err := h.connect(conn, password, postgresDBName())
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	query := fmt.Sprintf("DROP DATABASE %s", dbName.Quoted())
+	if force {
+		query += " WITH (FORCE)"
+	}
+
+	if _, err = db.ExecContext(ctx, query); err != nil {
+		return databaseError("dropping database", err)
+	}
+	return nil
+}
+
+// RenameDatabase fails with ErrDatabaseInUse while there are sessions connected to dbName.
+func (h *Gateway) RenameDatabase(ctx context.Context, conn connection.Connection, password string, dbName, newName connection.Identifier) error {
+	db, err := h.connect(conn, password, postgresDBName())
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	query := fmt.Sprintf("ALTER DATABASE %s RENAME TO %s", dbName.Quoted(), newName.Quoted())
+	if _, err = db.ExecContext(ctx, query); err != nil {
+		return databaseError("renaming database", err)
+	}
+	return nil
+}
+
+// databaseError maps the SQLSTATEs the database commands are expected to hit to domain errors.
+func databaseError(action string, err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "55006": // object_in_use
+			return fmt.Errorf("%w: %s", connection.ErrDatabaseInUse, pgErr.Detail)
+		case "42P04": // duplicate_database
+			return fmt.Errorf("%w: %s", connection.ErrDatabaseExists, pgErr.Message)
+		case "3D000", "42704": // invalid_catalog_name, undefined_object (template, tablespace)
+			return fmt.Errorf("%w: %s", connection.ErrResourceNotFound, pgErr.Message)
+		case "42501": // insufficient_privilege
+			return fmt.Errorf("%w: %s", connection.ErrPermissionDenied, pgErr.Message)
+		case "22023", "0A000": // invalid_parameter_value (encoding/locale), feature_not_supported
+			return fmt.Errorf("%w: %s: %s", connection.ErrInvalidConfiguration, action, pgErr.Message)
+		}
+	}
+	return fmt.Errorf("%w: %s: %v", connection.ErrQueryFailed, action, err)
+}
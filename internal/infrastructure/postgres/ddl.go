@@ -0,0 +1,637 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/lib/pq"
+)
+
+// --- DDL Extraction ---
+
+func (h *Gateway) GetObjectDDL(ctx context.Context, conn connection.Connection, password string, dbName connection.Identifier, kind connection.ObjectKind, schema, name connection.Identifier) (*connection.ObjectDDL, error) {
+	db, err := h.connect(conn, password, dbName)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	var definition string
+	switch kind {
+	case connection.ObjectKindTable:
+		var table *tableDDL
+		if table, err = h.tableDDL(ctx, db, schema.String(), name.String()); err == nil {
+			definition = table.String()
+		}
+	case connection.ObjectKindView, connection.ObjectKindMaterializedView:
+		definition, err = h.viewDDL(ctx, db, schema.String(), name.String(), kind)
+	case connection.ObjectKindIndex:
+		definition, err = h.indexDDL(ctx, db, schema.String(), name.String())
+	case connection.ObjectKindFunction:
+		definition, err = h.functionDDL(ctx, db, schema.String(), name.String())
+	case connection.ObjectKindTrigger:
+		definition, err = h.triggerDDL(ctx, db, schema.String(), name.String())
+	default:
+		return nil, connection.ErrInvalidObjectKind
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &connection.ObjectDDL{
+		Kind:       kind,
+		Schema:     schema.String(),
+		Name:       name.String(),
+		Definition: definition,
+	}, nil
+}
+
+func (h *Gateway) GetSchemaDDL(ctx context.Context, conn connection.Connection, password string, dbName, schema connection.Identifier) (*connection.SchemaDDL, error) {
+	db, err := h.connect(conn, password, dbName)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	var exists bool
+	if err := db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM pg_namespace WHERE nspname = $1)`, schema.String()).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("%w: checking schema: %v", connection.ErrQueryFailed, err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("%w: schema %s", connection.ErrResourceNotFound, schema)
+	}
+
+	statements := []string{fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s;", quoteIdentifier(schema.String()))}
+
+	types, err := h.userTypes(ctx, db, schema.String())
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range types {
+		statements = append(statements, typeDDL(t))
+	}
+
+	sequences, err := h.sequencesDDL(ctx, db, schema.String())
+	if err != nil {
+		return nil, err
+	}
+	statements = append(statements, sequences...)
+
+	functions, err := h.schemaFunctions(ctx, db, schema.String())
+	if err != nil {
+		return nil, err
+	}
+	for _, fn := range functions {
+		definition, err := h.functionDDL(ctx, db, schema.String(), fn)
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, definition)
+	}
+
+	tables, err := h.queryStrings(ctx, db, `
+SELECT c.relname
+FROM pg_class c
+JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE n.nspname = $1
+  AND c.relkind IN ('r', 'p')
+ORDER BY c.relname;
+`, schema.String())
+	if err != nil {
+		return nil, err
+	}
+
+	ddls := make([]*tableDDL, 0, len(tables))
+	for _, table := range tables {
+		ddl, err := h.tableDDL(ctx, db, schema.String(), table)
+		if err != nil {
+			return nil, err
+		}
+		ddls = append(ddls, ddl)
+	}
+
+	// Foreign keys are emitted after every table so that referenced tables already exist.
+	var foreignKeys []string
+	for _, ddl := range afterParents(ddls) {
+		statements = append(statements, ddl.create)
+		statements = append(statements, ddl.indexes...)
+		statements = append(statements, ddl.comments...)
+		foreignKeys = append(foreignKeys, ddl.foreignKeys...)
+	}
+	statements = append(statements, foreignKeys...)
+
+	views, err := h.schemaRelations(ctx, db, schema.String(), "v", "m")
+	if err != nil {
+		return nil, err
+	}
+	for _, view := range views {
+		kind := connection.ObjectKindView
+		if view.relkind == "m" {
+			kind = connection.ObjectKindMaterializedView
+		}
+
+		definition, err := h.viewDDL(ctx, db, schema.String(), view.name, kind)
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, definition)
+	}
+
+	triggers, err := h.queryStrings(ctx, db, `
+SELECT DISTINCT t.tgname
+FROM pg_trigger t
+JOIN pg_class c ON c.oid = t.tgrelid
+JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE n.nspname = $1
+  AND NOT t.tgisinternal
+  AND NOT EXISTS (
+    SELECT 1 FROM pg_depend d
+    WHERE d.classid = 'pg_trigger'::regclass AND d.objid = t.oid AND d.deptype = 'P'
+  )
+ORDER BY t.tgname;
+`, schema.String())
+	if err != nil {
+		return nil, err
+	}
+	for _, trigger := range triggers {
+		definition, err := h.triggerDDL(ctx, db, schema.String(), trigger)
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, definition)
+	}
+
+	return &connection.SchemaDDL{
+		Schema:     schema.String(),
+		Definition: strings.Join(statements, "\n\n") + "\n",
+	}, nil
+}
+
+// tableDDL groups the statements needed to recreate a table. A partition is created as a
+// partition of its parent, which must exist first, and leaves out what it inherits from it.
+type tableDDL struct {
+	relation string
+	// parent is the qualified name of the partitioned table, empty when not a partition.
+	parent string
+
+	create      string
+	indexes     []string
+	comments    []string
+	foreignKeys []string
+}
+
+func (t *tableDDL) String() string {
+	statements := []string{t.create}
+	statements = append(statements, t.indexes...)
+	statements = append(statements, t.foreignKeys...)
+	statements = append(statements, t.comments...)
+	return strings.Join(statements, "\n\n") + "\n"
+}
+
+func (h *Gateway) tableDDL(ctx context.Context, db *sql.DB, schema, name string) (*tableDDL, error) {
+	relation := qualifiedName(schema, name)
+
+	var relkind, tableComment, partitionKey, parentSchema, parentName, partitionBound string
+	var isPartition bool
+	err := db.QueryRowContext(ctx, `
+SELECT
+    c.relkind::text,
+    COALESCE(obj_description(c.oid, 'pg_class'), ''),
+    COALESCE(pg_get_partkeydef(c.oid), ''),
+    c.relispartition,
+    COALESCE(pn.nspname, ''),
+    COALESCE(pc.relname, ''),
+    COALESCE(pg_get_expr(c.relpartbound, c.oid), '')
+FROM pg_class c
+LEFT JOIN pg_inherits i ON i.inhrelid = c.oid AND c.relispartition
+LEFT JOIN pg_class pc ON pc.oid = i.inhparent
+LEFT JOIN pg_namespace pn ON pn.oid = pc.relnamespace
+WHERE c.oid = to_regclass($1);
+`, relation).Scan(&relkind, &tableComment, &partitionKey, &isPartition, &parentSchema, &parentName, &partitionBound)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && relkind != "r" && relkind != "p") {
+		return nil, fmt.Errorf("%w: table %s.%s", connection.ErrResourceNotFound, schema, name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: reading table: %v", connection.ErrQueryFailed, err)
+	}
+
+	rows, err := db.QueryContext(ctx, `
+SELECT
+    a.attname,
+    format_type(a.atttypid, a.atttypmod),
+    a.attnotnull,
+    COALESCE(pg_get_expr(d.adbin, d.adrelid), ''),
+    a.attidentity::text,
+    a.attgenerated::text,
+    COALESCE(col_description(a.attrelid, a.attnum), '')
+FROM pg_attribute a
+LEFT JOIN pg_attrdef d ON d.adrelid = a.attrelid AND d.adnum = a.attnum
+WHERE a.attrelid = to_regclass($1)
+  AND a.attnum > 0
+  AND NOT a.attisdropped
+ORDER BY a.attnum;
+`, relation)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
+	}
+	defer rows.Close()
+
+	ddl := &tableDDL{relation: relation}
+	if isPartition {
+		ddl.parent = qualifiedName(parentSchema, parentName)
+	}
+
+	var lines []string
+	for rows.Next() {
+		var colName, colType, defaultExpr, identity, generated, comment string
+		var notNull bool
+		if err := rows.Scan(&colName, &colType, &notNull, &defaultExpr, &identity, &generated, &comment); err != nil {
+			return nil, fmt.Errorf("%w: scanning column: %v", connection.ErrQueryFailed, err)
+		}
+
+		builder := strings.Builder{}
+		builder.WriteString(quoteIdentifier(colName))
+		builder.WriteString(" ")
+		builder.WriteString(colType)
+
+		switch {
+		case generated == "s":
+			fmt.Fprintf(&builder, " GENERATED ALWAYS AS (%s) STORED", defaultExpr)
+		case identity == "a":
+			builder.WriteString(" GENERATED ALWAYS AS IDENTITY")
+		case identity == "d":
+			builder.WriteString(" GENERATED BY DEFAULT AS IDENTITY")
+		case defaultExpr != "":
+			builder.WriteString(" DEFAULT ")
+			builder.WriteString(defaultExpr)
+		}
+
+		if notNull {
+			builder.WriteString(" NOT NULL")
+		}
+
+		// The columns of a partition, with their types and defaults, come from the parent.
+		if !isPartition {
+			lines = append(lines, builder.String())
+		}
+
+		if comment != "" {
+			ddl.comments = append(ddl.comments, fmt.Sprintf("COMMENT ON COLUMN %s.%s IS %s;", relation, quoteIdentifier(colName), quoteLiteral(comment)))
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: iterating columns: %v", connection.ErrQueryFailed, err)
+	}
+
+	// Constraints cloned from the parent of a partition (conparentid) or inherited from it
+	// (coninhcount) are recreated along with the partition.
+	constraints, err := db.QueryContext(ctx, `
+SELECT conname, contype::text, pg_get_constraintdef(oid, true)
+FROM pg_constraint
+WHERE conrelid = to_regclass($1)
+  AND conparentid = 0
+  AND (coninhcount = 0 OR NOT $2)
+ORDER BY
+    CASE contype WHEN 'p' THEN 0 WHEN 'u' THEN 1 WHEN 'c' THEN 2 ELSE 3 END,
+    conname;
+`, relation, isPartition)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
+	}
+	defer constraints.Close()
+
+	for constraints.Next() {
+		var conName, conType, definition string
+		if err := constraints.Scan(&conName, &conType, &definition); err != nil {
+			return nil, fmt.Errorf("%w: scanning constraint: %v", connection.ErrQueryFailed, err)
+		}
+
+		if conType == "f" {
+			ddl.foreignKeys = append(ddl.foreignKeys, fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s %s;", relation, quoteIdentifier(conName), definition))
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("CONSTRAINT %s %s", quoteIdentifier(conName), definition))
+	}
+
+	if err := constraints.Err(); err != nil {
+		return nil, fmt.Errorf("%w: iterating constraints: %v", connection.ErrQueryFailed, err)
+	}
+
+	var create string
+	switch {
+	case isPartition && len(lines) == 0:
+		create = fmt.Sprintf("CREATE TABLE %s PARTITION OF %s %s", relation, ddl.parent, partitionBound)
+	case isPartition:
+		create = fmt.Sprintf("CREATE TABLE %s PARTITION OF %s (\n    %s\n) %s", relation, ddl.parent, strings.Join(lines, ",\n    "), partitionBound)
+	default:
+		create = fmt.Sprintf("CREATE TABLE %s (\n    %s\n)", relation, strings.Join(lines, ",\n    "))
+	}
+	if partitionKey != "" {
+		create += " PARTITION BY " + partitionKey
+	}
+	ddl.create = create + ";"
+
+	// Indexes backing PRIMARY KEY / UNIQUE / EXCLUDE constraints are already part of the table
+	// body, and the partitions of an index on the parent are created with the partition.
+	indexes, err := h.queryStrings(ctx, db, `
+SELECT pg_get_indexdef(i.indexrelid)
+FROM pg_index i
+JOIN pg_class ic ON ic.oid = i.indexrelid
+WHERE i.indrelid = to_regclass($1)
+  AND NOT ic.relispartition
+  AND NOT EXISTS (SELECT 1 FROM pg_constraint c WHERE c.conindid = i.indexrelid AND c.contype IN ('p', 'u', 'x'))
+ORDER BY ic.relname;
+`, relation)
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range indexes {
+		ddl.indexes = append(ddl.indexes, index+";")
+	}
+
+	if tableComment != "" {
+		ddl.comments = append([]string{fmt.Sprintf("COMMENT ON TABLE %s IS %s;", relation, quoteLiteral(tableComment))}, ddl.comments...)
+	}
+
+	return ddl, nil
+}
+
+// afterParents orders the tables so that each partition comes after its parent; the others
+// keep their order.
+func afterParents(tables []*tableDDL) []*tableDDL {
+	pending := make(map[string]bool, len(tables))
+	for _, t := range tables {
+		pending[t.relation] = true
+	}
+
+	ordered := make([]*tableDDL, 0, len(tables))
+	for len(ordered) < len(tables) {
+		before := len(ordered)
+		for _, t := range tables {
+			if !pending[t.relation] || pending[t.parent] {
+				continue
+			}
+			ordered = append(ordered, t)
+			delete(pending, t.relation)
+		}
+		if len(ordered) == before {
+			break
+		}
+	}
+	return ordered
+}
+
+func (h *Gateway) viewDDL(ctx context.Context, db *sql.DB, schema, name string, kind connection.ObjectKind) (string, error) {
+	relation := qualifiedName(schema, name)
+
+	expectedKind := "v"
+	if kind == connection.ObjectKindMaterializedView {
+		expectedKind = "m"
+	}
+
+	var relkind, definition, comment string
+	err := db.QueryRowContext(ctx, `
+SELECT
+    c.relkind::text,
+    pg_get_viewdef(c.oid, true),
+    COALESCE(obj_description(c.oid, 'pg_class'), '')
+FROM pg_class c
+WHERE c.oid = to_regclass($1)
+  AND c.relkind IN ('v', 'm');
+`, relation).Scan(&relkind, &definition, &comment)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && relkind != expectedKind) {
+		return "", fmt.Errorf("%w: %s %s.%s", connection.ErrResourceNotFound, strings.ReplaceAll(kind.String(), "_", " "), schema, name)
+	}
+	if err != nil {
+		return "", fmt.Errorf("%w: reading view: %v", connection.ErrQueryFailed, err)
+	}
+
+	body := strings.TrimSuffix(strings.TrimSpace(definition), ";")
+
+	var statement string
+	if relkind == "m" {
+		statement = fmt.Sprintf("CREATE MATERIALIZED VIEW %s AS\n%s\nWITH DATA;", relation, body)
+	} else {
+		statement = fmt.Sprintf("CREATE OR REPLACE VIEW %s AS\n%s;", relation, body)
+	}
+
+	if comment != "" {
+		objectType := "VIEW"
+		if relkind == "m" {
+			objectType = "MATERIALIZED VIEW"
+		}
+		statement += fmt.Sprintf("\n\nCOMMENT ON %s %s IS %s;", objectType, relation, quoteLiteral(comment))
+	}
+
+	return statement, nil
+}
+
+func (h *Gateway) indexDDL(ctx context.Context, db *sql.DB, schema, name string) (string, error) {
+	var definition string
+	err := db.QueryRowContext(ctx, `
+SELECT pg_get_indexdef(c.oid)
+FROM pg_class c
+WHERE c.oid = to_regclass($1)
+  AND c.relkind IN ('i', 'I');
+`, qualifiedName(schema, name)).Scan(&definition)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", fmt.Errorf("%w: index %s.%s", connection.ErrResourceNotFound, schema, name)
+	}
+	if err != nil {
+		return "", fmt.Errorf("%w: reading index: %v", connection.ErrQueryFailed, err)
+	}
+
+	return definition + ";", nil
+}
+
+// functionDDL returns the definition of every overload of the named function or procedure.
+func (h *Gateway) functionDDL(ctx context.Context, db *sql.DB, schema, name string) (string, error) {
+	definitions, err := h.queryStrings(ctx, db, `
+SELECT pg_get_functiondef(p.oid)
+FROM pg_proc p
+JOIN pg_namespace n ON n.oid = p.pronamespace
+WHERE n.nspname = $1
+  AND p.proname = $2
+  AND p.prokind IN ('f', 'p', 'w')
+ORDER BY p.oid;
+`, schema, name)
+	if err != nil {
+		return "", err
+	}
+	if len(definitions) == 0 {
+		return "", fmt.Errorf("%w: function %s.%s", connection.ErrResourceNotFound, schema, name)
+	}
+
+	for i, definition := range definitions {
+		definitions[i] = strings.TrimSpace(definition) + ";"
+	}
+
+	return strings.Join(definitions, "\n\n"), nil
+}
+
+// schemaFunctions lists the names of the functions and procedures of a schema, leaving out
+// the ones that belong to extensions.
+func (h *Gateway) schemaFunctions(ctx context.Context, db *sql.DB, schema string) ([]string, error) {
+	return h.queryStrings(ctx, db, `
+SELECT DISTINCT p.proname
+FROM pg_proc p
+JOIN pg_namespace n ON n.oid = p.pronamespace
+WHERE n.nspname = $1
+  AND p.prokind IN ('f', 'p', 'w')
+  AND NOT EXISTS (SELECT 1 FROM pg_depend d WHERE d.objid = p.oid AND d.deptype = 'e')
+ORDER BY p.proname;
+`, schema)
+}
+
+// triggerDDL returns the definition of every trigger with the given name in the schema,
+// since trigger names are only unique per table. The clones of a trigger on a partitioned
+// table, which its partitions get automatically, are left out.
+func (h *Gateway) triggerDDL(ctx context.Context, db *sql.DB, schema, name string) (string, error) {
+	definitions, err := h.queryStrings(ctx, db, `
+SELECT pg_get_triggerdef(t.oid, true)
+FROM pg_trigger t
+JOIN pg_class c ON c.oid = t.tgrelid
+JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE n.nspname = $1
+  AND t.tgname = $2
+  AND NOT t.tgisinternal
+  AND NOT EXISTS (
+    SELECT 1 FROM pg_depend d
+    WHERE d.classid = 'pg_trigger'::regclass AND d.objid = t.oid AND d.deptype = 'P'
+  )
+ORDER BY c.relname;
+`, schema, name)
+	if err != nil {
+		return "", err
+	}
+	if len(definitions) == 0 {
+		return "", fmt.Errorf("%w: trigger %s.%s", connection.ErrResourceNotFound, schema, name)
+	}
+
+	for i, definition := range definitions {
+		definitions[i] = definition + ";"
+	}
+
+	return strings.Join(definitions, "\n\n"), nil
+}
+
+// sequencesDDL recreates the standalone sequences of a schema. Sequences backing identity
+// columns are skipped since the table definition already generates them.
+func (h *Gateway) sequencesDDL(ctx context.Context, db *sql.DB, schema string) ([]string, error) {
+	rows, err := db.QueryContext(ctx, `
+SELECT
+    s.sequencename,
+    format_type(s.data_type, NULL),
+    s.start_value,
+    s.increment_by,
+    s.min_value,
+    s.max_value,
+    s.cache_size,
+    s.cycle
+FROM pg_sequences s
+WHERE s.schemaname = $1
+  AND NOT EXISTS (
+    SELECT 1
+    FROM pg_depend d
+    WHERE d.objid = to_regclass(format('%I.%I', s.schemaname, s.sequencename))
+      AND d.deptype = 'i'
+  )
+ORDER BY s.sequencename;
+`, schema)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
+	}
+	defer rows.Close()
+
+	var statements []string
+	for rows.Next() {
+		var name, dataType string
+		var start, increment, minValue, maxValue, cache int64
+		var cycle bool
+		if err := rows.Scan(&name, &dataType, &start, &increment, &minValue, &maxValue, &cache, &cycle); err != nil {
+			return nil, fmt.Errorf("%w: scanning sequence: %v", connection.ErrQueryFailed, err)
+		}
+
+		cycleKeyword := "NO CYCLE"
+		if cycle {
+			cycleKeyword = "CYCLE"
+		}
+
+		statements = append(statements, fmt.Sprintf(
+			"CREATE SEQUENCE IF NOT EXISTS %s AS %s START WITH %d INCREMENT BY %d MINVALUE %d MAXVALUE %d CACHE %d %s;",
+			qualifiedName(schema, name), dataType, start, increment, minValue, maxValue, cache, cycleKeyword,
+		))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: iterating sequences: %v", connection.ErrQueryFailed, err)
+	}
+
+	return statements, nil
+}
+
+type relationName struct {
+	name    string
+	relkind string
+}
+
+// schemaRelations lists the relations of the given kinds in creation order, which keeps
+// views that depend on other views after their dependencies.
+func (h *Gateway) schemaRelations(ctx context.Context, db *sql.DB, schema string, kinds ...string) ([]relationName, error) {
+	rows, err := db.QueryContext(ctx, `
+SELECT c.relname, c.relkind::text
+FROM pg_class c
+JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE n.nspname = $1
+  AND c.relkind::text = ANY($2)
+ORDER BY c.oid;
+`, schema, pq.Array(kinds))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
+	}
+	defer rows.Close()
+
+	var relations []relationName
+	for rows.Next() {
+		var relation relationName
+		if err := rows.Scan(&relation.name, &relation.relkind); err != nil {
+			return nil, fmt.Errorf("%w: scanning relation: %v", connection.ErrQueryFailed, err)
+		}
+		relations = append(relations, relation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: iterating relations: %v", connection.ErrQueryFailed, err)
+	}
+
+	return relations, nil
+}
+
+// queryStrings runs a query returning a single text column and collects its values.
+func (h *Gateway) queryStrings(ctx context.Context, db *sql.DB, query string, args ...any) ([]string, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
+	}
+	defer rows.Close()
+
+	var values []string
+	for rows.Next() {
+		var value string
+		if err := rows.Scan(&value); err != nil {
+			return nil, fmt.Errorf("%w: scanning row: %v", connection.ErrQueryFailed, err)
+		}
+		values = append(values, value)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: iterating rows: %v", connection.ErrQueryFailed, err)
+	}
+
+	return values, nil
+}
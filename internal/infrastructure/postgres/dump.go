@@ -0,0 +1,659 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lib/pq"
+)
+
+// --- Dump & Restore Implementation ---
+
+// dumpPlan is what a dump contains: every table of the user schemas or only the requested ones.
+type dumpPlan struct {
+	partial   bool
+	schemas   []string
+	relations []dumpRelation
+}
+
+type dumpRelation struct {
+	table   connection.DumpTable
+	relkind string
+}
+
+func (r dumpRelation) qualified() string {
+	return qualifiedName(r.table.Schema, r.table.Name)
+}
+
+func (p *dumpPlan) qualifiedNames() []string {
+	names := make([]string, len(p.relations))
+	for i, relation := range p.relations {
+		names[i] = relation.qualified()
+	}
+	return names
+}
+
+// DumpDatabase reads the schema through the catalog and then copies every table from a single
+// REPEATABLE READ transaction, so the rows of all tables, and the sequence values, come from
+// the same snapshot.
+func (h *Gateway) DumpDatabase(ctx context.Context, conn connection.Connection, password string, dbName connection.Identifier, scope connection.DumpScope, w connection.DumpWriter, observer connection.MaintenanceObserver) error {
+	db, err := h.connect(conn, password, dbName)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	plan, err := h.dumpPlan(ctx, db, scope)
+	if err != nil {
+		return err
+	}
+
+	preData, postData, err := h.dumpScripts(ctx, db, plan)
+	if err != nil {
+		return err
+	}
+
+	session, err := h.session(ctx, conn, password, dbName, observer)
+	if err != nil {
+		return err
+	}
+	defer session.Close(context.WithoutCancel(ctx))
+	observer.Started(int(session.PgConn().PID()))
+
+	tx, err := session.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return fmt.Errorf("%w: starting dump transaction: %v", connection.ErrQueryFailed, err)
+	}
+	defer tx.Rollback(context.WithoutCancel(ctx))
+
+	// Partitioned tables hold no rows of their own: the data is copied from each partition.
+	for _, relation := range plan.relations {
+		if relation.relkind != "r" {
+			continue
+		}
+
+		out, err := w.WriteTable(relation.table)
+		if err != nil {
+			return err
+		}
+		tag, err := session.PgConn().CopyTo(ctx, out, copyStatement(relation, "TO STDOUT"))
+		if err != nil {
+			return fmt.Errorf("%w: copying %s: %v", connection.ErrQueryFailed, relation.qualified(), err)
+		}
+		observer.Notice(fmt.Sprintf("dumped %s.%s: %d rows", relation.table.Schema, relation.table.Name, tag.RowsAffected()))
+	}
+
+	sequences, err := sequenceValues(ctx, tx, plan)
+	if err != nil {
+		return err
+	}
+	postData = append(postData, sequences...)
+
+	sections := map[connection.DumpSection][]string{
+		connection.DumpClean:    plan.cleanStatements(),
+		connection.DumpPreData:  preData,
+		connection.DumpPostData: postData,
+	}
+	for _, section := range connection.DumpSections {
+		if err := w.WriteSection(section, joinStatements(sections[section])); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RestoreDatabase runs the whole restore in one transaction: a failure leaves the database as
+// it was. User triggers and foreign keys are only created after the rows are loaded.
+func (h *Gateway) RestoreDatabase(ctx context.Context, conn connection.Connection, password string, dbName connection.Identifier, dump connection.DumpReader, opts connection.RestoreOptions, observer connection.MaintenanceObserver) error {
+	session, err := h.session(ctx, conn, password, dbName, observer)
+	if err != nil {
+		return err
+	}
+	defer session.Close(context.WithoutCancel(ctx))
+	observer.Started(int(session.PgConn().PID()))
+
+	tx, err := session.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: starting restore transaction: %v", connection.ErrQueryFailed, err)
+	}
+	defer tx.Rollback(context.WithoutCancel(ctx))
+
+	// Function bodies may reference tables that are created later in the script, as in pg_dump.
+	if _, err := tx.Exec(ctx, "SET LOCAL check_function_bodies = false"); err != nil {
+		return fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
+	}
+
+	if opts.Clean {
+		if err := runDumpSection(ctx, tx, dump, connection.DumpClean); err != nil {
+			return err
+		}
+	}
+	if err := runDumpSection(ctx, tx, dump, connection.DumpPreData); err != nil {
+		return err
+	}
+
+	for i, table := range dump.Tables() {
+		data, err := dump.OpenTable(i)
+		if err != nil {
+			return err
+		}
+		relation := dumpRelation{table: table, relkind: "r"}
+		tag, err := session.PgConn().CopyFrom(ctx, data, copyStatement(relation, "FROM STDIN"))
+		data.Close()
+		if err != nil {
+			return restoreError(fmt.Sprintf("loading %s", relation.qualified()), err)
+		}
+		observer.Notice(fmt.Sprintf("restored %s.%s: %d rows", table.Schema, table.Name, tag.RowsAffected()))
+	}
+
+	if err := runDumpSection(ctx, tx, dump, connection.DumpPostData); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return restoreError("committing restore", err)
+	}
+	return nil
+}
+
+// session opens a dedicated connection whose server messages go to the observer, if any.
+func (h *Gateway) session(ctx context.Context, conn connection.Connection, password string, dbName connection.Identifier, observer connection.MaintenanceObserver) (*pgx.Conn, error) {
+	config, err := h.config(conn, password, dbName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", connection.ErrInvalidConfiguration, err)
+	}
+	if observer != nil {
+		config.OnNotice = func(_ *pgconn.PgConn, notice *pgconn.Notice) {
+			observer.Notice(notice.Message)
+		}
+	}
+
+	session, err := pgx.ConnectConfig(ctx, config)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", connection.ErrConnectionFailed, err)
+	}
+	return session, nil
+}
+
+func (h *Gateway) dumpPlan(ctx context.Context, db *sql.DB, scope connection.DumpScope) (*dumpPlan, error) {
+	plan := &dumpPlan{partial: len(scope.Tables) > 0}
+
+	var names []string
+	if plan.partial {
+		for _, table := range scope.Tables {
+			names = append(names, qualifiedName(table.Schema.String(), table.Name.String()))
+		}
+
+		// The rows of a partitioned table live in its partitions, which come along with it.
+		// Names are quoted like qualifiedName to tell the ones already requested.
+		partitions, err := h.queryStrings(ctx, db, `
+WITH RECURSIVE tree AS (
+    SELECT i.inhrelid AS oid
+    FROM pg_inherits i
+    WHERE i.inhparent IN (SELECT to_regclass(name) FROM unnest($1::text[]) AS name)
+    UNION
+    SELECT i.inhrelid
+    FROM pg_inherits i
+    JOIN tree t ON i.inhparent = t.oid
+)
+SELECT format('"%s"."%s"', replace(n.nspname, '"', '""'), replace(c.relname, '"', '""'))
+FROM tree
+JOIN pg_class c ON c.oid = tree.oid
+JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE c.relispartition
+  AND c.relkind IN ('r', 'p')
+ORDER BY n.nspname, c.relname;
+`, pq.Array(names))
+		if err != nil {
+			return nil, err
+		}
+		requested := make(map[string]bool, len(names))
+		for _, name := range names {
+			requested[name] = true
+		}
+		for _, partition := range partitions {
+			if !requested[partition] {
+				names = append(names, partition)
+			}
+		}
+	} else {
+		schemas, err := h.queryStrings(ctx, db, `
+SELECT n.nspname
+FROM pg_namespace n
+WHERE n.nspname NOT IN ('pg_catalog', 'information_schema', 'pg_toast')
+  AND n.nspname NOT LIKE 'pg\_temp\_%'
+  AND n.nspname NOT LIKE 'pg\_toast\_temp\_%'
+  AND NOT EXISTS (SELECT 1 FROM pg_depend d WHERE d.objid = n.oid AND d.deptype = 'e')
+ORDER BY n.nspname;
+`)
+		if err != nil {
+			return nil, err
+		}
+		plan.schemas = schemas
+
+		names, err = h.queryStrings(ctx, db, `
+SELECT format('%I.%I', n.nspname, c.relname)
+FROM pg_class c
+JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE n.nspname = ANY($1)
+  AND c.relkind IN ('r', 'p')
+  AND NOT EXISTS (SELECT 1 FROM pg_depend d WHERE d.objid = c.oid AND d.deptype = 'e')
+ORDER BY n.nspname, c.relname;
+`, pq.Array(schemas))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err := db.QueryContext(ctx, `
+SELECT
+    r.name,
+    n.nspname,
+    c.relname,
+    c.relkind::text,
+    ARRAY(
+        SELECT a.attname::text
+        FROM pg_attribute a
+        WHERE a.attrelid = c.oid
+          AND a.attnum > 0
+          AND NOT a.attisdropped
+          AND a.attgenerated = ''
+        ORDER BY a.attnum
+    )
+FROM unnest($1::text[]) WITH ORDINALITY AS r(name, ord)
+LEFT JOIN pg_class c ON c.oid = to_regclass(r.name) AND c.relkind IN ('r', 'p')
+LEFT JOIN pg_namespace n ON n.oid = c.relnamespace
+ORDER BY r.ord;
+`, pq.Array(names))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
+	}
+	defer rows.Close()
+
+	seen := make(map[string]bool)
+	for rows.Next() {
+		var name string
+		var schema, table, relkind sql.NullString
+		var columns []string
+		if err := rows.Scan(&name, &schema, &table, &relkind, pq.Array(&columns)); err != nil {
+			return nil, fmt.Errorf("%w: scanning table: %v", connection.ErrQueryFailed, err)
+		}
+		if !schema.Valid {
+			return nil, fmt.Errorf("%w: table %s", connection.ErrResourceNotFound, name)
+		}
+
+		plan.relations = append(plan.relations, dumpRelation{
+			table:   connection.DumpTable{Schema: schema.String, Name: table.String, Columns: columns},
+			relkind: relkind.String,
+		})
+		if plan.partial && !seen[schema.String] {
+			seen[schema.String] = true
+			plan.schemas = append(plan.schemas, schema.String)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: iterating tables: %v", connection.ErrQueryFailed, err)
+	}
+
+	return plan, nil
+}
+
+// dumpScripts builds the pre-data and post-data statements of the plan. A partial dump only
+// brings along what its tables need, written so that it can be loaded next to existing objects.
+func (h *Gateway) dumpScripts(ctx context.Context, db *sql.DB, plan *dumpPlan) ([]string, []string, error) {
+	var preData, postData []string
+
+	for _, schema := range plan.schemas {
+		preData = append(preData, fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s;", quoteIdentifier(schema)))
+	}
+
+	if !plan.partial {
+		extensions, err := h.queryStrings(ctx, db, `
+SELECT format('CREATE EXTENSION IF NOT EXISTS %I WITH SCHEMA %I;', e.extname, n.nspname)
+FROM pg_extension e
+JOIN pg_namespace n ON n.oid = e.extnamespace
+WHERE e.extname <> 'plpgsql'
+ORDER BY e.extname;
+`)
+		if err != nil {
+			return nil, nil, err
+		}
+		preData = append(preData, extensions...)
+	}
+
+	for _, schema := range plan.schemas {
+		types, err := h.userTypes(ctx, db, schema)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, t := range types {
+			statement := typeDDL(t)
+			if plan.partial {
+				statement = fmt.Sprintf("DO $mesa$ BEGIN\n%s\nEXCEPTION WHEN duplicate_object THEN NULL;\nEND $mesa$;", statement)
+			}
+			preData = append(preData, statement)
+		}
+
+		sequences, err := h.sequencesDDL(ctx, db, schema)
+		if err != nil {
+			return nil, nil, err
+		}
+		preData = append(preData, sequences...)
+	}
+
+	functions, err := h.dumpFunctions(ctx, db, plan)
+	if err != nil {
+		return nil, nil, err
+	}
+	preData = append(preData, functions...)
+
+	ddls := make([]*tableDDL, 0, len(plan.relations))
+	for _, relation := range plan.relations {
+		ddl, err := h.tableDDL(ctx, db, relation.table.Schema, relation.table.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+		ddls = append(ddls, ddl)
+	}
+	// Partitions are created attached to their parent, so the rows copied into them are the
+	// rows of the partitioned table after a restore.
+	for _, ddl := range afterParents(ddls) {
+		preData = append(preData, ddl.create)
+		preData = append(preData, ddl.comments...)
+		postData = append(postData, ddl.indexes...)
+	}
+
+	// Only the foreign keys between dumped tables can be recreated. The clones of a foreign key
+	// on a partitioned table come back with it.
+	foreignKeys, err := h.queryStrings(ctx, db, `
+SELECT format('ALTER TABLE %I.%I ADD CONSTRAINT %I %s;', n.nspname, c.relname, con.conname, pg_get_constraintdef(con.oid, true))
+FROM pg_constraint con
+JOIN pg_class c ON c.oid = con.conrelid
+JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE con.contype = 'f'
+  AND con.conparentid = 0
+  AND con.conrelid = ANY($1::text[]::regclass[])
+  AND con.confrelid = ANY($1::text[]::regclass[])
+ORDER BY n.nspname, c.relname, con.conname;
+`, pq.Array(plan.qualifiedNames()))
+	if err != nil {
+		return nil, nil, err
+	}
+	postData = append(postData, foreignKeys...)
+
+	if !plan.partial {
+		views, err := h.dumpViews(ctx, db, plan.schemas)
+		if err != nil {
+			return nil, nil, err
+		}
+		postData = append(postData, views...)
+	}
+
+	triggers, err := h.queryStrings(ctx, db, `
+SELECT pg_get_triggerdef(t.oid, true) || ';'
+FROM pg_trigger t
+WHERE t.tgrelid = ANY($1::text[]::regclass[])
+  AND NOT t.tgisinternal
+  AND NOT EXISTS (
+    SELECT 1 FROM pg_depend d
+    WHERE d.classid = 'pg_trigger'::regclass AND d.objid = t.oid AND d.deptype = 'P'
+  )
+ORDER BY t.tgrelid, t.tgname;
+`, pq.Array(plan.qualifiedNames()))
+	if err != nil {
+		return nil, nil, err
+	}
+	postData = append(postData, triggers...)
+
+	return preData, postData, nil
+}
+
+// dumpFunctions returns every function of the dumped schemas or, for a partial dump, the
+// functions called by the triggers of its tables.
+func (h *Gateway) dumpFunctions(ctx context.Context, db *sql.DB, plan *dumpPlan) ([]string, error) {
+	type function struct{ schema, name string }
+
+	var functions []function
+	if plan.partial {
+		rows, err := db.QueryContext(ctx, `
+SELECT DISTINCT n.nspname, p.proname
+FROM pg_trigger t
+JOIN pg_proc p ON p.oid = t.tgfoid
+JOIN pg_namespace n ON n.oid = p.pronamespace
+WHERE t.tgrelid = ANY($1::text[]::regclass[])
+  AND NOT t.tgisinternal
+  AND NOT EXISTS (SELECT 1 FROM pg_depend d WHERE d.objid = p.oid AND d.deptype = 'e')
+ORDER BY n.nspname, p.proname;
+`, pq.Array(plan.qualifiedNames()))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var fn function
+			if err := rows.Scan(&fn.schema, &fn.name); err != nil {
+				return nil, fmt.Errorf("%w: scanning function: %v", connection.ErrQueryFailed, err)
+			}
+			functions = append(functions, fn)
+		}
+
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("%w: iterating functions: %v", connection.ErrQueryFailed, err)
+		}
+	} else {
+		for _, schema := range plan.schemas {
+			names, err := h.schemaFunctions(ctx, db, schema)
+			if err != nil {
+				return nil, err
+			}
+			for _, name := range names {
+				functions = append(functions, function{schema: schema, name: name})
+			}
+		}
+	}
+
+	statements := make([]string, 0, len(functions))
+	for _, fn := range functions {
+		definition, err := h.functionDDL(ctx, db, fn.schema, fn.name)
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, definition)
+	}
+	return statements, nil
+}
+
+// dumpViews returns the views and materialized views of the schemas in creation order, so
+// views built on other views come after them even across schemas.
+func (h *Gateway) dumpViews(ctx context.Context, db *sql.DB, schemas []string) ([]string, error) {
+	rows, err := db.QueryContext(ctx, `
+SELECT n.nspname, c.relname, c.relkind::text
+FROM pg_class c
+JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE n.nspname = ANY($1)
+  AND c.relkind IN ('v', 'm')
+  AND NOT EXISTS (SELECT 1 FROM pg_depend d WHERE d.objid = c.oid AND d.deptype = 'e')
+ORDER BY c.oid;
+`, pq.Array(schemas))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
+	}
+	defer rows.Close()
+
+	type view struct{ schema, name, relkind string }
+	var views []view
+	for rows.Next() {
+		var v view
+		if err := rows.Scan(&v.schema, &v.name, &v.relkind); err != nil {
+			return nil, fmt.Errorf("%w: scanning view: %v", connection.ErrQueryFailed, err)
+		}
+		views = append(views, v)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: iterating views: %v", connection.ErrQueryFailed, err)
+	}
+
+	statements := make([]string, 0, len(views))
+	for _, v := range views {
+		kind := connection.ObjectKindView
+		if v.relkind == "m" {
+			kind = connection.ObjectKindMaterializedView
+		}
+
+		definition, err := h.viewDDL(ctx, db, v.schema, v.name, kind)
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, definition)
+	}
+	return statements, nil
+}
+
+// sequenceValues reads the sequences of the dumped schemas and returns the setval calls that
+// restore them. Sequences owned by a column are addressed through pg_get_serial_sequence,
+// since identity sequences get a new name when the table is recreated. A partial dump only
+// moves standalone sequences forward, as other tables may draw from them too.
+func sequenceValues(ctx context.Context, tx pgx.Tx, plan *dumpPlan) ([]string, error) {
+	rows, err := tx.Query(ctx, `
+SELECT
+    s.schemaname,
+    s.sequencename,
+    s.last_value,
+    COALESCE(tn.nspname, ''),
+    COALESCE(t.relname, ''),
+    COALESCE(a.attname, '')
+FROM pg_sequences s
+LEFT JOIN pg_depend d
+    ON d.objid = to_regclass(format('%I.%I', s.schemaname, s.sequencename))
+   AND d.classid = 'pg_class'::regclass
+   AND d.refclassid = 'pg_class'::regclass
+   AND d.deptype IN ('a', 'i')
+LEFT JOIN pg_class t ON t.oid = d.refobjid
+LEFT JOIN pg_namespace tn ON tn.oid = t.relnamespace
+LEFT JOIN pg_attribute a ON a.attrelid = d.refobjid AND a.attnum = d.refobjsubid
+WHERE s.schemaname = ANY($1::text[])
+  AND s.last_value IS NOT NULL
+ORDER BY s.schemaname, s.sequencename;
+`, plan.schemas)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
+	}
+	defer rows.Close()
+
+	dumped := make(map[string]bool, len(plan.relations))
+	for _, relation := range plan.relations {
+		dumped[relation.qualified()] = true
+	}
+
+	var statements []string
+	for rows.Next() {
+		var schema, name, ownerSchema, ownerTable, ownerColumn string
+		var value int64
+		if err := rows.Scan(&schema, &name, &value, &ownerSchema, &ownerTable, &ownerColumn); err != nil {
+			return nil, fmt.Errorf("%w: scanning sequence: %v", connection.ErrQueryFailed, err)
+		}
+
+		sequence := qualifiedName(schema, name)
+		switch {
+		case ownerTable != "":
+			owner := qualifiedName(ownerSchema, ownerTable)
+			if !dumped[owner] {
+				continue
+			}
+			statements = append(statements, fmt.Sprintf(
+				"SELECT pg_catalog.setval(pg_catalog.pg_get_serial_sequence(%s, %s), %d, true);",
+				quoteLiteral(owner), quoteLiteral(ownerColumn), value,
+			))
+		case plan.partial:
+			statements = append(statements, fmt.Sprintf(
+				"SELECT pg_catalog.setval(%s, GREATEST(%d, (SELECT last_value FROM %s)), true);",
+				quoteLiteral(sequence), value, sequence,
+			))
+		default:
+			statements = append(statements, fmt.Sprintf("SELECT pg_catalog.setval(%s, %d, true);", quoteLiteral(sequence), value))
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: iterating sequences: %v", connection.ErrQueryFailed, err)
+	}
+
+	return statements, nil
+}
+
+// cleanStatements drops the dumped schemas or, for a partial dump, the dumped tables. CASCADE
+// also removes what depends on them, such as views and foreign keys of other tables.
+func (p *dumpPlan) cleanStatements() []string {
+	if p.partial {
+		statements := make([]string, len(p.relations))
+		for i, relation := range p.relations {
+			statements[i] = fmt.Sprintf("DROP TABLE IF EXISTS %s CASCADE;", relation.qualified())
+		}
+		return statements
+	}
+
+	statements := make([]string, len(p.schemas))
+	for i, schema := range p.schemas {
+		statements[i] = fmt.Sprintf("DROP SCHEMA IF EXISTS %s CASCADE;", quoteIdentifier(schema))
+	}
+	return statements
+}
+
+func runDumpSection(ctx context.Context, tx pgx.Tx, dump connection.DumpReader, section connection.DumpSection) error {
+	script, err := dump.Section(section)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(script) == "" {
+		return nil
+	}
+
+	// Without arguments pgx uses the simple protocol, which accepts the whole script at once.
+	if _, err := tx.Exec(ctx, script); err != nil {
+		return restoreError(fmt.Sprintf("running %s", section), err)
+	}
+	return nil
+}
+
+func copyStatement(relation dumpRelation, direction string) string {
+	if len(relation.table.Columns) == 0 {
+		return fmt.Sprintf("COPY %s %s", relation.qualified(), direction)
+	}
+
+	columns := make([]string, len(relation.table.Columns))
+	for i, column := range relation.table.Columns {
+		columns[i] = quoteIdentifier(column)
+	}
+	return fmt.Sprintf("COPY %s (%s) %s", relation.qualified(), strings.Join(columns, ", "), direction)
+}
+
+func joinStatements(statements []string) string {
+	if len(statements) == 0 {
+		return ""
+	}
+	return strings.Join(statements, "\n\n") + "\n"
+}
+
+// restoreError maps the SQLSTATEs a restore is expected to hit to domain errors.
+func restoreError(action string, err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "42501": // insufficient_privilege
+			return fmt.Errorf("%w: %s", connection.ErrPermissionDenied, pgErr.Message)
+		case "42P07", "42710", "42723": // duplicate_table, duplicate_object, duplicate_function
+			return fmt.Errorf("%w: %s", connection.ErrDumpConflict, pgErr.Message)
+		}
+	}
+	return fmt.Errorf("%w: %s: %v", connection.ErrQueryFailed, action, err)
+}
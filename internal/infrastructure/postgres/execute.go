@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/google/uuid"
+)
+
+// ExecuteStatement runs the statement on its own session through the extended protocol, so
+// the arguments are always sent apart from the SQL and a single statement is accepted.
+func (h *Gateway) ExecuteStatement(ctx context.Context, conn connection.Connection, password string, dbName connection.Identifier, statement string, args []any, maxRows int) (*connection.QueryResult, error) {
+	session, err := h.session(ctx, conn, password, dbName, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer session.Close(context.WithoutCancel(ctx))
+
+	start := time.Now()
+	rows, err := session.Query(ctx, statement, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
+	}
+	defer rows.Close()
+
+	fields := rows.FieldDescriptions()
+	result := &connection.QueryResult{
+		Columns: make([]string, len(fields)),
+		Rows:    [][]any{},
+	}
+	for i, field := range fields {
+		result.Columns[i] = field.Name
+	}
+
+	for rows.Next() {
+		if len(result.Rows) >= maxRows {
+			result.Truncated = true
+			break
+		}
+
+		values, err := rows.Values()
+		if err != nil {
+			return nil, fmt.Errorf("%w: reading row: %v", connection.ErrQueryFailed, err)
+		}
+		for i, value := range values {
+			values[i] = resultValue(value)
+		}
+		result.Rows = append(result.Rows, values)
+	}
+	// Close reads the rest of the result, so the command tag counts every row.
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
+	}
+	result.RowsAffected = rows.CommandTag().RowsAffected()
+	result.Duration = time.Since(start)
+
+	return result, nil
+}
+
+// resultValue converts the values decoded by pgx into ones that encode well as JSON, ex: a
+// uuid comes as [16]byte and a numeric as pgtype.Numeric.
+func resultValue(value any) any {
+	switch v := value.(type) {
+	case [16]byte:
+		return uuid.UUID(v).String()
+	case []byte:
+		return string(v)
+	case driver.Valuer:
+		converted, err := v.Value()
+		if err != nil {
+			return fmt.Sprint(value)
+		}
+		return converted
+	}
+	return value
+}
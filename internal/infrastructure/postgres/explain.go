@@ -0,0 +1,46 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+)
+
+// Explain runs EXPLAIN (FORMAT JSON) for the statement. The statement always runs inside a
+// transaction that is rolled back, which undoes the rows EXPLAIN ANALYZE writes but not what is
+// outside the transaction: sequence advances, signalled backends or statements sent by dblink.
+func (h *Gateway) Explain(ctx context.Context, conn connection.Connection, password string, dbName connection.Identifier, statement string, opts connection.ExplainOptions) (*connection.QueryPlan, error) {
+	db, err := h.connect(conn, password, dbName)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	options := []string{"FORMAT JSON"}
+	if opts.Analyze {
+		options = append(options, "ANALYZE")
+	}
+	if opts.Buffers {
+		options = append(options, "BUFFERS")
+	}
+	if opts.Verbose {
+		options = append(options, "VERBOSE")
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: begin transaction: %v", connection.ErrQueryFailed, err)
+	}
+	defer tx.Rollback()
+
+	query := fmt.Sprintf("EXPLAIN (%s) %s", strings.Join(options, ", "), statement)
+
+	var raw []byte
+	if err := tx.QueryRowContext(ctx, query).Scan(&raw); err != nil {
+		return nil, fmt.Errorf("%w: explain: %v", connection.ErrQueryFailed, err)
+	}
+
+	return connection.ParseJSONPlan(raw)
+}
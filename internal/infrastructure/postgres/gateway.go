@@ -4,25 +4,35 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"log"
+	"log/slog"
+	"net"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/felipemalacarne/mesa/internal/domain/connection"
-	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/lib/pq"
 )
 
+// TunnelDialer abre conexões de rede pelo túnel SSH de uma conexão.
+type TunnelDialer interface {
+	DialContext(ctx context.Context, tunnel connection.SSHTunnel, network, addr string) (net.Conn, error)
+}
+
 // Gateway implementa o contrato de runtime e inspeção para Postgres.
-type Gateway struct{}
+type Gateway struct {
+	tunnels TunnelDialer
+}
 
-func NewGateway() connection.Gateway {
-	return &Gateway{}
+func NewGateway(tunnels TunnelDialer) connection.Gateway {
+	return &Gateway{tunnels: tunnels}
 }
 
-func (h *Gateway) connect(conn connection.Connection, password string, dbName connection.Identifier) (*sql.DB, error) {
-	dsn := fmt.Sprintf(
+func (h *Gateway) dsn(conn connection.Connection, password string, dbName connection.Identifier) string {
+	return fmt.Sprintf(
 		"postgres://%s:%s@%s:%d/%s?sslmode=disable&connect_timeout=5",
 		conn.Username,
 		password,
@@ -30,12 +40,41 @@ func (h *Gateway) connect(conn connection.Connection, password string, dbName co
 		conn.Port,
 		dbName.String(),
 	)
+}
 
-	db, err := sql.Open("pgx", dsn)
+// config builds the session configuration. Sessions of read-only connections start with
+// default_transaction_read_only, so every transaction they open, implicit or not, is read-only
+// and the server itself rejects writes. Connections behind a bastion are dialed through it.
+func (h *Gateway) config(conn connection.Connection, password string, dbName connection.Identifier) (*pgx.ConnConfig, error) {
+	cfg, err := pgx.ParseConfig(h.dsn(conn, password, dbName))
+	if err != nil {
+		return nil, err
+	}
+	cfg.Tracer = queryTracer{}
+	if conn.ReadOnly {
+		cfg.RuntimeParams["default_transaction_read_only"] = "on"
+	}
+	if conn.Tunnel != nil {
+		tunnel := *conn.Tunnel
+		cfg.DialFunc = func(ctx context.Context, network, addr string) (net.Conn, error) {
+			return h.tunnels.DialContext(ctx, tunnel, network, addr)
+		}
+		// The host is resolved by the bastion: it is often a name only visible from there.
+		cfg.LookupFunc = func(_ context.Context, host string) ([]string, error) {
+			return []string{host}, nil
+		}
+	}
+	return cfg, nil
+}
+
+func (h *Gateway) connect(conn connection.Connection, password string, dbName connection.Identifier) (*sql.DB, error) {
+	cfg, err := h.config(conn, password, dbName)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", connection.ErrConnectionFailed, err)
 	}
 
+	db := stdlib.OpenDB(*cfg)
+
 	// Just opening the pool doesn't verify the connection.
 	// We might want to Ping here if we want immediate feedback,
 	// but usually we let the first query fail.
@@ -61,8 +100,23 @@ SELECT
     d.datname,
     pg_get_userbyid(d.datdba) as owner,
     pg_encoding_to_char(d.encoding) as encoding,
-    pg_database_size(d.datname) as size_bytes
+    d.datcollate,
+    d.datctype,
+    COALESCE(t.spcname, ''),
+    d.datconnlimit,
+    pg_database_size(d.datname) as size_bytes,
+    COALESCE(s.numbackends, 0),
+    COALESCE(s.xact_commit, 0),
+    COALESCE(s.xact_rollback, 0),
+    a.last_activity
 FROM pg_database d
+LEFT JOIN pg_tablespace t ON t.oid = d.dattablespace
+LEFT JOIN pg_stat_database s ON s.datid = d.oid
+LEFT JOIN LATERAL (
+    SELECT max(sa.state_change) AS last_activity
+    FROM pg_stat_activity sa
+    WHERE sa.datid = d.oid AND sa.pid <> pg_backend_pid()
+) a ON true
 WHERE d.datistemplate = false
   AND d.datallowconn = true
 ORDER BY d.datname;
@@ -77,10 +131,15 @@ ORDER BY d.datname;
 	var databases []connection.Database
 	for rows.Next() {
 		var database connection.Database
-		if err := rows.Scan(&database.Name, &database.Owner, &database.Encoding, &database.Size); err != nil {
+		var lastActivity sql.NullTime
+		if err := rows.Scan(
+			&database.Name, &database.Owner, &database.Encoding, &database.Collation, &database.Ctype,
+			&database.Tablespace, &database.ConnLimit, &database.Size,
+			&database.Connections, &database.Commits, &database.Rollbacks, &lastActivity,
+		); err != nil {
 			return nil, fmt.Errorf("%w: scanning database: %v", connection.ErrQueryFailed, err)
 		}
-		database.TableCount = 0
+		database.LastActivity = nullTime(lastActivity)
 		databases = append(databases, database)
 	}
 
@@ -88,9 +147,64 @@ ORDER BY d.datname;
 		return nil, fmt.Errorf("%w: iterating databases: %v", connection.ErrQueryFailed, err)
 	}
 
+	h.countTables(ctx, conn, password, databases)
+
 	return databases, nil
 }
 
+// maxConcurrentTableCounts limits how many databases are connected to at once by countTables.
+const maxConcurrentTableCounts = 4
+
+// countTables fills TableCount with the number of user tables of each database. pg_class is
+// per database, so each one needs its own connection; the ones that fail are left as nil.
+func (h *Gateway) countTables(ctx context.Context, conn connection.Connection, password string, databases []connection.Database) {
+	sem := make(chan struct{}, maxConcurrentTableCounts)
+	var wg sync.WaitGroup
+	for i := range databases {
+		dbName, err := connection.NewIdentifier(databases[i].Name)
+		if err != nil {
+			continue
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(database *connection.Database) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			count, err := h.countUserTables(ctx, conn, password, dbName)
+			if err != nil {
+				slog.DebugContext(ctx, "counting tables", "database", database.Name, "error", err)
+				return
+			}
+			database.TableCount = &count
+		}(&databases[i])
+	}
+	wg.Wait()
+}
+
+func (h *Gateway) countUserTables(ctx context.Context, conn connection.Connection, password string, dbName connection.Identifier) (int, error) {
+	db, err := h.connect(conn, password, dbName)
+	if err != nil {
+		return 0, err
+	}
+	defer db.Close()
+
+	var count int
+	err = db.QueryRowContext(ctx, `
+SELECT count(*)
+FROM pg_class c
+JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE c.relkind IN ('r', 'p')
+  AND n.nspname NOT IN ('pg_catalog', 'information_schema')
+  AND n.nspname NOT LIKE 'pg\_toast%'
+  AND n.nspname NOT LIKE 'pg\_temp\_%'`).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
+	}
+	return count, nil
+}
+
 func (h *Gateway) GetTables(ctx context.Context, conn connection.Connection, password string, dbName connection.Identifier) ([]connection.Table, error) {
 	db, err := h.connect(conn, password, dbName)
 	if err != nil {
@@ -376,52 +490,76 @@ SELECT
 	return &health, nil
 }
 
-func (h *Gateway) ListSessions(ctx context.Context, conn connection.Connection, password string) ([]connection.Session, error) {
+func (h *Gateway) ListSessions(ctx context.Context, conn connection.Connection, password string, filter connection.SessionFilter, limit, offset int) (*connection.SessionPage, error) {
 	db, err := h.connect(conn, password, postgresDBName())
 	if err != nil {
 		return nil, err
 	}
 	defer db.Close()
 
-	query := `
+	where, args := sessionFilterClause(filter)
+
+	page := &connection.SessionPage{Limit: limit, Offset: offset}
+	if err := db.QueryRowContext(ctx, "SELECT count(*) FROM pg_stat_activity "+where, args...).Scan(&page.Total); err != nil {
+		return nil, fmt.Errorf("%w: counting sessions: %v", connection.ErrQueryFailed, err)
+	}
+
+	query := fmt.Sprintf(`
 SELECT
     pid,
-    usename,
-    datname,
-    state,
+    COALESCE(usename, ''),
+    COALESCE(datname, ''),
+    COALESCE(state, ''),
     COALESCE(query, ''),
-    EXTRACT(EPOCH FROM (now() - query_start))::bigint as duration,
-    query_start
+    EXTRACT(EPOCH FROM (now() - COALESCE(query_start, backend_start)))::bigint as duration,
+    COALESCE(query_start, backend_start),
+    COALESCE(host(client_addr), ''),
+    COALESCE(application_name, ''),
+    COALESCE(wait_event_type, ''),
+    COALESCE(wait_event, ''),
+    COALESCE(backend_type, '')
 FROM pg_stat_activity
-WHERE state != 'idle'
-  AND pid <> pg_backend_pid()
-ORDER BY query_start DESC
-LIMIT 50;
-`
+%s
+ORDER BY COALESCE(query_start, backend_start) DESC
+LIMIT %d OFFSET %d;
+`, where, limit, offset)
 
-	rows, err := db.QueryContext(ctx, query)
+	rows, err := db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
 	}
 	defer rows.Close()
 
-	sessions := make([]connection.Session, 0)
+	page.Sessions = make([]connection.Session, 0)
 	for rows.Next() {
 		var session connection.Session
 		var durationSeconds int64
-		if err := rows.Scan(&session.PID, &session.User, &session.Database, &session.State, &session.Query, &durationSeconds, &session.StartedAt); err != nil {
+		if err := rows.Scan(
+			&session.PID,
+			&session.User,
+			&session.Database,
+			&session.State,
+			&session.Query,
+			&durationSeconds,
+			&session.StartedAt,
+			&session.ClientAddr,
+			&session.ApplicationName,
+			&session.WaitEventType,
+			&session.WaitEvent,
+			&session.BackendType,
+		); err != nil {
 			return nil, fmt.Errorf("%w: scanning session: %v", connection.ErrQueryFailed, err)
 		}
 
 		session.Duration = time.Duration(durationSeconds) * time.Second
-		sessions = append(sessions, session)
+		page.Sessions = append(page.Sessions, session)
 	}
 
 	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("%w: iterating sessions: %v", connection.ErrQueryFailed, err)
 	}
 
-	return sessions, nil
+	return page, nil
 }
 
 // --- Administrator Implementation ---
@@ -445,6 +583,25 @@ func (h *Gateway) KillSession(ctx context.Context, conn connection.Connection, p
 	return nil
 }
 
+func (h *Gateway) CancelSession(ctx context.Context, conn connection.Connection, password string, pid int) error {
+	db, err := h.connect(conn, password, postgresDBName())
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	var success bool
+	if err := db.QueryRowContext(ctx, "SELECT pg_cancel_backend($1)", pid).Scan(&success); err != nil {
+		return fmt.Errorf("%w: cancelling backend: %v", connection.ErrQueryFailed, err)
+	}
+
+	if !success {
+		return fmt.Errorf("%w: failed to cancel pid %d: permission denied or not found", connection.ErrResourceNotFound, pid)
+	}
+
+	return nil
+}
+
 func (h *Gateway) ListUsers(ctx context.Context, conn connection.Connection, password string) ([]connection.DBUser, error) {
 	db, err := h.connect(conn, password, postgresDBName())
 	if err != nil {
@@ -452,11 +609,35 @@ func (h *Gateway) ListUsers(ctx context.Context, conn connection.Connection, pas
 	}
 	defer db.Close()
 
+	// rolvaliduntil may be 'infinity', which does not fit a time.Time.
 	query := `
-SELECT rolname, rolsuper, rolcanlogin, rolconnlimit
-FROM pg_roles
-WHERE rolname NOT LIKE 'pg_%'
-ORDER BY rolname;
+SELECT
+    r.rolname,
+    r.rolsuper,
+    r.rolcanlogin,
+    r.rolconnlimit,
+    r.rolcreatedb,
+    r.rolcreaterole,
+    r.rolreplication,
+    CASE WHEN r.rolvaliduntil = 'infinity' THEN NULL ELSE r.rolvaliduntil END,
+    mo.roles,
+    mo.admin
+FROM pg_roles r
+LEFT JOIN LATERAL (
+    SELECT
+        array_agg(g.rolname ORDER BY g.rolname) AS roles,
+        array_agg(m.admin_option ORDER BY g.rolname) AS admin
+    FROM (
+        -- Since PostgreSQL 16 the same membership may be granted by several grantors.
+        SELECT roleid, bool_or(admin_option) AS admin_option
+        FROM pg_auth_members
+        WHERE member = r.oid
+        GROUP BY roleid
+    ) m
+    JOIN pg_roles g ON g.oid = m.roleid
+) mo ON true
+WHERE r.rolname NOT LIKE 'pg_%'
+ORDER BY r.rolname;
 `
 
 	rows, err := db.QueryContext(ctx, query)
@@ -469,15 +650,34 @@ ORDER BY rolname;
 	for rows.Next() {
 		var user connection.DBUser
 		var name string
-		if err := rows.Scan(&name, &user.IsSuperUser, &user.CanLogin, &user.ConnLimit); err != nil {
+		var validUntil sql.NullTime
+		var memberOf []string
+		var adminOption []bool
+		if err := rows.Scan(
+			&name,
+			&user.IsSuperUser,
+			&user.CanLogin,
+			&user.ConnLimit,
+			&user.CanCreateDB,
+			&user.CanCreateRole,
+			&user.Replication,
+			&validUntil,
+			pq.Array(&memberOf),
+			pq.Array(&adminOption),
+		); err != nil {
 			return nil, fmt.Errorf("%w: scanning user: %v", connection.ErrQueryFailed, err)
 		}
+		user.ValidUntil = nullTime(validUntil)
+		user.MemberOf = make([]connection.RoleMembership, len(memberOf))
+		for i, role := range memberOf {
+			user.MemberOf[i] = connection.RoleMembership{Role: role, AdminOption: i < len(adminOption) && adminOption[i]}
+		}
 
 		identifier, err := connection.NewIdentifier(name)
 		if err != nil {
 			// Skip users with invalid identifiers to avoid breaking the entire list
 			// In a real scenario, we might want to log this
-			log.Printf("[ERROR]skipping user with invalid name '%s': %v", name, err)
+			slog.ErrorContext(ctx, "skipping user with invalid name", "user", name, "error", err)
 			continue
 		}
 		user.Name = identifier
@@ -517,46 +717,29 @@ func (h *Gateway) CreateUser(ctx context.Context, conn connection.Connection, pa
 		builder.WriteString("NOLOGIN ")
 	}
 
-	if user.ConnLimit >= 0 {
-		fmt.Fprintf(&builder, "CONNECTION LIMIT %d ", user.ConnLimit)
+	if user.CanCreateDB {
+		builder.WriteString("CREATEDB ")
 	}
-
-	query := strings.TrimSpace(builder.String())
-
-	if _, err = db.ExecContext(ctx, query); err != nil {
-		return fmt.Errorf("%w: creating user: %v", connection.ErrQueryFailed, err)
+	if user.CanCreateRole {
+		builder.WriteString("CREATEROLE ")
 	}
-	return nil
-}
-
-func (h *Gateway) DropUser(ctx context.Context, conn connection.Connection, password string, username connection.Identifier) error {
-	db, err := h.connect(conn, password, postgresDBName())
-	if err != nil {
-		return err
+	if user.Replication {
+		builder.WriteString("REPLICATION ")
 	}
-	defer db.Close()
 
-	query := fmt.Sprintf("DROP USER %s", username.Quoted())
-	if _, err = db.ExecContext(ctx, query); err != nil {
-		return fmt.Errorf("%w: dropping user: %v", connection.ErrQueryFailed, err)
+	if user.ConnLimit >= 0 {
+		fmt.Fprintf(&builder, "CONNECTION LIMIT %d ", user.ConnLimit)
 	}
-	return nil
-}
 
-func (h *Gateway) CreateDatabase(ctx context.Context, conn connection.Connection, password string, dbName, owner connection.Identifier) error {
-	db, err := h.connect(conn, password, postgresDBName())
-	if err != nil {
-		return err
+	if user.ValidUntil != nil {
+		builder.WriteString("VALID UNTIL ")
+		builder.WriteString(quoteLiteral(user.ValidUntil.UTC().Format(time.RFC3339)))
 	}
-	defer db.Close()
 
-	query := fmt.Sprintf(
-		"CREATE DATABASE %s OWNER %s",
-		dbName.Quoted(),
-		owner.Quoted(),
-	)
+	query := strings.TrimSpace(builder.String())
+
 	if _, err = db.ExecContext(ctx, query); err != nil {
-		return fmt.Errorf("%w: creating database: %v", connection.ErrQueryFailed, err)
+		return fmt.Errorf("%w: creating user: %v", connection.ErrQueryFailed, err)
 	}
 	return nil
 }
@@ -750,6 +933,35 @@ func quoteLiteral(value string) string {
 	return fmt.Sprintf("'%s'", escaped)
 }
 
+// quoteIdentifier quotes catalog names that are not guaranteed to be valid Identifiers.
+func quoteIdentifier(value string) string {
+	return `"` + strings.ReplaceAll(value, `"`, `""`) + `"`
+}
+
+func qualifiedName(schema, name string) string {
+	return quoteIdentifier(schema) + "." + quoteIdentifier(name)
+}
+
+// requireServerVersion fails with ErrUnsupportedServerVersion when the server is older than
+// minimum, a server_version_num such as 140000.
+func requireServerVersion(ctx context.Context, db *sql.DB, minimum int, feature string) error {
+	var version int
+	if err := db.QueryRowContext(ctx, "SELECT current_setting('server_version_num')::int").Scan(&version); err != nil {
+		return fmt.Errorf("%w: reading server version: %v", connection.ErrQueryFailed, err)
+	}
+	if version < minimum {
+		return fmt.Errorf("%w: %s requires PostgreSQL %d or later", connection.ErrUnsupportedServerVersion, feature, minimum/10000)
+	}
+	return nil
+}
+
 func postgresDBName() connection.Identifier {
 	return connection.MustNewIdentifier("postgres")
 }
+
+func nullTime(value sql.NullTime) *time.Time {
+	if !value.Valid {
+		return nil
+	}
+	return &value.Time
+}
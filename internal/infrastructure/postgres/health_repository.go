@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/infrastructure/postgres/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var errNullHealthCheckedAt = errors.New("connection health checked_at is NULL")
+
+type HealthRepository struct {
+	queries *sqlc.Queries
+}
+
+func NewHealthRepository(pool *pgxpool.Pool) *HealthRepository {
+	return &HealthRepository{
+		queries: sqlc.New(pool),
+	}
+}
+
+func (r *HealthRepository) SaveHealth(ctx context.Context, check connection.HealthCheck) error {
+	return r.queries.UpsertConnectionHealth(ctx, sqlc.UpsertConnectionHealthParams{
+		ConnectionID:  pgtype.UUID{Bytes: check.ConnectionID, Valid: true},
+		Status:        string(check.Status),
+		Error:         check.Error,
+		LatencyMs:     check.Latency.Milliseconds(),
+		CheckedAt:     pgtype.Timestamptz{Time: check.CheckedAt, Valid: true},
+		LastSuccessAt: timestamptzFrom(check.LastSuccessAt),
+	})
+}
+
+func (r *HealthRepository) FindHealth(ctx context.Context, connectionID uuid.UUID) (*connection.HealthCheck, error) {
+	record, err := r.queries.GetConnectionHealth(ctx, pgtype.UUID{Bytes: connectionID, Valid: true})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	check, err := toDomainHealthCheck(record)
+	if err != nil {
+		return nil, err
+	}
+	return &check, nil
+}
+
+func (r *HealthRepository) ListHealth(ctx context.Context) ([]connection.HealthCheck, error) {
+	rows, err := r.queries.ListConnectionHealth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	checks := make([]connection.HealthCheck, 0, len(rows))
+	for _, record := range rows {
+		check, err := toDomainHealthCheck(record)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, check)
+	}
+
+	return checks, nil
+}
+
+func toDomainHealthCheck(record sqlc.ConnectionHealth) (connection.HealthCheck, error) {
+	if !record.CheckedAt.Valid {
+		return connection.HealthCheck{}, errNullHealthCheckedAt
+	}
+
+	return connection.HealthCheck{
+		ConnectionID:  uuid.UUID(record.ConnectionID.Bytes),
+		Status:        connection.HealthStatus(record.Status),
+		Error:         record.Error,
+		Latency:       time.Duration(record.LatencyMs) * time.Millisecond,
+		CheckedAt:     record.CheckedAt.Time,
+		LastSuccessAt: timePtrFromPg(record.LastSuccessAt),
+	}, nil
+}
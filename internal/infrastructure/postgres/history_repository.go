@@ -0,0 +1,166 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/domain/history"
+	"github.com/felipemalacarne/mesa/internal/infrastructure/postgres/sqlc"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// defaultHistoryListLimit applies when the filter does not bound the listing.
+const defaultHistoryListLimit = 100
+
+type HistoryRepository struct {
+	pool    *pgxpool.Pool
+	queries *sqlc.Queries
+}
+
+func NewHistoryRepository(pool *pgxpool.Pool) *HistoryRepository {
+	return &HistoryRepository{
+		pool:    pool,
+		queries: sqlc.New(pool),
+	}
+}
+
+// SaveEntries inserts the entries in a single transaction.
+func (r *HistoryRepository) SaveEntries(ctx context.Context, entries []history.Entry) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	queries := r.queries.WithTx(tx)
+	for _, e := range entries {
+		err := queries.InsertHistoryEntry(ctx, sqlc.InsertHistoryEntryParams{
+			ID:           pgtype.UUID{Bytes: e.ID, Valid: true},
+			ConnectionID: pgtype.UUID{Bytes: e.ConnectionID, Valid: true},
+			Database:     e.Database,
+			Source:       string(e.Source),
+			Statement:    e.Statement,
+			DurationMs:   float64(e.Duration.Microseconds()) / 1000,
+			RowsReturned: int8From(e.RowsReturned),
+			RowsAffected: int8From(e.RowsAffected),
+			Error:        e.Error,
+			ExecutedAt:   pgtype.Timestamptz{Time: e.ExecutedAt, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *HistoryRepository) ListEntries(ctx context.Context, filter history.Filter) ([]history.Entry, error) {
+	params := sqlc.ListHistoryEntriesParams{
+		Since:      timestamptzFrom(filter.Since),
+		Until:      timestamptzFrom(filter.Until),
+		FailedOnly: filter.FailedOnly,
+		RowLimit:   defaultHistoryListLimit,
+	}
+	if filter.ConnectionID != nil {
+		params.ConnectionID = pgtype.UUID{Bytes: *filter.ConnectionID, Valid: true}
+	}
+	if filter.Database != "" {
+		params.Database = pgtype.Text{String: filter.Database, Valid: true}
+	}
+	if filter.Source != nil {
+		params.Source = pgtype.Text{String: string(*filter.Source), Valid: true}
+	}
+	if filter.Search != "" {
+		params.Search = pgtype.Text{String: filter.Search, Valid: true}
+	}
+	if filter.Limit > 0 {
+		params.RowLimit = int32(filter.Limit)
+	}
+
+	rows, err := r.queries.ListHistoryEntries(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]history.Entry, 0, len(rows))
+	for _, record := range rows {
+		e, err := toDomainHistoryEntry(record)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+
+	return entries, nil
+}
+
+func (r *HistoryRepository) DeleteEntriesBefore(ctx context.Context, before time.Time) error {
+	return r.queries.DeleteHistoryBefore(ctx, pgtype.Timestamptz{Time: before, Valid: true})
+}
+
+func (r *HistoryRepository) TrimEntries(ctx context.Context, keep int) error {
+	connections, err := r.queries.ListHistoryConnections(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, connectionID := range connections {
+		cutoff, err := r.queries.GetHistoryCutoff(ctx, sqlc.GetHistoryCutoffParams{
+			ConnectionID: connectionID,
+			KeepOffset:   int32(keep - 1),
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		err = r.queries.DeleteConnectionHistoryBefore(ctx, sqlc.DeleteConnectionHistoryBeforeParams{
+			ConnectionID: connectionID,
+			Before:       cutoff,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func toDomainHistoryEntry(record sqlc.QueryHistory) (history.Entry, error) {
+	source, err := history.NewSource(record.Source)
+	if err != nil {
+		return history.Entry{}, err
+	}
+
+	return history.Entry{
+		ID:           record.ID.Bytes,
+		ConnectionID: record.ConnectionID.Bytes,
+		Database:     record.Database,
+		Source:       source,
+		Statement:    record.Statement,
+		Duration:     time.Duration(record.DurationMs * float64(time.Millisecond)),
+		RowsReturned: int64PtrFrom(record.RowsReturned),
+		RowsAffected: int64PtrFrom(record.RowsAffected),
+		Error:        record.Error,
+		ExecutedAt:   record.ExecutedAt.Time,
+	}, nil
+}
+
+func int8From(n *int64) pgtype.Int8 {
+	if n == nil {
+		return pgtype.Int8{}
+	}
+	return pgtype.Int8{Int64: *n, Valid: true}
+}
+
+func int64PtrFrom(value pgtype.Int8) *int64 {
+	if !value.Valid {
+		return nil
+	}
+	return &value.Int64
+}
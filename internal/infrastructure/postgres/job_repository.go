@@ -0,0 +1,147 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/domain/job"
+	"github.com/felipemalacarne/mesa/internal/infrastructure/postgres/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// defaultJobListLimit applies when the filter does not bound the listing.
+const defaultJobListLimit = 100
+
+type JobRepository struct {
+	queries *sqlc.Queries
+}
+
+func NewJobRepository(pool *pgxpool.Pool) *JobRepository {
+	return &JobRepository{
+		queries: sqlc.New(pool),
+	}
+}
+
+func (r *JobRepository) SaveJob(ctx context.Context, j job.Job) error {
+	log, err := json.Marshal(j.Log)
+	if err != nil {
+		return err
+	}
+
+	var progress pgtype.Float8
+	if j.Progress != nil {
+		progress = pgtype.Float8{Float64: *j.Progress, Valid: true}
+	}
+
+	return r.queries.UpsertJob(ctx, sqlc.UpsertJobParams{
+		ID:           pgtype.UUID{Bytes: j.ID, Valid: true},
+		ConnectionID: pgtype.UUID{Bytes: j.ConnectionID, Valid: true},
+		Kind:         j.Kind,
+		Database:     j.Database,
+		Target:       j.Target,
+		Status:       string(j.Status),
+		Progress:     progress,
+		Phase:        j.Phase,
+		Log:          log,
+		Error:        j.Error,
+		Result:       j.Result,
+		CreatedAt:    pgtype.Timestamptz{Time: j.CreatedAt, Valid: true},
+		StartedAt:    timestamptzFrom(j.StartedAt),
+		FinishedAt:   timestamptzFrom(j.FinishedAt),
+	})
+}
+
+func (r *JobRepository) FindJob(ctx context.Context, id uuid.UUID) (*job.Job, error) {
+	record, err := r.queries.GetJob(ctx, pgtype.UUID{Bytes: id, Valid: true})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	j, err := toDomainJob(record)
+	if err != nil {
+		return nil, err
+	}
+	return &j, nil
+}
+
+func (r *JobRepository) ListJobs(ctx context.Context, filter job.Filter) ([]job.Job, error) {
+	params := sqlc.ListJobsParams{RowLimit: defaultJobListLimit}
+	if filter.ConnectionID != nil {
+		params.ConnectionID = pgtype.UUID{Bytes: *filter.ConnectionID, Valid: true}
+	}
+	if filter.Status != nil {
+		params.Status = pgtype.Text{String: string(*filter.Status), Valid: true}
+	}
+	if filter.Limit > 0 {
+		params.RowLimit = int32(filter.Limit)
+	}
+
+	rows, err := r.queries.ListJobs(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]job.Job, 0, len(rows))
+	for _, record := range rows {
+		j, err := toDomainJob(record)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, j)
+	}
+
+	return jobs, nil
+}
+
+func (r *JobRepository) InterruptJobs(ctx context.Context, reason string, now time.Time) (int64, error) {
+	return r.queries.InterruptJobs(ctx, sqlc.InterruptJobsParams{
+		Reason:     reason,
+		FinishedAt: pgtype.Timestamptz{Time: now, Valid: true},
+	})
+}
+
+func (r *JobRepository) DeleteJobsFinishedBefore(ctx context.Context, before time.Time) error {
+	return r.queries.DeleteJobsFinishedBefore(ctx, pgtype.Timestamptz{Time: before, Valid: true})
+}
+
+func toDomainJob(record sqlc.Job) (job.Job, error) {
+	status, err := job.NewStatus(record.Status)
+	if err != nil {
+		return job.Job{}, err
+	}
+
+	log := make([]string, 0)
+	if err := json.Unmarshal(record.Log, &log); err != nil {
+		return job.Job{}, err
+	}
+
+	var progress *float64
+	if record.Progress.Valid {
+		progress = &record.Progress.Float64
+	}
+
+	return job.Job{
+		ID:           record.ID.Bytes,
+		ConnectionID: record.ConnectionID.Bytes,
+		Kind:         record.Kind,
+		Database:     record.Database,
+		Target:       record.Target,
+		Status:       status,
+		Progress:     progress,
+		Phase:        record.Phase,
+		Log:          log,
+		Error:        record.Error,
+		Result:       record.Result,
+		CreatedAt:    record.CreatedAt.Time,
+		StartedAt:    timePtrFromPg(record.StartedAt),
+		FinishedAt:   timePtrFromPg(record.FinishedAt),
+	}, nil
+}
@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/lib/pq"
+)
+
+func (h *Gateway) GetLockWaits(ctx context.Context, conn connection.Connection, password string) ([]connection.LockWait, error) {
+	db, err := h.connect(conn, password, postgresDBName())
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	if err := requireServerVersion(ctx, db, 140000, "the blocking tree (pg_locks.waitstart)"); err != nil {
+		return nil, err
+	}
+
+	// Relation names can only be resolved for the database we are connected to; locks on
+	// other databases keep the raw OID.
+	query := `
+WITH waiting AS (
+    SELECT pid, pg_blocking_pids(pid) AS blocked_by
+    FROM pg_stat_activity
+    WHERE cardinality(pg_blocking_pids(pid)) > 0
+), involved AS (
+    SELECT pid FROM waiting
+    UNION
+    SELECT unnest(blocked_by) FROM waiting
+)
+SELECT
+    a.pid,
+    COALESCE(a.usename, ''),
+    COALESCE(a.datname, ''),
+    COALESCE(a.state, ''),
+    COALESCE(a.query, ''),
+    COALESCE(a.application_name, ''),
+    COALESCE(a.wait_event_type, ''),
+    COALESCE(a.wait_event, ''),
+    COALESCE(l.locktype, ''),
+    COALESCE(l.mode, ''),
+    CASE
+        WHEN l.relation IS NULL THEN ''
+        WHEN l.database = (SELECT oid FROM pg_database WHERE datname = current_database()) THEN l.relation::regclass::text
+        ELSE l.relation::text
+    END,
+    COALESCE(EXTRACT(EPOCH FROM now() - l.waitstart), 0)::float8,
+    COALESCE(EXTRACT(EPOCH FROM now() - a.xact_start), 0)::float8,
+    COALESCE(w.blocked_by, '{}'),
+    COALESCE(ARRAY(
+        SELECT DISTINCT h.pid
+        FROM pg_locks h
+        WHERE h.granted
+          AND h.pid = ANY(w.blocked_by)
+          AND h.locktype = l.locktype
+          AND h.database IS NOT DISTINCT FROM l.database
+          AND h.relation IS NOT DISTINCT FROM l.relation
+          AND h.page IS NOT DISTINCT FROM l.page
+          AND h.tuple IS NOT DISTINCT FROM l.tuple
+          AND h.virtualxid IS NOT DISTINCT FROM l.virtualxid
+          AND h.transactionid IS NOT DISTINCT FROM l.transactionid
+          AND h.classid IS NOT DISTINCT FROM l.classid
+          AND h.objid IS NOT DISTINCT FROM l.objid
+          AND h.objsubid IS NOT DISTINCT FROM l.objsubid
+    ), '{}')
+FROM involved i
+JOIN pg_stat_activity a ON a.pid = i.pid
+LEFT JOIN waiting w ON w.pid = a.pid
+LEFT JOIN LATERAL (
+    SELECT locktype, mode, database, relation, page, tuple, virtualxid, transactionid,
+        classid, objid, objsubid, waitstart
+    FROM pg_locks
+    WHERE pid = a.pid AND NOT granted
+    LIMIT 1
+) l ON true
+ORDER BY a.pid;
+`
+
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
+	}
+	defer rows.Close()
+
+	waits := make([]connection.LockWait, 0)
+	for rows.Next() {
+		var w connection.LockWait
+		var waitSeconds, xactSeconds float64
+		var blockedBy, heldBy []int64
+		if err := rows.Scan(
+			&w.PID,
+			&w.User,
+			&w.Database,
+			&w.State,
+			&w.Query,
+			&w.ApplicationName,
+			&w.WaitEventType,
+			&w.WaitEvent,
+			&w.LockType,
+			&w.LockMode,
+			&w.Relation,
+			&waitSeconds,
+			&xactSeconds,
+			pq.Array(&blockedBy),
+			pq.Array(&heldBy),
+		); err != nil {
+			return nil, fmt.Errorf("%w: scanning lock wait: %v", connection.ErrQueryFailed, err)
+		}
+
+		w.WaitDuration = time.Duration(waitSeconds * float64(time.Second))
+		w.TransactionDuration = time.Duration(xactSeconds * float64(time.Second))
+		for _, pid := range blockedBy {
+			w.BlockedBy = append(w.BlockedBy, int(pid))
+		}
+		for _, pid := range heldBy {
+			w.HeldBy = append(w.HeldBy, int(pid))
+		}
+		waits = append(waits, w)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: iterating lock waits: %v", connection.ErrQueryFailed, err)
+	}
+
+	return waits, nil
+}
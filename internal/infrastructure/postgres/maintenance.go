@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/stdlib"
+)
+
+// --- Maintenance Implementation ---
+
+// RunMaintenance executes the task on a dedicated session so that its backend PID can be
+// reported for progress tracking and its VERBOSE output forwarded to the observer.
+func (h *Gateway) RunMaintenance(ctx context.Context, conn connection.Connection, password string, task connection.MaintenanceTask, observer connection.MaintenanceObserver) error {
+	config, err := h.config(conn, password, task.Database)
+	if err != nil {
+		return fmt.Errorf("%w: %v", connection.ErrInvalidConfiguration, err)
+	}
+	config.OnNotice = func(_ *pgconn.PgConn, notice *pgconn.Notice) {
+		observer.Notice(notice.Message)
+	}
+
+	db := stdlib.OpenDB(*config)
+	defer db.Close()
+
+	session, err := db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %v", connection.ErrConnectionFailed, err)
+	}
+	defer session.Close()
+
+	var pid int
+	if err := session.QueryRowContext(ctx, "SELECT pg_backend_pid()").Scan(&pid); err != nil {
+		return fmt.Errorf("%w: reading backend pid: %v", connection.ErrQueryFailed, err)
+	}
+	observer.Started(pid)
+
+	if _, err := session.ExecContext(ctx, maintenanceStatement(task)); err != nil {
+		return fmt.Errorf("%w: running %s: %v", connection.ErrQueryFailed, task.Kind, err)
+	}
+
+	return nil
+}
+
+func (h *Gateway) GetMaintenanceProgress(ctx context.Context, conn connection.Connection, password string, dbName connection.Identifier, pid int) (*connection.MaintenanceProgress, error) {
+	db, err := h.connect(conn, password, dbName)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	// VACUUM FULL reports through pg_stat_progress_cluster, REINDEX through create_index.
+	query := `
+SELECT 'VACUUM', phase, COALESCE(relid::regclass::text, ''), heap_blks_total, heap_blks_vacuumed
+FROM pg_stat_progress_vacuum WHERE pid = $1
+UNION ALL
+SELECT command, phase, COALESCE(relid::regclass::text, ''), heap_blks_total, heap_blks_scanned
+FROM pg_stat_progress_cluster WHERE pid = $1
+UNION ALL
+SELECT 'ANALYZE', phase, COALESCE(relid::regclass::text, ''), sample_blks_total, sample_blks_scanned
+FROM pg_stat_progress_analyze WHERE pid = $1
+UNION ALL
+SELECT command, phase, COALESCE(relid::regclass::text, ''), blocks_total, blocks_done
+FROM pg_stat_progress_create_index WHERE pid = $1
+LIMIT 1;
+`
+
+	var progress connection.MaintenanceProgress
+	err = db.QueryRowContext(ctx, query, pid).Scan(&progress.Command, &progress.Phase, &progress.Relation, &progress.BlocksTotal, &progress.BlocksDone)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: reading progress: %v", connection.ErrQueryFailed, err)
+	}
+
+	return &progress, nil
+}
+
+func maintenanceStatement(task connection.MaintenanceTask) string {
+	target := ""
+	if task.Target != nil {
+		target = " " + task.Schema.Quoted() + "." + task.Target.Quoted()
+	}
+
+	var options []string
+	switch task.Kind {
+	case connection.MaintenanceVacuum:
+		if task.Options.Full {
+			options = append(options, "FULL")
+		}
+		if task.Options.Analyze {
+			options = append(options, "ANALYZE")
+		}
+		if task.Options.Verbose {
+			options = append(options, "VERBOSE")
+		}
+		return "VACUUM" + optionList(options) + target
+
+	case connection.MaintenanceAnalyze:
+		if task.Options.Verbose {
+			options = append(options, "VERBOSE")
+		}
+		return "ANALYZE" + optionList(options) + target
+
+	case connection.MaintenanceReindex:
+		if task.Options.Verbose {
+			options = append(options, "VERBOSE")
+		}
+		concurrently := ""
+		if task.Options.Concurrently {
+			concurrently = " CONCURRENTLY"
+		}
+		if task.Target == nil {
+			return "REINDEX" + optionList(options) + " DATABASE" + concurrently + " " + task.Database.Quoted()
+		}
+		return "REINDEX" + optionList(options) + " TABLE" + concurrently + target
+
+	default:
+		concurrently := ""
+		if task.Options.Concurrently {
+			concurrently = " CONCURRENTLY"
+		}
+		return "REFRESH MATERIALIZED VIEW" + concurrently + target
+	}
+}
+
+func optionList(options []string) string {
+	if len(options) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(options, ", ") + ")"
+}
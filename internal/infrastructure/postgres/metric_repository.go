@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/domain/metric"
+	"github.com/felipemalacarne/mesa/internal/infrastructure/postgres/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var errNullMetricSampledAt = errors.New("metric sample sampled_at is NULL")
+
+type MetricRepository struct {
+	queries *sqlc.Queries
+}
+
+func NewMetricRepository(pool *pgxpool.Pool) *MetricRepository {
+	return &MetricRepository{
+		queries: sqlc.New(pool),
+	}
+}
+
+func (r *MetricRepository) Save(ctx context.Context, samples ...metric.Sample) error {
+	for _, s := range samples {
+		sizes, err := json.Marshal(s.DatabaseSizes)
+		if err != nil {
+			return err
+		}
+
+		if err := r.queries.UpsertMetricSample(ctx, sqlc.UpsertMetricSampleParams{
+			ConnectionID:   pgtype.UUID{Bytes: s.ConnectionID, Valid: true},
+			Resolution:     string(s.Resolution),
+			SampledAt:      pgtype.Timestamptz{Time: s.Time, Valid: true},
+			TotalSessions:  int32(s.TotalSessions),
+			ActiveSessions: int32(s.ActiveSessions),
+			MaxConnections: int32(s.MaxConnections),
+			Tps:            s.TPS,
+			CacheHitRatio:  s.CacheHitRatio,
+			Deadlocks:      s.Deadlocks,
+			TempBytes:      s.TempBytes,
+			DatabaseSizes:  sizes,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *MetricRepository) Range(ctx context.Context, connectionID uuid.UUID, resolution metric.Resolution, from, to time.Time) ([]metric.Sample, error) {
+	rows, err := r.queries.ListMetricSamples(ctx, sqlc.ListMetricSamplesParams{
+		ConnectionID: pgtype.UUID{Bytes: connectionID, Valid: true},
+		Resolution:   string(resolution),
+		SampledFrom:  pgtype.Timestamptz{Time: from, Valid: true},
+		SampledTo:    pgtype.Timestamptz{Time: to, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	samples := make([]metric.Sample, 0, len(rows))
+	for _, record := range rows {
+		sample, err := toDomainSample(record)
+		if err != nil {
+			return nil, err
+		}
+		samples = append(samples, sample)
+	}
+
+	return samples, nil
+}
+
+func (r *MetricRepository) Latest(ctx context.Context, connectionID uuid.UUID, resolution metric.Resolution) (*time.Time, error) {
+	sampledAt, err := r.queries.LatestMetricSampleTime(ctx, sqlc.LatestMetricSampleTimeParams{
+		ConnectionID: pgtype.UUID{Bytes: connectionID, Valid: true},
+		Resolution:   string(resolution),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := timeFromPg(sampledAt, errNullMetricSampledAt)
+	if err != nil {
+		return nil, err
+	}
+	return &latest, nil
+}
+
+func (r *MetricRepository) DeleteBefore(ctx context.Context, resolution metric.Resolution, before time.Time) error {
+	return r.queries.DeleteMetricSamplesBefore(ctx, sqlc.DeleteMetricSamplesBeforeParams{
+		Resolution: string(resolution),
+		SampledAt:  pgtype.Timestamptz{Time: before, Valid: true},
+	})
+}
+
+func toDomainSample(record sqlc.MetricSample) (metric.Sample, error) {
+	connectionID, err := uuidFromPg(record.ConnectionID)
+	if err != nil {
+		return metric.Sample{}, err
+	}
+
+	sampledAt, err := timeFromPg(record.SampledAt, errNullMetricSampledAt)
+	if err != nil {
+		return metric.Sample{}, err
+	}
+
+	resolution, err := metric.NewResolution(record.Resolution)
+	if err != nil {
+		return metric.Sample{}, err
+	}
+
+	var sizes map[string]int64
+	if err := json.Unmarshal(record.DatabaseSizes, &sizes); err != nil {
+		return metric.Sample{}, err
+	}
+
+	return metric.Sample{
+		ConnectionID:   connectionID,
+		Resolution:     resolution,
+		Time:           sampledAt.UTC(),
+		TotalSessions:  int(record.TotalSessions),
+		ActiveSessions: int(record.ActiveSessions),
+		MaxConnections: int(record.MaxConnections),
+		TPS:            record.Tps,
+		CacheHitRatio:  record.CacheHitRatio,
+		Deadlocks:      record.Deadlocks,
+		TempBytes:      record.TempBytes,
+		DatabaseSizes:  sizes,
+	}, nil
+}
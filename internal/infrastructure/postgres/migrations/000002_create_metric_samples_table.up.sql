@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS metric_samples (
+    connection_id UUID NOT NULL REFERENCES connections (id) ON DELETE CASCADE,
+    resolution TEXT NOT NULL,
+    sampled_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    total_sessions INTEGER NOT NULL,
+    active_sessions INTEGER NOT NULL,
+    max_connections INTEGER NOT NULL,
+    tps DOUBLE PRECISION NOT NULL,
+    cache_hit_ratio DOUBLE PRECISION NOT NULL,
+    deadlocks BIGINT NOT NULL,
+    temp_bytes BIGINT NOT NULL,
+    database_sizes JSONB NOT NULL, -- database name -> bytes
+    PRIMARY KEY (connection_id, resolution, sampled_at)
+);
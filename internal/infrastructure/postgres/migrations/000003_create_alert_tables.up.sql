@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS alert_rules (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    connection_id UUID REFERENCES connections (id) ON DELETE CASCADE, -- NULL applies to every connection
+    condition TEXT NOT NULL,
+    threshold DOUBLE PRECISION NOT NULL,
+    consecutive_checks INTEGER NOT NULL,
+    channel TEXT NOT NULL,
+    target TEXT NOT NULL,
+    enabled BOOLEAN NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS alert_states (
+    rule_id UUID NOT NULL REFERENCES alert_rules (id) ON DELETE CASCADE,
+    connection_id UUID NOT NULL REFERENCES connections (id) ON DELETE CASCADE,
+    status TEXT NOT NULL,
+    consecutive_breaches INTEGER NOT NULL,
+    value DOUBLE PRECISION NOT NULL,
+    message TEXT NOT NULL,
+    fired_at TIMESTAMP WITH TIME ZONE,
+    resolved_at TIMESTAMP WITH TIME ZONE,
+    evaluated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (rule_id, connection_id)
+);
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS connection_health (
+    connection_id UUID PRIMARY KEY REFERENCES connections (id) ON DELETE CASCADE,
+    status TEXT NOT NULL,
+    error TEXT NOT NULL,
+    latency_ms BIGINT NOT NULL,
+    checked_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_success_at TIMESTAMP WITH TIME ZONE
+);
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS jobs (
+    id UUID PRIMARY KEY,
+    connection_id UUID NOT NULL REFERENCES connections (id) ON DELETE CASCADE,
+    kind TEXT NOT NULL,
+    database TEXT NOT NULL,
+    target TEXT NOT NULL,
+    status TEXT NOT NULL,
+    progress DOUBLE PRECISION,
+    phase TEXT NOT NULL,
+    log JSONB NOT NULL, -- array of lines
+    error TEXT NOT NULL,
+    result TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    started_at TIMESTAMP WITH TIME ZONE,
+    finished_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS jobs_connection_created_idx ON jobs (connection_id, created_at);
+CREATE INDEX IF NOT EXISTS jobs_status_idx ON jobs (status);
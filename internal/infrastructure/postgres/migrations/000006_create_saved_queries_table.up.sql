@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS saved_queries (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL,
+    sql TEXT NOT NULL,
+    connection_id UUID REFERENCES connections (id) ON DELETE CASCADE,
+    driver TEXT,
+    tags JSONB NOT NULL, -- array of tags
+    params JSONB NOT NULL, -- array of parameter definitions
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
@@ -0,0 +1,5 @@
+ALTER TABLE connections DROP COLUMN protection;
+ALTER TABLE connections DROP COLUMN description;
+ALTER TABLE connections DROP COLUMN color;
+ALTER TABLE connections DROP COLUMN tags;
+ALTER TABLE connections DROP COLUMN environment;
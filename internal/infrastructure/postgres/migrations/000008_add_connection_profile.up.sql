@@ -0,0 +1,5 @@
+ALTER TABLE connections ADD COLUMN environment TEXT NOT NULL DEFAULT 'development';
+ALTER TABLE connections ADD COLUMN tags JSONB NOT NULL DEFAULT '[]'; -- array of tags
+ALTER TABLE connections ADD COLUMN color TEXT NOT NULL DEFAULT '';
+ALTER TABLE connections ADD COLUMN description TEXT NOT NULL DEFAULT '';
+ALTER TABLE connections ADD COLUMN protection TEXT NOT NULL DEFAULT 'none';
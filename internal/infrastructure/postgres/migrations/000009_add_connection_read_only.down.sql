@@ -0,0 +1 @@
+ALTER TABLE connections DROP COLUMN read_only;
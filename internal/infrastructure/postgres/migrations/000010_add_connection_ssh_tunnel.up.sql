@@ -0,0 +1 @@
+ALTER TABLE connections ADD COLUMN ssh_tunnel JSONB; -- secrets AES-256 Encrypted
@@ -0,0 +1,433 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/lib/pq"
+)
+
+// --- Schema Object Browsing ---
+
+func (h *Gateway) GetViews(ctx context.Context, conn connection.Connection, password string, dbName, schema connection.Identifier) ([]connection.View, error) {
+	db, err := h.connect(conn, password, dbName)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	query := `
+SELECT
+    c.relname,
+    pg_get_userbyid(c.relowner),
+    COALESCE(obj_description(c.oid, 'pg_class'), '')
+FROM pg_class c
+JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE n.nspname = $1
+  AND c.relkind = 'v'
+ORDER BY c.relname;
+`
+
+	rows, err := db.QueryContext(ctx, query, schema.String())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
+	}
+	defer rows.Close()
+
+	views := make([]connection.View, 0)
+	for rows.Next() {
+		view := connection.View{Schema: schema.String()}
+		if err := rows.Scan(&view.Name, &view.Owner, &view.Comment); err != nil {
+			return nil, fmt.Errorf("%w: scanning view: %v", connection.ErrQueryFailed, err)
+		}
+		views = append(views, view)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: iterating views: %v", connection.ErrQueryFailed, err)
+	}
+
+	return views, nil
+}
+
+func (h *Gateway) GetMaterializedViews(ctx context.Context, conn connection.Connection, password string, dbName, schema connection.Identifier) ([]connection.MaterializedView, error) {
+	db, err := h.connect(conn, password, dbName)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	// Postgres does not record refresh times. The modification time of the main data file is
+	// reported instead, when the role may call pg_stat_file. A REFRESH writes the file, but so
+	// do VACUUM, hint-bit writes and checkpoints, so the last refresh happened at or before
+	// this time and possibly long before.
+	query := `
+SELECT
+    c.relname,
+    pg_get_userbyid(c.relowner),
+    c.relispopulated,
+    pg_total_relation_size(c.oid),
+    CASE
+        WHEN has_function_privilege('pg_catalog.pg_stat_file(text, boolean)', 'EXECUTE')
+        THEN (pg_stat_file(pg_relation_filepath(c.oid), true)).modification
+    END,
+    COALESCE(obj_description(c.oid, 'pg_class'), '')
+FROM pg_class c
+JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE n.nspname = $1
+  AND c.relkind = 'm'
+ORDER BY c.relname;
+`
+
+	rows, err := db.QueryContext(ctx, query, schema.String())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
+	}
+	defer rows.Close()
+
+	views := make([]connection.MaterializedView, 0)
+	for rows.Next() {
+		view := connection.MaterializedView{Schema: schema.String()}
+		var modifiedAt sql.NullTime
+		if err := rows.Scan(&view.Name, &view.Owner, &view.Populated, &view.Size, &modifiedAt, &view.Comment); err != nil {
+			return nil, fmt.Errorf("%w: scanning materialized view: %v", connection.ErrQueryFailed, err)
+		}
+		if modifiedAt.Valid {
+			view.DataFileModifiedAt = &modifiedAt.Time
+		}
+		views = append(views, view)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: iterating materialized views: %v", connection.ErrQueryFailed, err)
+	}
+
+	return views, nil
+}
+
+func (h *Gateway) GetFunctions(ctx context.Context, conn connection.Connection, password string, dbName, schema connection.Identifier) ([]connection.Function, error) {
+	db, err := h.connect(conn, password, dbName)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	// Functions installed by extensions are skipped, they would drown the user's own code.
+	query := `
+SELECT
+    p.proname,
+    CASE p.prokind
+        WHEN 'p' THEN 'procedure'
+        WHEN 'a' THEN 'aggregate'
+        WHEN 'w' THEN 'window'
+        ELSE 'function'
+    END,
+    pg_get_function_identity_arguments(p.oid),
+    COALESCE(pg_get_function_result(p.oid), ''),
+    l.lanname,
+    CASE p.provolatile
+        WHEN 'i' THEN 'immutable'
+        WHEN 's' THEN 'stable'
+        ELSE 'volatile'
+    END,
+    pg_get_userbyid(p.proowner),
+    p.prosecdef
+FROM pg_proc p
+JOIN pg_namespace n ON n.oid = p.pronamespace
+JOIN pg_language l ON l.oid = p.prolang
+WHERE n.nspname = $1
+  AND NOT EXISTS (SELECT 1 FROM pg_depend d WHERE d.objid = p.oid AND d.deptype = 'e')
+ORDER BY p.proname, 3;
+`
+
+	rows, err := db.QueryContext(ctx, query, schema.String())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
+	}
+	defer rows.Close()
+
+	functions := make([]connection.Function, 0)
+	for rows.Next() {
+		fn := connection.Function{Schema: schema.String()}
+		var kind, volatility string
+		if err := rows.Scan(&fn.Name, &kind, &fn.Arguments, &fn.ReturnType, &fn.Language, &volatility, &fn.Owner, &fn.SecurityDefiner); err != nil {
+			return nil, fmt.Errorf("%w: scanning function: %v", connection.ErrQueryFailed, err)
+		}
+		fn.Kind = connection.RoutineKind(kind)
+		fn.Volatility = connection.Volatility(volatility)
+		functions = append(functions, fn)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: iterating functions: %v", connection.ErrQueryFailed, err)
+	}
+
+	return functions, nil
+}
+
+func (h *Gateway) GetTriggers(ctx context.Context, conn connection.Connection, password string, dbName, schema, tableName connection.Identifier) ([]connection.Trigger, error) {
+	db, err := h.connect(conn, password, dbName)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	// tgtype bits: 1 ROW, 2 BEFORE, 4 INSERT, 8 DELETE, 16 UPDATE, 32 TRUNCATE, 64 INSTEAD OF.
+	query := `
+SELECT
+    t.tgname,
+    c.relname,
+    CASE
+        WHEN t.tgtype & 2 = 2 THEN 'BEFORE'
+        WHEN t.tgtype & 64 = 64 THEN 'INSTEAD OF'
+        ELSE 'AFTER'
+    END,
+    array_remove(ARRAY[
+        CASE WHEN t.tgtype & 4 = 4 THEN 'INSERT' END,
+        CASE WHEN t.tgtype & 16 = 16 THEN 'UPDATE' END,
+        CASE WHEN t.tgtype & 8 = 8 THEN 'DELETE' END,
+        CASE WHEN t.tgtype & 32 = 32 THEN 'TRUNCATE' END
+    ], NULL)::text[],
+    CASE WHEN t.tgtype & 1 = 1 THEN 'ROW' ELSE 'STATEMENT' END,
+    format('%I.%I', pn.nspname, p.proname),
+    t.tgenabled <> 'D',
+    pg_get_triggerdef(t.oid, true)
+FROM pg_trigger t
+JOIN pg_class c ON c.oid = t.tgrelid
+JOIN pg_namespace n ON n.oid = c.relnamespace
+JOIN pg_proc p ON p.oid = t.tgfoid
+JOIN pg_namespace pn ON pn.oid = p.pronamespace
+WHERE n.nspname = $1
+  AND c.relname = $2
+  AND NOT t.tgisinternal
+ORDER BY t.tgname;
+`
+
+	rows, err := db.QueryContext(ctx, query, schema.String(), tableName.String())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
+	}
+	defer rows.Close()
+
+	triggers := make([]connection.Trigger, 0)
+	for rows.Next() {
+		var trigger connection.Trigger
+		if err := rows.Scan(&trigger.Name, &trigger.Table, &trigger.Timing, pq.Array(&trigger.Events), &trigger.Level, &trigger.Function, &trigger.Enabled, &trigger.Definition); err != nil {
+			return nil, fmt.Errorf("%w: scanning trigger: %v", connection.ErrQueryFailed, err)
+		}
+		triggers = append(triggers, trigger)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: iterating triggers: %v", connection.ErrQueryFailed, err)
+	}
+
+	return triggers, nil
+}
+
+func (h *Gateway) GetSequences(ctx context.Context, conn connection.Connection, password string, dbName, schema connection.Identifier) ([]connection.Sequence, error) {
+	db, err := h.connect(conn, password, dbName)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	// last_value is NULL until the first nextval() or when the role lacks USAGE/SELECT.
+	query := `
+SELECT
+    s.sequencename,
+    format_type(s.data_type, NULL),
+    s.start_value,
+    s.increment_by,
+    s.min_value,
+    s.max_value,
+    s.cache_size,
+    s.cycle,
+    s.last_value,
+    COALESCE((
+        SELECT format('%s.%I', d.refobjid::regclass, a.attname)
+        FROM pg_depend d
+        JOIN pg_attribute a ON a.attrelid = d.refobjid AND a.attnum = d.refobjsubid
+        WHERE d.classid = 'pg_class'::regclass
+          AND d.refclassid = 'pg_class'::regclass
+          AND d.objid = to_regclass(format('%I.%I', s.schemaname, s.sequencename))
+          AND d.deptype IN ('a', 'i')
+        LIMIT 1
+    ), '')
+FROM pg_sequences s
+WHERE s.schemaname = $1
+ORDER BY s.sequencename;
+`
+
+	rows, err := db.QueryContext(ctx, query, schema.String())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
+	}
+	defer rows.Close()
+
+	sequences := make([]connection.Sequence, 0)
+	for rows.Next() {
+		seq := connection.Sequence{Schema: schema.String()}
+		var lastValue sql.NullInt64
+		if err := rows.Scan(&seq.Name, &seq.DataType, &seq.Start, &seq.Increment, &seq.Min, &seq.Max, &seq.Cache, &seq.Cycle, &lastValue, &seq.OwnedBy); err != nil {
+			return nil, fmt.Errorf("%w: scanning sequence: %v", connection.ErrQueryFailed, err)
+		}
+		if lastValue.Valid {
+			seq.CurrentValue = &lastValue.Int64
+		}
+		sequences = append(sequences, seq)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: iterating sequences: %v", connection.ErrQueryFailed, err)
+	}
+
+	return sequences, nil
+}
+
+func (h *Gateway) GetTypes(ctx context.Context, conn connection.Connection, password string, dbName, schema connection.Identifier) ([]connection.UserType, error) {
+	db, err := h.connect(conn, password, dbName)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	return h.userTypes(ctx, db, schema.String())
+}
+
+func (h *Gateway) userTypes(ctx context.Context, db *sql.DB, schema string) ([]connection.UserType, error) {
+	query := `
+SELECT
+    t.typname,
+    CASE t.typtype
+        WHEN 'e' THEN 'enum'
+        WHEN 'd' THEN 'domain'
+        ELSE 'composite'
+    END,
+    COALESCE(obj_description(t.oid, 'pg_type'), ''),
+    ARRAY(
+        SELECT e.enumlabel::text
+        FROM pg_enum e
+        WHERE e.enumtypid = t.oid
+        ORDER BY e.enumsortorder
+    ),
+    CASE WHEN t.typtype = 'd' THEN format_type(t.typbasetype, t.typtypmod) ELSE '' END,
+    t.typnotnull,
+    COALESCE(t.typdefault, ''),
+    ARRAY(
+        SELECT pg_get_constraintdef(con.oid, true)
+        FROM pg_constraint con
+        WHERE con.contypid = t.oid
+          AND con.contype = 'c'
+        ORDER BY con.conname
+    ),
+    ARRAY(
+        SELECT a.attname::text
+        FROM pg_attribute a
+        WHERE a.attrelid = t.typrelid AND a.attnum > 0 AND NOT a.attisdropped
+        ORDER BY a.attnum
+    ),
+    ARRAY(
+        SELECT format_type(a.atttypid, a.atttypmod)
+        FROM pg_attribute a
+        WHERE a.attrelid = t.typrelid AND a.attnum > 0 AND NOT a.attisdropped
+        ORDER BY a.attnum
+    )
+FROM pg_type t
+JOIN pg_namespace n ON n.oid = t.typnamespace
+LEFT JOIN pg_class c ON c.oid = t.typrelid
+WHERE n.nspname = $1
+  AND (t.typtype IN ('e', 'd') OR (t.typtype = 'c' AND c.relkind = 'c'))
+  AND NOT EXISTS (SELECT 1 FROM pg_depend d WHERE d.objid = t.oid AND d.deptype = 'e')
+ORDER BY t.typname;
+`
+
+	rows, err := db.QueryContext(ctx, query, schema)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
+	}
+	defer rows.Close()
+
+	types := make([]connection.UserType, 0)
+	for rows.Next() {
+		userType := connection.UserType{Schema: schema}
+		var kind string
+		var attrNames, attrTypes []string
+		if err := rows.Scan(
+			&userType.Name,
+			&kind,
+			&userType.Comment,
+			pq.Array(&userType.Labels),
+			&userType.BaseType,
+			&userType.NotNull,
+			&userType.Default,
+			pq.Array(&userType.Constraints),
+			pq.Array(&attrNames),
+			pq.Array(&attrTypes),
+		); err != nil {
+			return nil, fmt.Errorf("%w: scanning type: %v", connection.ErrQueryFailed, err)
+		}
+
+		userType.Kind = connection.TypeKind(kind)
+		for i := range attrNames {
+			userType.Attributes = append(userType.Attributes, connection.TypeAttribute{Name: attrNames[i], Type: attrTypes[i]})
+		}
+		types = append(types, userType)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: iterating types: %v", connection.ErrQueryFailed, err)
+	}
+
+	return types, nil
+}
+
+// typeDDL renders the CREATE TYPE / CREATE DOMAIN statement of a user-defined type.
+func typeDDL(t connection.UserType) string {
+	name := qualifiedName(t.Schema, t.Name)
+
+	var statement string
+	switch t.Kind {
+	case connection.TypeKindEnum:
+		labels := make([]string, len(t.Labels))
+		for i, label := range t.Labels {
+			labels[i] = quoteLiteral(label)
+		}
+		statement = fmt.Sprintf("CREATE TYPE %s AS ENUM (%s);", name, strings.Join(labels, ", "))
+	case connection.TypeKindDomain:
+		builder := strings.Builder{}
+		fmt.Fprintf(&builder, "CREATE DOMAIN %s AS %s", name, t.BaseType)
+		if t.Default != "" {
+			builder.WriteString(" DEFAULT ")
+			builder.WriteString(t.Default)
+		}
+		if t.NotNull {
+			builder.WriteString(" NOT NULL")
+		}
+		for _, constraint := range t.Constraints {
+			builder.WriteString(" ")
+			builder.WriteString(constraint)
+		}
+		builder.WriteString(";")
+		statement = builder.String()
+	default:
+		attributes := make([]string, len(t.Attributes))
+		for i, attr := range t.Attributes {
+			attributes[i] = fmt.Sprintf("%s %s", quoteIdentifier(attr.Name), attr.Type)
+		}
+		statement = fmt.Sprintf("CREATE TYPE %s AS (\n    %s\n);", name, strings.Join(attributes, ",\n    "))
+	}
+
+	if t.Comment != "" {
+		objectType := "TYPE"
+		if t.Kind == connection.TypeKindDomain {
+			objectType = "DOMAIN"
+		}
+		statement += fmt.Sprintf("\n\nCOMMENT ON %s %s IS %s;", objectType, name, quoteLiteral(t.Comment))
+	}
+
+	return statement
+}
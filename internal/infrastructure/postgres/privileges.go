@@ -0,0 +1,393 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lib/pq"
+)
+
+// ListPrivileges decodes the ACLs of the current database and of its schemas, tables,
+// sequences and functions, plus the default privileges. NULL ACLs are replaced by
+// acldefault(), so implicit owner and PUBLIC privileges are reported too.
+func (h *Gateway) ListPrivileges(ctx context.Context, conn connection.Connection, password string, dbName connection.Identifier, filter connection.PrivilegeFilter) (*connection.DatabasePrivileges, error) {
+	db, err := h.connect(conn, password, dbName)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	var schema string
+	if filter.Schema != nil {
+		schema = filter.Schema.String()
+	}
+
+	objects, err := listObjectPrivileges(ctx, db, string(filter.ObjectType), schema)
+	if err != nil {
+		return nil, err
+	}
+
+	defaults, err := listDefaultPrivileges(ctx, db, string(filter.ObjectType), schema)
+	if err != nil {
+		return nil, err
+	}
+
+	return &connection.DatabasePrivileges{Objects: objects, Defaults: defaults}, nil
+}
+
+func listObjectPrivileges(ctx context.Context, db *sql.DB, objectType, schema string) ([]connection.ObjectPrivileges, error) {
+	// Objects installed by extensions are skipped, as in GetFunctions.
+	query := `
+WITH user_namespaces AS (
+    SELECT oid, nspname, nspowner, nspacl
+    FROM pg_namespace
+    WHERE nspname NOT IN ('pg_catalog', 'information_schema')
+      AND nspname NOT LIKE 'pg\_toast%'
+      AND nspname NOT LIKE 'pg\_temp\_%'
+)
+SELECT * FROM (
+    SELECT 'database' AS object_type, '' AS schema_name, d.datname::text AS name, '' AS arguments,
+           pg_get_userbyid(d.datdba) AS owner,
+           COALESCE(d.datacl, acldefault('d', d.datdba))::text[] AS acl
+    FROM pg_database d
+    WHERE d.datname = current_database()
+    UNION ALL
+    SELECT 'schema', '', n.nspname::text, '', pg_get_userbyid(n.nspowner),
+           COALESCE(n.nspacl, acldefault('n', n.nspowner))::text[]
+    FROM user_namespaces n
+    UNION ALL
+    SELECT CASE c.relkind WHEN 'S' THEN 'sequence' ELSE 'table' END, n.nspname::text, c.relname::text, '',
+           pg_get_userbyid(c.relowner),
+           COALESCE(c.relacl, acldefault(CASE c.relkind WHEN 'S' THEN 's' ELSE 'r' END::"char", c.relowner))::text[]
+    FROM pg_class c
+    JOIN user_namespaces n ON n.oid = c.relnamespace
+    WHERE c.relkind IN ('r', 'p', 'v', 'm', 'f', 'S')
+      AND NOT EXISTS (SELECT 1 FROM pg_depend dep WHERE dep.objid = c.oid AND dep.deptype = 'e')
+    UNION ALL
+    SELECT 'function', n.nspname::text, p.proname::text, pg_get_function_identity_arguments(p.oid),
+           pg_get_userbyid(p.proowner),
+           COALESCE(p.proacl, acldefault('f', p.proowner))::text[]
+    FROM pg_proc p
+    JOIN user_namespaces n ON n.oid = p.pronamespace
+    WHERE NOT EXISTS (SELECT 1 FROM pg_depend dep WHERE dep.objid = p.oid AND dep.deptype = 'e')
+) objects
+WHERE ($1 = '' OR object_type = $1)
+  AND ($2 = '' OR schema_name = $2 OR (object_type = 'schema' AND name = $2))
+ORDER BY CASE object_type WHEN 'database' THEN 0 WHEN 'schema' THEN 1 ELSE 2 END,
+         schema_name, object_type, name, arguments;
+`
+
+	rows, err := db.QueryContext(ctx, query, objectType, schema)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
+	}
+	defer rows.Close()
+
+	objects := make([]connection.ObjectPrivileges, 0)
+	for rows.Next() {
+		var object connection.ObjectPrivileges
+		var kind string
+		var acl pq.StringArray
+		if err := rows.Scan(&kind, &object.Schema, &object.Name, &object.Arguments, &object.Owner, &acl); err != nil {
+			return nil, fmt.Errorf("%w: scanning object privileges: %v", connection.ErrQueryFailed, err)
+		}
+		object.ObjectType = connection.PrivilegeObjectType(kind)
+		if object.ACL, err = parseACL(acl); err != nil {
+			return nil, err
+		}
+		objects = append(objects, object)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: iterating object privileges: %v", connection.ErrQueryFailed, err)
+	}
+
+	return objects, nil
+}
+
+func listDefaultPrivileges(ctx context.Context, db *sql.DB, objectType, schema string) ([]connection.DefaultPrivileges, error) {
+	// Default privileges on types ('T') and large objects ('L') are not handled by Mesa.
+	query := `
+SELECT * FROM (
+    SELECT pg_get_userbyid(a.defaclrole) AS role,
+           COALESCE(n.nspname::text, '') AS schema_name,
+           CASE a.defaclobjtype
+               WHEN 'r' THEN 'table'
+               WHEN 'S' THEN 'sequence'
+               WHEN 'f' THEN 'function'
+               WHEN 'n' THEN 'schema'
+           END AS object_type,
+           a.defaclacl::text[] AS acl
+    FROM pg_default_acl a
+    LEFT JOIN pg_namespace n ON n.oid = a.defaclnamespace
+    WHERE a.defaclobjtype IN ('r', 'S', 'f', 'n')
+) defaults
+WHERE ($1 = '' OR object_type = $1)
+  AND ($2 = '' OR schema_name = $2)
+ORDER BY role, schema_name, object_type;
+`
+
+	rows, err := db.QueryContext(ctx, query, objectType, schema)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
+	}
+	defer rows.Close()
+
+	defaults := make([]connection.DefaultPrivileges, 0)
+	for rows.Next() {
+		var entry connection.DefaultPrivileges
+		var kind string
+		var acl pq.StringArray
+		if err := rows.Scan(&entry.Role, &entry.Schema, &kind, &acl); err != nil {
+			return nil, fmt.Errorf("%w: scanning default privileges: %v", connection.ErrQueryFailed, err)
+		}
+		entry.ObjectType = connection.PrivilegeObjectType(kind)
+		if entry.ACL, err = parseACL(acl); err != nil {
+			return nil, err
+		}
+		defaults = append(defaults, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: iterating default privileges: %v", connection.ErrQueryFailed, err)
+	}
+
+	return defaults, nil
+}
+
+func parseACL(items []string) ([]connection.ACLEntry, error) {
+	entries := make([]connection.ACLEntry, 0, len(items))
+	for _, item := range items {
+		entry, err := connection.ParseACLItem(item)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// privilegeSince is the server_version_num of the first release that knows each privilege
+// added after the oldest supported server.
+var privilegeSince = map[connection.Privilege]int{
+	connection.PrivilegeMaintain: 170000,
+}
+
+// GetEffectivePrivileges checks each table privilege of role with has_table_privilege, which
+// accounts for role membership, PUBLIC grants and ownership.
+func (h *Gateway) GetEffectivePrivileges(ctx context.Context, conn connection.Connection, password string, dbName, role connection.Identifier, schema *connection.Identifier) (*connection.EffectivePrivileges, error) {
+	db, err := h.connect(conn, password, dbName)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	checks := make([]string, len(connection.TablePrivileges))
+	for i, privilege := range connection.TablePrivileges {
+		checks[i] = fmt.Sprintf("has_table_privilege($1::name, c.oid, %s)", quoteLiteral(string(privilege)))
+		// Older servers reject the privilege name, so it is reported as not held there.
+		if version, ok := privilegeSince[privilege]; ok {
+			checks[i] = fmt.Sprintf("CASE WHEN current_setting('server_version_num')::int >= %d THEN %s ELSE false END", version, checks[i])
+		}
+	}
+
+	query := fmt.Sprintf(`
+SELECT n.nspname, c.relname,
+       pg_has_role($1::name, c.relowner, 'USAGE'),
+       has_schema_privilege($1::name, n.oid, 'USAGE'),
+       %s
+FROM pg_class c
+JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE c.relkind IN ('r', 'p', 'v', 'm', 'f')
+  AND n.nspname NOT IN ('pg_catalog', 'information_schema')
+  AND n.nspname NOT LIKE 'pg\_toast%%'
+  AND n.nspname NOT LIKE 'pg\_temp\_%%'
+  AND ($2 = '' OR n.nspname = $2)
+ORDER BY n.nspname, c.relname;
+`, strings.Join(checks, ",\n       "))
+
+	var schemaName string
+	if schema != nil {
+		schemaName = schema.String()
+	}
+
+	rows, err := db.QueryContext(ctx, query, role.String(), schemaName)
+	if err != nil {
+		return nil, roleError("checking privileges", err)
+	}
+	defer rows.Close()
+
+	result := &connection.EffectivePrivileges{Role: role.String(), Tables: make([]connection.TableAccess, 0)}
+	granted := make([]bool, len(connection.TablePrivileges))
+	for rows.Next() {
+		var access connection.TableAccess
+		dest := []any{&access.Schema, &access.Table, &access.Owner, &access.SchemaUsage}
+		for i := range granted {
+			dest = append(dest, &granted[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("%w: scanning table privileges: %v", connection.ErrQueryFailed, err)
+		}
+
+		access.Privileges = make([]connection.Privilege, 0, len(granted))
+		for i, ok := range granted {
+			if ok {
+				access.Privileges = append(access.Privileges, connection.TablePrivileges[i])
+			}
+		}
+		result.Tables = append(result.Tables, access)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: iterating table privileges: %v", connection.ErrQueryFailed, err)
+	}
+
+	return result, nil
+}
+
+func (h *Gateway) GrantPrivileges(ctx context.Context, conn connection.Connection, password string, dbName connection.Identifier, change connection.PrivilegeChange) error {
+	return h.changePrivileges(ctx, conn, password, dbName, change, true)
+}
+
+func (h *Gateway) RevokePrivileges(ctx context.Context, conn connection.Connection, password string, dbName connection.Identifier, change connection.PrivilegeChange) error {
+	return h.changePrivileges(ctx, conn, password, dbName, change, false)
+}
+
+func (h *Gateway) changePrivileges(ctx context.Context, conn connection.Connection, password string, dbName connection.Identifier, change connection.PrivilegeChange, grant bool) error {
+	if err := change.Validate(); err != nil {
+		return err
+	}
+
+	db, err := h.connect(conn, password, dbName)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	var target string
+	if change.Default {
+		target = defaultPrivilegeTargets[change.ObjectType]
+	} else if target, err = privilegeTarget(ctx, db, change); err != nil {
+		return err
+	}
+
+	privileges := "ALL PRIVILEGES"
+	if len(change.Privileges) > 0 {
+		names := make([]string, len(change.Privileges))
+		for i, p := range change.Privileges {
+			names[i] = string(p)
+		}
+		privileges = strings.Join(names, ", ")
+	}
+
+	grantee := "PUBLIC"
+	if change.Grantee != nil {
+		grantee = change.Grantee.Quoted()
+	}
+
+	var b strings.Builder
+	if change.Default {
+		b.WriteString("ALTER DEFAULT PRIVILEGES ")
+		if change.ForRole != nil {
+			fmt.Fprintf(&b, "FOR ROLE %s ", change.ForRole.Quoted())
+		}
+		if change.Schema != nil {
+			fmt.Fprintf(&b, "IN SCHEMA %s ", change.Schema.Quoted())
+		}
+	}
+
+	action := "granting privileges"
+	if grant {
+		fmt.Fprintf(&b, "GRANT %s ON %s TO %s", privileges, target, grantee)
+		if change.GrantOption {
+			b.WriteString(" WITH GRANT OPTION")
+		}
+	} else {
+		action = "revoking privileges"
+		b.WriteString("REVOKE ")
+		if change.GrantOption {
+			b.WriteString("GRANT OPTION FOR ")
+		}
+		fmt.Fprintf(&b, "%s ON %s FROM %s", privileges, target, grantee)
+		if change.Cascade {
+			b.WriteString(" CASCADE")
+		}
+	}
+
+	if _, err := db.ExecContext(ctx, b.String()); err != nil {
+		return privilegeError(action, err)
+	}
+	return nil
+}
+
+var defaultPrivilegeTargets = map[connection.PrivilegeObjectType]string{
+	connection.PrivilegeOnSchema:   "SCHEMAS",
+	connection.PrivilegeOnTable:    "TABLES",
+	connection.PrivilegeOnSequence: "SEQUENCES",
+	connection.PrivilegeOnFunction: "FUNCTIONS",
+}
+
+// privilegeTarget builds the ON clause of a GRANT/REVOKE. Function arguments are only used
+// after matching an existing overload.
+func privilegeTarget(ctx context.Context, db *sql.DB, change connection.PrivilegeChange) (string, error) {
+	switch change.ObjectType {
+	case connection.PrivilegeOnDatabase:
+		return "DATABASE " + change.Object.Quoted(), nil
+	case connection.PrivilegeOnSchema:
+		return "SCHEMA " + change.Object.Quoted(), nil
+	}
+
+	if change.AllInSchema {
+		kind := map[connection.PrivilegeObjectType]string{
+			connection.PrivilegeOnTable:    "TABLES",
+			connection.PrivilegeOnSequence: "SEQUENCES",
+			connection.PrivilegeOnFunction: "ROUTINES",
+		}[change.ObjectType]
+		return fmt.Sprintf("ALL %s IN SCHEMA %s", kind, change.Schema.Quoted()), nil
+	}
+
+	switch change.ObjectType {
+	case connection.PrivilegeOnTable:
+		return fmt.Sprintf("TABLE %s.%s", change.Schema.Quoted(), change.Object.Quoted()), nil
+	case connection.PrivilegeOnSequence:
+		return fmt.Sprintf("SEQUENCE %s.%s", change.Schema.Quoted(), change.Object.Quoted()), nil
+	}
+
+	var exists bool
+	err := db.QueryRowContext(ctx, `
+SELECT EXISTS (
+    SELECT 1
+    FROM pg_proc p
+    JOIN pg_namespace n ON n.oid = p.pronamespace
+    WHERE n.nspname = $1 AND p.proname = $2 AND pg_get_function_identity_arguments(p.oid) = $3
+)`,
+		change.Schema.String(), change.Object.String(), *change.Arguments,
+	).Scan(&exists)
+	if err != nil {
+		return "", fmt.Errorf("%w: resolving function: %v", connection.ErrQueryFailed, err)
+	}
+	if !exists {
+		return "", fmt.Errorf("%w: function %s.%s(%s)", connection.ErrResourceNotFound, change.Schema, change.Object, *change.Arguments)
+	}
+
+	// The arguments matched the server's own rendering, so they are safe to embed.
+	return fmt.Sprintf("ROUTINE %s.%s(%s)", change.Schema.Quoted(), change.Object.Quoted(), *change.Arguments), nil
+}
+
+// privilegeError maps the SQLSTATEs of GRANT/REVOKE; they mostly match the role commands,
+// plus undefined tables, schemas and databases.
+func privilegeError(action string, err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "42P01", "3F000", "3D000": // undefined_table, invalid_schema_name, invalid_catalog_name
+			return fmt.Errorf("%w: %s", connection.ErrResourceNotFound, pgErr.Message)
+		}
+	}
+	return roleError(action, err)
+}
@@ -0,0 +1,195 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+)
+
+// currentWALPosition is the newest WAL position known to the server: the insert position on a
+// primary and the last received position on a standby.
+const currentWALPosition = `CASE WHEN pg_is_in_recovery() THEN pg_last_wal_receive_lsn() ELSE pg_current_wal_lsn() END`
+
+func (h *Gateway) GetReplicaStatus(ctx context.Context, conn connection.Connection, password string) (*connection.ReplicaStatus, error) {
+	db, err := h.connect(conn, password, postgresDBName())
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	// pg_is_wal_replay_paused() raises an error outside recovery, hence the CASE.
+	query := `
+SELECT
+    pg_is_in_recovery(),
+    CASE WHEN pg_is_in_recovery() THEN pg_is_wal_replay_paused() ELSE false END,
+    COALESCE(pg_last_wal_receive_lsn()::text, ''),
+    COALESCE(pg_last_wal_replay_lsn()::text, ''),
+    COALESCE(pg_wal_lsn_diff(pg_last_wal_receive_lsn(), pg_last_wal_replay_lsn()), 0)::bigint,
+    pg_last_xact_replay_timestamp(),
+    EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp())::float8;
+`
+
+	var status connection.ReplicaStatus
+	var lastReplay sql.NullTime
+	var delaySeconds sql.NullFloat64
+	if err := db.QueryRowContext(ctx, query).Scan(
+		&status.InRecovery,
+		&status.ReplayPaused,
+		&status.ReceiveLSN,
+		&status.ReplayLSN,
+		&status.ReplayLagBytes,
+		&lastReplay,
+		&delaySeconds,
+	); err != nil {
+		return nil, fmt.Errorf("%w: reading recovery status: %v", connection.ErrQueryFailed, err)
+	}
+
+	status.LastReplayAt = nullTime(lastReplay)
+	status.ReplayDelay = nullSeconds(delaySeconds)
+
+	return &status, nil
+}
+
+func (h *Gateway) ListReplicationClients(ctx context.Context, conn connection.Connection, password string) ([]connection.ReplicationClient, error) {
+	db, err := h.connect(conn, password, postgresDBName())
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	query := `
+SELECT
+    pid,
+    COALESCE(usename, ''),
+    COALESCE(application_name, ''),
+    COALESCE(client_addr::text, ''),
+    COALESCE(state, ''),
+    COALESCE(sync_state, ''),
+    COALESCE(sent_lsn::text, ''),
+    COALESCE(write_lsn::text, ''),
+    COALESCE(flush_lsn::text, ''),
+    COALESCE(replay_lsn::text, ''),
+    EXTRACT(EPOCH FROM write_lag)::float8,
+    EXTRACT(EPOCH FROM flush_lag)::float8,
+    EXTRACT(EPOCH FROM replay_lag)::float8,
+    COALESCE(pg_wal_lsn_diff(` + currentWALPosition + `, replay_lsn), 0)::bigint,
+    backend_start
+FROM pg_stat_replication
+ORDER BY application_name, pid;
+`
+
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
+	}
+	defer rows.Close()
+
+	clients := make([]connection.ReplicationClient, 0)
+	for rows.Next() {
+		var c connection.ReplicationClient
+		var writeLag, flushLag, replayLag sql.NullFloat64
+		if err := rows.Scan(
+			&c.PID,
+			&c.User,
+			&c.ApplicationName,
+			&c.ClientAddr,
+			&c.State,
+			&c.SyncState,
+			&c.SentLSN,
+			&c.WriteLSN,
+			&c.FlushLSN,
+			&c.ReplayLSN,
+			&writeLag,
+			&flushLag,
+			&replayLag,
+			&c.ReplayLagBytes,
+			&c.BackendStart,
+		); err != nil {
+			return nil, fmt.Errorf("%w: scanning replication client: %v", connection.ErrQueryFailed, err)
+		}
+
+		c.WriteLag = nullSeconds(writeLag)
+		c.FlushLag = nullSeconds(flushLag)
+		c.ReplayLag = nullSeconds(replayLag)
+		clients = append(clients, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: iterating replication clients: %v", connection.ErrQueryFailed, err)
+	}
+
+	return clients, nil
+}
+
+func (h *Gateway) ListReplicationSlots(ctx context.Context, conn connection.Connection, password string) ([]connection.ReplicationSlot, error) {
+	db, err := h.connect(conn, password, postgresDBName())
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	query := `
+SELECT
+    slot_name,
+    COALESCE(plugin, ''),
+    slot_type,
+    COALESCE(database, ''),
+    active,
+    active_pid,
+    COALESCE(restart_lsn::text, ''),
+    COALESCE(confirmed_flush_lsn::text, ''),
+    COALESCE(pg_wal_lsn_diff(` + currentWALPosition + `, restart_lsn), 0)::bigint,
+    COALESCE(wal_status, '')
+FROM pg_replication_slots
+ORDER BY slot_name;
+`
+
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
+	}
+	defer rows.Close()
+
+	slots := make([]connection.ReplicationSlot, 0)
+	for rows.Next() {
+		var s connection.ReplicationSlot
+		var activePID sql.NullInt64
+		if err := rows.Scan(
+			&s.Name,
+			&s.Plugin,
+			&s.SlotType,
+			&s.Database,
+			&s.Active,
+			&activePID,
+			&s.RestartLSN,
+			&s.ConfirmedFlushLSN,
+			&s.RetainedWALBytes,
+			&s.WALStatus,
+		); err != nil {
+			return nil, fmt.Errorf("%w: scanning replication slot: %v", connection.ErrQueryFailed, err)
+		}
+
+		if activePID.Valid {
+			pid := int(activePID.Int64)
+			s.ActivePID = &pid
+		}
+		slots = append(slots, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: iterating replication slots: %v", connection.ErrQueryFailed, err)
+	}
+
+	return slots, nil
+}
+
+func nullSeconds(value sql.NullFloat64) *time.Duration {
+	if !value.Valid {
+		return nil
+	}
+	d := time.Duration(value.Float64 * float64(time.Second))
+	return &d
+}
@@ -0,0 +1,209 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func (h *Gateway) AlterUser(ctx context.Context, conn connection.Connection, password string, username connection.Identifier, changes connection.RoleChanges) error {
+	if err := changes.Validate(); err != nil {
+		return err
+	}
+
+	db, err := h.connect(conn, password, postgresDBName())
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	options := make([]string, 0, 8)
+	if changes.Password != nil {
+		options = append(options, "PASSWORD "+quoteLiteral(*changes.Password))
+	}
+	options = appendRoleFlag(options, changes.CanLogin, "LOGIN")
+	options = appendRoleFlag(options, changes.IsSuperUser, "SUPERUSER")
+	options = appendRoleFlag(options, changes.CanCreateDB, "CREATEDB")
+	options = appendRoleFlag(options, changes.CanCreateRole, "CREATEROLE")
+	options = appendRoleFlag(options, changes.Replication, "REPLICATION")
+	if changes.ConnLimit != nil {
+		options = append(options, fmt.Sprintf("CONNECTION LIMIT %d", *changes.ConnLimit))
+	}
+	switch {
+	case changes.ValidUntil != nil:
+		options = append(options, "VALID UNTIL "+quoteLiteral(changes.ValidUntil.UTC().Format(time.RFC3339)))
+	case changes.ClearValidUntil:
+		options = append(options, "VALID UNTIL 'infinity'")
+	}
+
+	query := fmt.Sprintf("ALTER ROLE %s WITH %s", username.Quoted(), strings.Join(options, " "))
+	if _, err := db.ExecContext(ctx, query); err != nil {
+		return roleError("altering role", err)
+	}
+	return nil
+}
+
+// DropUser drops the role after handling the objects it owns in every database, as asked by
+// opts. Without options, a role that still owns objects fails with ErrRoleHasDependents.
+func (h *Gateway) DropUser(ctx context.Context, conn connection.Connection, password string, username connection.Identifier, opts connection.DropRoleOptions) error {
+	if opts.ReassignTo != nil || opts.DropOwned {
+		databases, err := h.connectableDatabases(ctx, conn, password)
+		if err != nil {
+			return err
+		}
+
+		for _, dbName := range databases {
+			if err := h.releaseOwned(ctx, conn, password, dbName, username, opts); err != nil {
+				return err
+			}
+		}
+	}
+
+	db, err := h.connect(conn, password, postgresDBName())
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if _, err := db.ExecContext(ctx, fmt.Sprintf("DROP ROLE %s", username.Quoted())); err != nil {
+		return roleError("dropping role", err)
+	}
+	return nil
+}
+
+// releaseOwned runs REASSIGN OWNED and/or DROP OWNED inside one database, in a transaction.
+func (h *Gateway) releaseOwned(ctx context.Context, conn connection.Connection, password string, dbName, username connection.Identifier, opts connection.DropRoleOptions) error {
+	db, err := h.connect(conn, password, dbName)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
+	}
+	defer tx.Rollback()
+
+	if opts.ReassignTo != nil {
+		query := fmt.Sprintf("REASSIGN OWNED BY %s TO %s", username.Quoted(), opts.ReassignTo.Quoted())
+		if _, err := tx.ExecContext(ctx, query); err != nil {
+			return roleError(fmt.Sprintf("reassigning objects in %s", dbName), err)
+		}
+	}
+	if opts.DropOwned {
+		query := fmt.Sprintf("DROP OWNED BY %s", username.Quoted())
+		if _, err := tx.ExecContext(ctx, query); err != nil {
+			return roleError(fmt.Sprintf("dropping objects in %s", dbName), err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
+	}
+	return nil
+}
+
+// connectableDatabases lists the databases that accept connections. A name that is not a valid
+// Identifier fails the whole list, before any database is changed: skipping it would leave the
+// role's objects there and DROP ROLE would fail without saying where.
+func (h *Gateway) connectableDatabases(ctx context.Context, conn connection.Connection, password string) ([]connection.Identifier, error) {
+	db, err := h.connect(conn, password, postgresDBName())
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	rows, err := db.QueryContext(ctx, `SELECT datname FROM pg_database WHERE datallowconn ORDER BY datname`)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
+	}
+	defer rows.Close()
+
+	databases := make([]connection.Identifier, 0)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("%w: scanning database: %v", connection.ErrQueryFailed, err)
+		}
+		dbName, err := connection.NewIdentifier(name)
+		if err != nil {
+			return nil, fmt.Errorf("%w: database %q cannot be opened to release the role's objects, reassign or drop them there first", connection.ErrInvalidIdentifier, name)
+		}
+		databases = append(databases, dbName)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: iterating databases: %v", connection.ErrQueryFailed, err)
+	}
+
+	return databases, nil
+}
+
+func (h *Gateway) GrantRole(ctx context.Context, conn connection.Connection, password string, role, member connection.Identifier, withAdmin bool) error {
+	if role == member {
+		return connection.ErrSelfMembership
+	}
+
+	db, err := h.connect(conn, password, postgresDBName())
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	query := fmt.Sprintf("GRANT %s TO %s", role.Quoted(), member.Quoted())
+	if withAdmin {
+		query += " WITH ADMIN OPTION"
+	}
+	if _, err := db.ExecContext(ctx, query); err != nil {
+		return roleError("granting role", err)
+	}
+	return nil
+}
+
+func (h *Gateway) RevokeRole(ctx context.Context, conn connection.Connection, password string, role, member connection.Identifier) error {
+	db, err := h.connect(conn, password, postgresDBName())
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	query := fmt.Sprintf("REVOKE %s FROM %s", role.Quoted(), member.Quoted())
+	if _, err := db.ExecContext(ctx, query); err != nil {
+		return roleError("revoking role", err)
+	}
+	return nil
+}
+
+func appendRoleFlag(options []string, value *bool, flag string) []string {
+	if value == nil {
+		return options
+	}
+	if *value {
+		return append(options, flag)
+	}
+	return append(options, "NO"+flag)
+}
+
+// roleError maps the SQLSTATEs the role commands are expected to hit to domain errors.
+func roleError(action string, err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "42501": // insufficient_privilege
+			return fmt.Errorf("%w: %s", connection.ErrPermissionDenied, pgErr.Message)
+		case "42704": // undefined_object
+			return fmt.Errorf("%w: %s", connection.ErrResourceNotFound, pgErr.Message)
+		case "2BP01": // dependent_objects_still_exist
+			return fmt.Errorf("%w: %s", connection.ErrRoleHasDependents, pgErr.Detail)
+		case "0LP01": // invalid_grant_operation, ex: circular membership
+			return fmt.Errorf("%w: %s: %s", connection.ErrInvalidConfiguration, action, pgErr.Message)
+		}
+	}
+	return fmt.Errorf("%w: %s: %v", connection.ErrQueryFailed, action, err)
+}
@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/savedquery"
+	"github.com/felipemalacarne/mesa/internal/infrastructure/postgres/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// savedQueryParam is how a parameter definition is stored in the params JSON column.
+type savedQueryParam struct {
+	Name        string  `json:"name"`
+	Type        string  `json:"type"`
+	Description string  `json:"description"`
+	Default     *string `json:"default,omitempty"`
+}
+
+type SavedQueryRepository struct {
+	queries *sqlc.Queries
+}
+
+func NewSavedQueryRepository(pool *pgxpool.Pool) *SavedQueryRepository {
+	return &SavedQueryRepository{
+		queries: sqlc.New(pool),
+	}
+}
+
+func (r *SavedQueryRepository) SaveQuery(ctx context.Context, q *savedquery.Query) error {
+	tags, err := json.Marshal(q.Tags)
+	if err != nil {
+		return err
+	}
+
+	params := make([]savedQueryParam, len(q.Params))
+	for i, p := range q.Params {
+		params[i] = savedQueryParam{Name: p.Name, Type: string(p.Type), Description: p.Description, Default: p.Default}
+	}
+	encodedParams, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	var connectionID pgtype.UUID
+	if q.ConnectionID != nil {
+		connectionID = pgtype.UUID{Bytes: *q.ConnectionID, Valid: true}
+	}
+	var driver pgtype.Text
+	if q.Driver != nil {
+		driver = pgtype.Text{String: string(*q.Driver), Valid: true}
+	}
+
+	return r.queries.UpsertSavedQuery(ctx, sqlc.UpsertSavedQueryParams{
+		ID:           pgtype.UUID{Bytes: q.ID, Valid: true},
+		Name:         q.Name,
+		Description:  q.Description,
+		Sql:          q.SQL,
+		ConnectionID: connectionID,
+		Driver:       driver,
+		Tags:         tags,
+		Params:       encodedParams,
+		CreatedAt:    pgtype.Timestamptz{Time: q.CreatedAt, Valid: true},
+		UpdatedAt:    pgtype.Timestamptz{Time: q.UpdatedAt, Valid: true},
+	})
+}
+
+func (r *SavedQueryRepository) FindQuery(ctx context.Context, id uuid.UUID) (*savedquery.Query, error) {
+	record, err := r.queries.GetSavedQuery(ctx, pgtype.UUID{Bytes: id, Valid: true})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainSavedQuery(record)
+}
+
+func (r *SavedQueryRepository) ListQueries(ctx context.Context) ([]*savedquery.Query, error) {
+	rows, err := r.queries.ListSavedQueries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*savedquery.Query, 0, len(rows))
+	for _, record := range rows {
+		q, err := toDomainSavedQuery(record)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, q)
+	}
+
+	return result, nil
+}
+
+func (r *SavedQueryRepository) DeleteQuery(ctx context.Context, id uuid.UUID) error {
+	return r.queries.DeleteSavedQuery(ctx, pgtype.UUID{Bytes: id, Valid: true})
+}
+
+func toDomainSavedQuery(record sqlc.SavedQuery) (*savedquery.Query, error) {
+	tags := make([]string, 0)
+	if err := json.Unmarshal(record.Tags, &tags); err != nil {
+		return nil, err
+	}
+
+	var stored []savedQueryParam
+	if err := json.Unmarshal(record.Params, &stored); err != nil {
+		return nil, err
+	}
+	params := make([]savedquery.Param, len(stored))
+	for i, p := range stored {
+		paramType, err := savedquery.NewParamType(p.Type)
+		if err != nil {
+			return nil, err
+		}
+		params[i] = savedquery.Param{Name: p.Name, Type: paramType, Description: p.Description, Default: p.Default}
+	}
+
+	var connectionID *uuid.UUID
+	if record.ConnectionID.Valid {
+		id := uuid.UUID(record.ConnectionID.Bytes)
+		connectionID = &id
+	}
+	var driver *connection.Driver
+	if record.Driver.Valid {
+		d := connection.Driver(record.Driver.String)
+		driver = &d
+	}
+
+	return &savedquery.Query{
+		ID:           record.ID.Bytes,
+		Name:         record.Name,
+		Description:  record.Description,
+		SQL:          record.Sql,
+		ConnectionID: connectionID,
+		Driver:       driver,
+		Tags:         tags,
+		Params:       params,
+		CreatedAt:    record.CreatedAt.Time,
+		UpdatedAt:    record.UpdatedAt.Time,
+	}, nil
+}
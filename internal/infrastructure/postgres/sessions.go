@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/lib/pq"
+)
+
+// SignalSessions cancels or terminates every session matching the filter, reporting per PID
+// whether the server accepted the signal.
+func (h *Gateway) SignalSessions(ctx context.Context, conn connection.Connection, password string, filter connection.SessionFilter, signal connection.SessionSignal) ([]connection.SignalResult, error) {
+	db, err := h.connect(conn, password, postgresDBName())
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	function := "pg_cancel_backend"
+	if signal == connection.SignalTerminate {
+		function = "pg_terminate_backend"
+	}
+
+	where, args := sessionFilterClause(filter)
+	query := fmt.Sprintf("SELECT pid, %s(pid) FROM pg_stat_activity %s ORDER BY pid;", function, where)
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: signalling sessions: %v", connection.ErrQueryFailed, err)
+	}
+	defer rows.Close()
+
+	results := make([]connection.SignalResult, 0)
+	for rows.Next() {
+		var result connection.SignalResult
+		if err := rows.Scan(&result.PID, &result.Signalled); err != nil {
+			return nil, fmt.Errorf("%w: scanning signal result: %v", connection.ErrQueryFailed, err)
+		}
+		results = append(results, result)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: iterating signal results: %v", connection.ErrQueryFailed, err)
+	}
+
+	return results, nil
+}
+
+// sessionFilterClause builds the WHERE clause over pg_stat_activity for a filter. The
+// session running the query itself is always excluded.
+func sessionFilterClause(filter connection.SessionFilter) (string, []any) {
+	conditions := []string{"pid <> pg_backend_pid()"}
+	var args []any
+	arg := func(value any) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if len(filter.States) == 0 {
+		conditions = append(conditions, "state <> 'idle'")
+	} else {
+		states := make([]string, len(filter.States))
+		for i, state := range filter.States {
+			states[i] = state.ServerValue()
+		}
+		conditions = append(conditions, "state = ANY("+arg(pq.StringArray(states))+"::text[])")
+	}
+
+	if filter.User != "" {
+		conditions = append(conditions, "usename = "+arg(filter.User))
+	}
+
+	if filter.Database != "" {
+		conditions = append(conditions, "datname = "+arg(filter.Database))
+	}
+
+	if filter.MinDuration > 0 {
+		conditions = append(conditions, "now() - COALESCE(query_start, backend_start) >= make_interval(secs => "+arg(filter.MinDuration.Seconds())+")")
+	}
+
+	if len(filter.PIDs) > 0 {
+		pids := make([]int64, len(filter.PIDs))
+		for i, pid := range filter.PIDs {
+			pids[i] = int64(pid)
+		}
+		conditions = append(conditions, "pid = ANY("+arg(pq.Int64Array(pids))+"::int[])")
+	}
+
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
@@ -0,0 +1,196 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lib/pq"
+)
+
+const settingsQuery = `
+SELECT
+    name,
+    COALESCE(setting, ''),
+    COALESCE(unit, ''),
+    COALESCE(category, ''),
+    COALESCE(short_desc, ''),
+    context,
+    vartype,
+    COALESCE(source, ''),
+    COALESCE(boot_val, ''),
+    COALESCE(reset_val, ''),
+    COALESCE(min_val, ''),
+    COALESCE(max_val, ''),
+    COALESCE(enumvals, '{}'),
+    pending_restart
+FROM pg_settings
+`
+
+func (h *Gateway) GetSettings(ctx context.Context, conn connection.Connection, password string) ([]connection.Setting, error) {
+	db, err := h.connect(conn, password, postgresDBName())
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	return querySettings(ctx, db, settingsQuery+"ORDER BY category, name;")
+}
+
+func (h *Gateway) AlterSystem(ctx context.Context, conn connection.Connection, password string, changes []connection.SettingChange) ([]connection.Setting, error) {
+	db, err := h.connect(conn, password, postgresDBName())
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	// ALTER SYSTEM cannot run inside a transaction block, so each change is its own statement.
+	// Names are validated by the domain; values go as literals, one per element for the list
+	// parameters that quote their elements.
+	names := make([]string, 0, len(changes))
+	for _, change := range changes {
+		statement := fmt.Sprintf("ALTER SYSTEM RESET %s", change.Name)
+		if !change.IsReset() {
+			statement = fmt.Sprintf("ALTER SYSTEM SET %s = %s", change.Name, settingValue(change.Name, *change.Value))
+		}
+
+		if _, err := db.ExecContext(ctx, statement); err != nil {
+			return nil, alterSystemError(change.Name, err)
+		}
+		names = append(names, change.Name)
+	}
+
+	if _, err := db.ExecContext(ctx, "SELECT pg_reload_conf()"); err != nil {
+		return nil, fmt.Errorf("%w: reloading configuration: %v", connection.ErrQueryFailed, err)
+	}
+
+	// The reload is signalled asynchronously, so pending_restart may still be false here for a
+	// postmaster parameter; callers should also look at the parameter context.
+	return querySettings(ctx, db, settingsQuery+"WHERE name = ANY($1) ORDER BY name;", pq.Array(names))
+}
+
+// quotedListSettings are the parameters flagged GUC_LIST_QUOTE: the server quotes each
+// element given to SET as an identifier, so 'a,b' as one literal is stored as the single
+// element "a,b", which for shared_preload_libraries keeps the server from starting.
+var quotedListSettings = map[string]bool{
+	"shared_preload_libraries":  true,
+	"session_preload_libraries": true,
+	"local_preload_libraries":   true,
+	"search_path":               true,
+	"temp_tablespaces":          true,
+	"unix_socket_directories":   true,
+}
+
+// settingValue renders the value of SET: a literal, or for the quoted list parameters one
+// literal per comma-separated element, ex: 'pg_stat_statements', 'auto_explain'. Elements may
+// be written double quoted, as SHOW prints them, ex: "$user", public.
+func settingValue(name, value string) string {
+	if !quotedListSettings[name] {
+		return quoteLiteral(value)
+	}
+
+	elements := splitSettingList(value)
+	if len(elements) == 0 {
+		return quoteLiteral("")
+	}
+	quoted := make([]string, len(elements))
+	for i, element := range elements {
+		quoted[i] = quoteLiteral(element)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// splitSettingList splits a list value on the commas outside double quotes, trimming and
+// unquoting the elements.
+func splitSettingList(value string) []string {
+	var elements []string
+	var element strings.Builder
+	inQuotes, wasQuoted := false, false
+	flush := func() {
+		e := element.String()
+		if !wasQuoted {
+			e = strings.TrimSpace(e)
+		}
+		if e != "" {
+			elements = append(elements, e)
+		}
+		element.Reset()
+		wasQuoted = false
+	}
+
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case c == '"' && inQuotes && i+1 < len(value) && value[i+1] == '"':
+			element.WriteByte('"')
+			i++
+		case c == '"':
+			inQuotes = !inQuotes
+			if inQuotes {
+				// Spaces before the opening quote are not part of the element.
+				element.Reset()
+				wasQuoted = true
+			}
+		case c == ',' && !inQuotes:
+			flush()
+		case c == ' ' && !inQuotes && wasQuoted:
+			// Spaces after the closing quote are not part of the element either.
+		default:
+			element.WriteByte(c)
+		}
+	}
+	flush()
+	return elements
+}
+
+func querySettings(ctx context.Context, db *sql.DB, query string, args ...any) ([]connection.Setting, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
+	}
+	defer rows.Close()
+
+	settings := make([]connection.Setting, 0)
+	for rows.Next() {
+		var s connection.Setting
+		if err := rows.Scan(
+			&s.Name,
+			&s.Value,
+			&s.Unit,
+			&s.Category,
+			&s.Description,
+			&s.Context,
+			&s.VarType,
+			&s.Source,
+			&s.BootValue,
+			&s.ResetValue,
+			&s.MinValue,
+			&s.MaxValue,
+			pq.Array(&s.EnumValues),
+			&s.PendingRestart,
+		); err != nil {
+			return nil, fmt.Errorf("%w: scanning setting: %v", connection.ErrQueryFailed, err)
+		}
+		settings = append(settings, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: iterating settings: %v", connection.ErrQueryFailed, err)
+	}
+
+	return settings, nil
+}
+
+// alterSystemError separates missing privileges (ALTER SYSTEM needs superuser or, since
+// PostgreSQL 15, GRANT ALTER SYSTEM) from invalid names and values.
+func alterSystemError(name string, err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "42501" {
+		return fmt.Errorf("%w: %s", connection.ErrPermissionDenied, pgErr.Message)
+	}
+	return fmt.Errorf("%w: %s: %v", connection.ErrQueryFailed, name, err)
+}
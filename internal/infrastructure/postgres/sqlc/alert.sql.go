@@ -0,0 +1,241 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: alert.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deleteAlertRule = `-- name: DeleteAlertRule :exec
+DELETE FROM alert_rules
+WHERE id = $1
+`
+
+func (q *Queries) DeleteAlertRule(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteAlertRule, id)
+	return err
+}
+
+const deleteAlertStatesByRule = `-- name: DeleteAlertStatesByRule :exec
+DELETE FROM alert_states
+WHERE rule_id = $1
+`
+
+func (q *Queries) DeleteAlertStatesByRule(ctx context.Context, ruleID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteAlertStatesByRule, ruleID)
+	return err
+}
+
+const getAlertRule = `-- name: GetAlertRule :one
+SELECT id, name, connection_id, condition, threshold, consecutive_checks, channel, target, enabled, updated_at, created_at
+FROM alert_rules
+WHERE id = $1
+`
+
+func (q *Queries) GetAlertRule(ctx context.Context, id pgtype.UUID) (AlertRule, error) {
+	row := q.db.QueryRow(ctx, getAlertRule, id)
+	var i AlertRule
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.ConnectionID,
+		&i.Condition,
+		&i.Threshold,
+		&i.ConsecutiveChecks,
+		&i.Channel,
+		&i.Target,
+		&i.Enabled,
+		&i.UpdatedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listAlertRules = `-- name: ListAlertRules :many
+SELECT id, name, connection_id, condition, threshold, consecutive_checks, channel, target, enabled, updated_at, created_at
+FROM alert_rules
+ORDER BY created_at
+`
+
+func (q *Queries) ListAlertRules(ctx context.Context) ([]AlertRule, error) {
+	rows, err := q.db.Query(ctx, listAlertRules)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AlertRule{}
+	for rows.Next() {
+		var i AlertRule
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.ConnectionID,
+			&i.Condition,
+			&i.Threshold,
+			&i.ConsecutiveChecks,
+			&i.Channel,
+			&i.Target,
+			&i.Enabled,
+			&i.UpdatedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAlertStates = `-- name: ListAlertStates :many
+SELECT rule_id, connection_id, status, consecutive_breaches, value, message, fired_at, resolved_at, evaluated_at
+FROM alert_states
+ORDER BY rule_id, connection_id
+`
+
+func (q *Queries) ListAlertStates(ctx context.Context) ([]AlertState, error) {
+	rows, err := q.db.Query(ctx, listAlertStates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AlertState{}
+	for rows.Next() {
+		var i AlertState
+		if err := rows.Scan(
+			&i.RuleID,
+			&i.ConnectionID,
+			&i.Status,
+			&i.ConsecutiveBreaches,
+			&i.Value,
+			&i.Message,
+			&i.FiredAt,
+			&i.ResolvedAt,
+			&i.EvaluatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertAlertRule = `-- name: UpsertAlertRule :exec
+INSERT INTO alert_rules (
+    id,
+    name,
+    connection_id,
+    condition,
+    threshold,
+    consecutive_checks,
+    channel,
+    target,
+    enabled,
+    updated_at,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+)
+ON CONFLICT (id) DO UPDATE
+SET name = excluded.name,
+    connection_id = excluded.connection_id,
+    condition = excluded.condition,
+    threshold = excluded.threshold,
+    consecutive_checks = excluded.consecutive_checks,
+    channel = excluded.channel,
+    target = excluded.target,
+    enabled = excluded.enabled,
+    updated_at = excluded.updated_at
+`
+
+type UpsertAlertRuleParams struct {
+	ID                pgtype.UUID
+	Name              string
+	ConnectionID      pgtype.UUID
+	Condition         string
+	Threshold         float64
+	ConsecutiveChecks int32
+	Channel           string
+	Target            string
+	Enabled           bool
+	UpdatedAt         pgtype.Timestamptz
+	CreatedAt         pgtype.Timestamptz
+}
+
+func (q *Queries) UpsertAlertRule(ctx context.Context, arg UpsertAlertRuleParams) error {
+	_, err := q.db.Exec(ctx, upsertAlertRule,
+		arg.ID,
+		arg.Name,
+		arg.ConnectionID,
+		arg.Condition,
+		arg.Threshold,
+		arg.ConsecutiveChecks,
+		arg.Channel,
+		arg.Target,
+		arg.Enabled,
+		arg.UpdatedAt,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const upsertAlertState = `-- name: UpsertAlertState :exec
+INSERT INTO alert_states (
+    rule_id,
+    connection_id,
+    status,
+    consecutive_breaches,
+    value,
+    message,
+    fired_at,
+    resolved_at,
+    evaluated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+)
+ON CONFLICT (rule_id, connection_id) DO UPDATE
+SET status = excluded.status,
+    consecutive_breaches = excluded.consecutive_breaches,
+    value = excluded.value,
+    message = excluded.message,
+    fired_at = excluded.fired_at,
+    resolved_at = excluded.resolved_at,
+    evaluated_at = excluded.evaluated_at
+`
+
+type UpsertAlertStateParams struct {
+	RuleID              pgtype.UUID
+	ConnectionID        pgtype.UUID
+	Status              string
+	ConsecutiveBreaches int32
+	Value               float64
+	Message             string
+	FiredAt             pgtype.Timestamptz
+	ResolvedAt          pgtype.Timestamptz
+	EvaluatedAt         pgtype.Timestamptz
+}
+
+func (q *Queries) UpsertAlertState(ctx context.Context, arg UpsertAlertStateParams) error {
+	_, err := q.db.Exec(ctx, upsertAlertState,
+		arg.RuleID,
+		arg.ConnectionID,
+		arg.Status,
+		arg.ConsecutiveBreaches,
+		arg.Value,
+		arg.Message,
+		arg.FiredAt,
+		arg.ResolvedAt,
+		arg.EvaluatedAt,
+	)
+	return err
+}
@@ -22,7 +22,7 @@ func (q *Queries) DeleteConnection(ctx context.Context, id pgtype.UUID) error {
 }
 
 const getConnection = `-- name: GetConnection :one
-SELECT id, name, driver, host, port, username, password, updated_at, created_at
+SELECT id, name, driver, host, port, username, password, updated_at, created_at, environment, tags, color, description, protection, read_only, ssh_tunnel
 FROM connections
 WHERE id = $1
 `
@@ -40,12 +40,19 @@ func (q *Queries) GetConnection(ctx context.Context, id pgtype.UUID) (Connection
 		&i.Password,
 		&i.UpdatedAt,
 		&i.CreatedAt,
+		&i.Environment,
+		&i.Tags,
+		&i.Color,
+		&i.Description,
+		&i.Protection,
+		&i.ReadOnly,
+		&i.SshTunnel,
 	)
 	return i, err
 }
 
 const listConnections = `-- name: ListConnections :many
-SELECT id, name, driver, host, port, username, password, updated_at, created_at
+SELECT id, name, driver, host, port, username, password, updated_at, created_at, environment, tags, color, description, protection, read_only, ssh_tunnel
 FROM connections
 ORDER BY created_at DESC
 LIMIT 100
@@ -70,6 +77,13 @@ func (q *Queries) ListConnections(ctx context.Context) ([]Connection, error) {
 			&i.Password,
 			&i.UpdatedAt,
 			&i.CreatedAt,
+			&i.Environment,
+			&i.Tags,
+			&i.Color,
+			&i.Description,
+			&i.Protection,
+			&i.ReadOnly,
+			&i.SshTunnel,
 		); err != nil {
 			return nil, err
 		}
@@ -90,10 +104,17 @@ INSERT INTO connections (
     port,
     username,
     password,
+    environment,
+    tags,
+    color,
+    description,
+    protection,
+    read_only,
+    ssh_tunnel,
     updated_at,
     created_at
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
 )
 ON CONFLICT (id) DO UPDATE
 SET name = EXCLUDED.name,
@@ -101,19 +122,33 @@ SET name = EXCLUDED.name,
     host = EXCLUDED.host,
     port = EXCLUDED.port,
     username = EXCLUDED.username,
-    password = EXCLUDED.password
+    password = EXCLUDED.password,
+    environment = EXCLUDED.environment,
+    tags = EXCLUDED.tags,
+    color = EXCLUDED.color,
+    description = EXCLUDED.description,
+    protection = EXCLUDED.protection,
+    read_only = EXCLUDED.read_only,
+    ssh_tunnel = EXCLUDED.ssh_tunnel
 `
 
 type UpsertConnectionParams struct {
-	ID        pgtype.UUID
-	Name      string
-	Driver    string
-	Host      string
-	Port      int32
-	Username  string
-	Password  string
-	UpdatedAt pgtype.Timestamptz
-	CreatedAt pgtype.Timestamptz
+	ID          pgtype.UUID
+	Name        string
+	Driver      string
+	Host        string
+	Port        int32
+	Username    string
+	Password    string
+	Environment string
+	Tags        []byte
+	Color       string
+	Description string
+	Protection  string
+	ReadOnly    bool
+	SshTunnel   []byte
+	UpdatedAt   pgtype.Timestamptz
+	CreatedAt   pgtype.Timestamptz
 }
 
 func (q *Queries) UpsertConnection(ctx context.Context, arg UpsertConnectionParams) error {
@@ -125,6 +160,13 @@ func (q *Queries) UpsertConnection(ctx context.Context, arg UpsertConnectionPara
 		arg.Port,
 		arg.Username,
 		arg.Password,
+		arg.Environment,
+		arg.Tags,
+		arg.Color,
+		arg.Description,
+		arg.Protection,
+		arg.ReadOnly,
+		arg.SshTunnel,
 		arg.UpdatedAt,
 		arg.CreatedAt,
 	)
@@ -0,0 +1,105 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: health.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getConnectionHealth = `-- name: GetConnectionHealth :one
+SELECT connection_id, status, error, latency_ms, checked_at, last_success_at
+FROM connection_health
+WHERE connection_id = $1
+`
+
+func (q *Queries) GetConnectionHealth(ctx context.Context, connectionID pgtype.UUID) (ConnectionHealth, error) {
+	row := q.db.QueryRow(ctx, getConnectionHealth, connectionID)
+	var i ConnectionHealth
+	err := row.Scan(
+		&i.ConnectionID,
+		&i.Status,
+		&i.Error,
+		&i.LatencyMs,
+		&i.CheckedAt,
+		&i.LastSuccessAt,
+	)
+	return i, err
+}
+
+const listConnectionHealth = `-- name: ListConnectionHealth :many
+SELECT connection_id, status, error, latency_ms, checked_at, last_success_at
+FROM connection_health
+ORDER BY connection_id
+`
+
+func (q *Queries) ListConnectionHealth(ctx context.Context) ([]ConnectionHealth, error) {
+	rows, err := q.db.Query(ctx, listConnectionHealth)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ConnectionHealth{}
+	for rows.Next() {
+		var i ConnectionHealth
+		if err := rows.Scan(
+			&i.ConnectionID,
+			&i.Status,
+			&i.Error,
+			&i.LatencyMs,
+			&i.CheckedAt,
+			&i.LastSuccessAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertConnectionHealth = `-- name: UpsertConnectionHealth :exec
+INSERT INTO connection_health (
+    connection_id,
+    status,
+    error,
+    latency_ms,
+    checked_at,
+    last_success_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (connection_id) DO UPDATE
+SET status = excluded.status,
+    error = excluded.error,
+    latency_ms = excluded.latency_ms,
+    checked_at = excluded.checked_at,
+    last_success_at = excluded.last_success_at
+`
+
+type UpsertConnectionHealthParams struct {
+	ConnectionID  pgtype.UUID
+	Status        string
+	Error         string
+	LatencyMs     int64
+	CheckedAt     pgtype.Timestamptz
+	LastSuccessAt pgtype.Timestamptz
+}
+
+func (q *Queries) UpsertConnectionHealth(ctx context.Context, arg UpsertConnectionHealthParams) error {
+	_, err := q.db.Exec(ctx, upsertConnectionHealth,
+		arg.ConnectionID,
+		arg.Status,
+		arg.Error,
+		arg.LatencyMs,
+		arg.CheckedAt,
+		arg.LastSuccessAt,
+	)
+	return err
+}
@@ -0,0 +1,189 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: job.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deleteJobsFinishedBefore = `-- name: DeleteJobsFinishedBefore :exec
+DELETE FROM jobs
+WHERE finished_at IS NOT NULL
+  AND finished_at < $1
+`
+
+func (q *Queries) DeleteJobsFinishedBefore(ctx context.Context, finishedAt pgtype.Timestamptz) error {
+	_, err := q.db.Exec(ctx, deleteJobsFinishedBefore, finishedAt)
+	return err
+}
+
+const getJob = `-- name: GetJob :one
+SELECT id, connection_id, kind, database, target, status, progress, phase, log, error, result, created_at, started_at, finished_at
+FROM jobs
+WHERE id = $1
+`
+
+func (q *Queries) GetJob(ctx context.Context, id pgtype.UUID) (Job, error) {
+	row := q.db.QueryRow(ctx, getJob, id)
+	var i Job
+	err := row.Scan(
+		&i.ID,
+		&i.ConnectionID,
+		&i.Kind,
+		&i.Database,
+		&i.Target,
+		&i.Status,
+		&i.Progress,
+		&i.Phase,
+		&i.Log,
+		&i.Error,
+		&i.Result,
+		&i.CreatedAt,
+		&i.StartedAt,
+		&i.FinishedAt,
+	)
+	return i, err
+}
+
+const interruptJobs = `-- name: InterruptJobs :execrows
+UPDATE jobs
+SET status = 'failed',
+    error = $1,
+    finished_at = $2
+WHERE status IN ('queued', 'running')
+`
+
+type InterruptJobsParams struct {
+	Reason     string
+	FinishedAt pgtype.Timestamptz
+}
+
+func (q *Queries) InterruptJobs(ctx context.Context, arg InterruptJobsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, interruptJobs, arg.Reason, arg.FinishedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listJobs = `-- name: ListJobs :many
+SELECT id, connection_id, kind, database, target, status, progress, phase, log, error, result, created_at, started_at, finished_at
+FROM jobs
+WHERE ($1::uuid IS NULL OR connection_id = $1::uuid)
+  AND ($2::text IS NULL OR status = $2::text)
+ORDER BY created_at DESC
+LIMIT $3
+`
+
+type ListJobsParams struct {
+	ConnectionID pgtype.UUID
+	Status       pgtype.Text
+	RowLimit     int32
+}
+
+func (q *Queries) ListJobs(ctx context.Context, arg ListJobsParams) ([]Job, error) {
+	rows, err := q.db.Query(ctx, listJobs, arg.ConnectionID, arg.Status, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Job{}
+	for rows.Next() {
+		var i Job
+		if err := rows.Scan(
+			&i.ID,
+			&i.ConnectionID,
+			&i.Kind,
+			&i.Database,
+			&i.Target,
+			&i.Status,
+			&i.Progress,
+			&i.Phase,
+			&i.Log,
+			&i.Error,
+			&i.Result,
+			&i.CreatedAt,
+			&i.StartedAt,
+			&i.FinishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertJob = `-- name: UpsertJob :exec
+INSERT INTO jobs (
+    id,
+    connection_id,
+    kind,
+    database,
+    target,
+    status,
+    progress,
+    phase,
+    log,
+    error,
+    result,
+    created_at,
+    started_at,
+    finished_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+)
+ON CONFLICT (id) DO UPDATE
+SET status = excluded.status,
+    progress = excluded.progress,
+    phase = excluded.phase,
+    log = excluded.log,
+    error = excluded.error,
+    result = excluded.result,
+    started_at = excluded.started_at,
+    finished_at = excluded.finished_at
+`
+
+type UpsertJobParams struct {
+	ID           pgtype.UUID
+	ConnectionID pgtype.UUID
+	Kind         string
+	Database     string
+	Target       string
+	Status       string
+	Progress     pgtype.Float8
+	Phase        string
+	Log          []byte
+	Error        string
+	Result       string
+	CreatedAt    pgtype.Timestamptz
+	StartedAt    pgtype.Timestamptz
+	FinishedAt   pgtype.Timestamptz
+}
+
+func (q *Queries) UpsertJob(ctx context.Context, arg UpsertJobParams) error {
+	_, err := q.db.Exec(ctx, upsertJob,
+		arg.ID,
+		arg.ConnectionID,
+		arg.Kind,
+		arg.Database,
+		arg.Target,
+		arg.Status,
+		arg.Progress,
+		arg.Phase,
+		arg.Log,
+		arg.Error,
+		arg.Result,
+		arg.CreatedAt,
+		arg.StartedAt,
+		arg.FinishedAt,
+	)
+	return err
+}
@@ -0,0 +1,161 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: metric.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deleteMetricSamplesBefore = `-- name: DeleteMetricSamplesBefore :exec
+DELETE FROM metric_samples
+WHERE resolution = $1
+  AND sampled_at < $2
+`
+
+type DeleteMetricSamplesBeforeParams struct {
+	Resolution string
+	SampledAt  pgtype.Timestamptz
+}
+
+func (q *Queries) DeleteMetricSamplesBefore(ctx context.Context, arg DeleteMetricSamplesBeforeParams) error {
+	_, err := q.db.Exec(ctx, deleteMetricSamplesBefore, arg.Resolution, arg.SampledAt)
+	return err
+}
+
+const latestMetricSampleTime = `-- name: LatestMetricSampleTime :one
+SELECT sampled_at
+FROM metric_samples
+WHERE connection_id = $1
+  AND resolution = $2
+ORDER BY sampled_at DESC
+LIMIT 1
+`
+
+type LatestMetricSampleTimeParams struct {
+	ConnectionID pgtype.UUID
+	Resolution   string
+}
+
+func (q *Queries) LatestMetricSampleTime(ctx context.Context, arg LatestMetricSampleTimeParams) (pgtype.Timestamptz, error) {
+	row := q.db.QueryRow(ctx, latestMetricSampleTime, arg.ConnectionID, arg.Resolution)
+	var sampled_at pgtype.Timestamptz
+	err := row.Scan(&sampled_at)
+	return sampled_at, err
+}
+
+const listMetricSamples = `-- name: ListMetricSamples :many
+SELECT connection_id, resolution, sampled_at, total_sessions, active_sessions, max_connections, tps, cache_hit_ratio, deadlocks, temp_bytes, database_sizes
+FROM metric_samples
+WHERE connection_id = $1
+  AND resolution = $2
+  AND sampled_at >= $3
+  AND sampled_at < $4
+ORDER BY sampled_at
+`
+
+type ListMetricSamplesParams struct {
+	ConnectionID pgtype.UUID
+	Resolution   string
+	SampledFrom  pgtype.Timestamptz
+	SampledTo    pgtype.Timestamptz
+}
+
+func (q *Queries) ListMetricSamples(ctx context.Context, arg ListMetricSamplesParams) ([]MetricSample, error) {
+	rows, err := q.db.Query(ctx, listMetricSamples,
+		arg.ConnectionID,
+		arg.Resolution,
+		arg.SampledFrom,
+		arg.SampledTo,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []MetricSample{}
+	for rows.Next() {
+		var i MetricSample
+		if err := rows.Scan(
+			&i.ConnectionID,
+			&i.Resolution,
+			&i.SampledAt,
+			&i.TotalSessions,
+			&i.ActiveSessions,
+			&i.MaxConnections,
+			&i.Tps,
+			&i.CacheHitRatio,
+			&i.Deadlocks,
+			&i.TempBytes,
+			&i.DatabaseSizes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertMetricSample = `-- name: UpsertMetricSample :exec
+INSERT INTO metric_samples (
+    connection_id,
+    resolution,
+    sampled_at,
+    total_sessions,
+    active_sessions,
+    max_connections,
+    tps,
+    cache_hit_ratio,
+    deadlocks,
+    temp_bytes,
+    database_sizes
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+)
+ON CONFLICT (connection_id, resolution, sampled_at) DO UPDATE
+SET total_sessions = EXCLUDED.total_sessions,
+    active_sessions = EXCLUDED.active_sessions,
+    max_connections = EXCLUDED.max_connections,
+    tps = EXCLUDED.tps,
+    cache_hit_ratio = EXCLUDED.cache_hit_ratio,
+    deadlocks = EXCLUDED.deadlocks,
+    temp_bytes = EXCLUDED.temp_bytes,
+    database_sizes = EXCLUDED.database_sizes
+`
+
+type UpsertMetricSampleParams struct {
+	ConnectionID   pgtype.UUID
+	Resolution     string
+	SampledAt      pgtype.Timestamptz
+	TotalSessions  int32
+	ActiveSessions int32
+	MaxConnections int32
+	Tps            float64
+	CacheHitRatio  float64
+	Deadlocks      int64
+	TempBytes      int64
+	DatabaseSizes  []byte
+}
+
+func (q *Queries) UpsertMetricSample(ctx context.Context, arg UpsertMetricSampleParams) error {
+	_, err := q.db.Exec(ctx, upsertMetricSample,
+		arg.ConnectionID,
+		arg.Resolution,
+		arg.SampledAt,
+		arg.TotalSessions,
+		arg.ActiveSessions,
+		arg.MaxConnections,
+		arg.Tps,
+		arg.CacheHitRatio,
+		arg.Deadlocks,
+		arg.TempBytes,
+		arg.DatabaseSizes,
+	)
+	return err
+}
@@ -8,14 +8,113 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AlertRule struct {
+	ID                pgtype.UUID
+	Name              string
+	ConnectionID      pgtype.UUID
+	Condition         string
+	Threshold         float64
+	ConsecutiveChecks int32
+	Channel           string
+	Target            string
+	Enabled           bool
+	UpdatedAt         pgtype.Timestamptz
+	CreatedAt         pgtype.Timestamptz
+}
+
+type AlertState struct {
+	RuleID              pgtype.UUID
+	ConnectionID        pgtype.UUID
+	Status              string
+	ConsecutiveBreaches int32
+	Value               float64
+	Message             string
+	FiredAt             pgtype.Timestamptz
+	ResolvedAt          pgtype.Timestamptz
+	EvaluatedAt         pgtype.Timestamptz
+}
+
 type Connection struct {
-	ID        pgtype.UUID
-	Name      string
-	Driver    string
-	Host      string
-	Port      int32
-	Username  string
-	Password  string
-	UpdatedAt pgtype.Timestamptz
-	CreatedAt pgtype.Timestamptz
+	ID          pgtype.UUID
+	Name        string
+	Driver      string
+	Host        string
+	Port        int32
+	Username    string
+	Password    string
+	UpdatedAt   pgtype.Timestamptz
+	CreatedAt   pgtype.Timestamptz
+	Environment string
+	Tags        []byte
+	Color       string
+	Description string
+	Protection  string
+	ReadOnly    bool
+	SshTunnel   []byte
+}
+
+type ConnectionHealth struct {
+	ConnectionID  pgtype.UUID
+	Status        string
+	Error         string
+	LatencyMs     int64
+	CheckedAt     pgtype.Timestamptz
+	LastSuccessAt pgtype.Timestamptz
+}
+
+type Job struct {
+	ID           pgtype.UUID
+	ConnectionID pgtype.UUID
+	Kind         string
+	Database     string
+	Target       string
+	Status       string
+	Progress     pgtype.Float8
+	Phase        string
+	Log          []byte
+	Error        string
+	Result       string
+	CreatedAt    pgtype.Timestamptz
+	StartedAt    pgtype.Timestamptz
+	FinishedAt   pgtype.Timestamptz
+}
+
+type MetricSample struct {
+	ConnectionID   pgtype.UUID
+	Resolution     string
+	SampledAt      pgtype.Timestamptz
+	TotalSessions  int32
+	ActiveSessions int32
+	MaxConnections int32
+	Tps            float64
+	CacheHitRatio  float64
+	Deadlocks      int64
+	TempBytes      int64
+	DatabaseSizes  []byte
+}
+
+type QueryHistory struct {
+	ID           pgtype.UUID
+	ConnectionID pgtype.UUID
+	Database     string
+	Source       string
+	Statement    string
+	DurationMs   float64
+	RowsReturned pgtype.Int8
+	RowsAffected pgtype.Int8
+	Error        string
+	ExecutedAt   pgtype.Timestamptz
+}
+
+type SavedQuery struct {
+	ID           pgtype.UUID
+	Name         string
+	Description  string
+	Sql          string
+	ConnectionID pgtype.UUID
+	Driver       pgtype.Text
+	Tags         []byte
+	Params       []byte
+	CreatedAt    pgtype.Timestamptz
+	UpdatedAt    pgtype.Timestamptz
 }
@@ -0,0 +1,72 @@
+-- name: UpsertAlertRule :exec
+INSERT INTO alert_rules (
+    id,
+    name,
+    connection_id,
+    condition,
+    threshold,
+    consecutive_checks,
+    channel,
+    target,
+    enabled,
+    updated_at,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+)
+ON CONFLICT (id) DO UPDATE
+SET name = excluded.name,
+    connection_id = excluded.connection_id,
+    condition = excluded.condition,
+    threshold = excluded.threshold,
+    consecutive_checks = excluded.consecutive_checks,
+    channel = excluded.channel,
+    target = excluded.target,
+    enabled = excluded.enabled,
+    updated_at = excluded.updated_at;
+
+-- name: GetAlertRule :one
+SELECT id, name, connection_id, condition, threshold, consecutive_checks, channel, target, enabled, updated_at, created_at
+FROM alert_rules
+WHERE id = $1;
+
+-- name: ListAlertRules :many
+SELECT id, name, connection_id, condition, threshold, consecutive_checks, channel, target, enabled, updated_at, created_at
+FROM alert_rules
+ORDER BY created_at;
+
+-- name: DeleteAlertRule :exec
+DELETE FROM alert_rules
+WHERE id = $1;
+
+-- name: DeleteAlertStatesByRule :exec
+DELETE FROM alert_states
+WHERE rule_id = $1;
+
+-- name: UpsertAlertState :exec
+INSERT INTO alert_states (
+    rule_id,
+    connection_id,
+    status,
+    consecutive_breaches,
+    value,
+    message,
+    fired_at,
+    resolved_at,
+    evaluated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+)
+ON CONFLICT (rule_id, connection_id) DO UPDATE
+SET status = excluded.status,
+    consecutive_breaches = excluded.consecutive_breaches,
+    value = excluded.value,
+    message = excluded.message,
+    fired_at = excluded.fired_at,
+    resolved_at = excluded.resolved_at,
+    evaluated_at = excluded.evaluated_at;
+
+-- name: ListAlertStates :many
+SELECT rule_id, connection_id, status, consecutive_breaches, value, message, fired_at, resolved_at, evaluated_at
+FROM alert_states
+ORDER BY rule_id, connection_id;
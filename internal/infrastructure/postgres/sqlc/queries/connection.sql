@@ -7,10 +7,17 @@ INSERT INTO connections (
     port,
     username,
     password,
+    environment,
+    tags,
+    color,
+    description,
+    protection,
+    read_only,
+    ssh_tunnel,
     updated_at,
     created_at
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
 )
 ON CONFLICT (id) DO UPDATE
 SET name = EXCLUDED.name,
@@ -18,15 +25,22 @@ SET name = EXCLUDED.name,
     host = EXCLUDED.host,
     port = EXCLUDED.port,
     username = EXCLUDED.username,
-    password = EXCLUDED.password;
+    password = EXCLUDED.password,
+    environment = EXCLUDED.environment,
+    tags = EXCLUDED.tags,
+    color = EXCLUDED.color,
+    description = EXCLUDED.description,
+    protection = EXCLUDED.protection,
+    read_only = EXCLUDED.read_only,
+    ssh_tunnel = EXCLUDED.ssh_tunnel;
 
 -- name: GetConnection :one
-SELECT id, name, driver, host, port, username, password, updated_at, created_at
+SELECT id, name, driver, host, port, username, password, updated_at, created_at, environment, tags, color, description, protection, read_only, ssh_tunnel
 FROM connections
 WHERE id = $1;
 
 -- name: ListConnections :many
-SELECT id, name, driver, host, port, username, password, updated_at, created_at
+SELECT id, name, driver, host, port, username, password, updated_at, created_at, environment, tags, color, description, protection, read_only, ssh_tunnel
 FROM connections
 ORDER BY created_at DESC
 LIMIT 100;
@@ -0,0 +1,27 @@
+-- name: UpsertConnectionHealth :exec
+INSERT INTO connection_health (
+    connection_id,
+    status,
+    error,
+    latency_ms,
+    checked_at,
+    last_success_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (connection_id) DO UPDATE
+SET status = excluded.status,
+    error = excluded.error,
+    latency_ms = excluded.latency_ms,
+    checked_at = excluded.checked_at,
+    last_success_at = excluded.last_success_at;
+
+-- name: GetConnectionHealth :one
+SELECT connection_id, status, error, latency_ms, checked_at, last_success_at
+FROM connection_health
+WHERE connection_id = $1;
+
+-- name: ListConnectionHealth :many
+SELECT connection_id, status, error, latency_ms, checked_at, last_success_at
+FROM connection_health
+ORDER BY connection_id;
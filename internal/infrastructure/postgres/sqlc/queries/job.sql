@@ -0,0 +1,53 @@
+-- name: UpsertJob :exec
+INSERT INTO jobs (
+    id,
+    connection_id,
+    kind,
+    database,
+    target,
+    status,
+    progress,
+    phase,
+    log,
+    error,
+    result,
+    created_at,
+    started_at,
+    finished_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+)
+ON CONFLICT (id) DO UPDATE
+SET status = excluded.status,
+    progress = excluded.progress,
+    phase = excluded.phase,
+    log = excluded.log,
+    error = excluded.error,
+    result = excluded.result,
+    started_at = excluded.started_at,
+    finished_at = excluded.finished_at;
+
+-- name: GetJob :one
+SELECT id, connection_id, kind, database, target, status, progress, phase, log, error, result, created_at, started_at, finished_at
+FROM jobs
+WHERE id = $1;
+
+-- name: ListJobs :many
+SELECT id, connection_id, kind, database, target, status, progress, phase, log, error, result, created_at, started_at, finished_at
+FROM jobs
+WHERE (sqlc.narg(connection_id)::uuid IS NULL OR connection_id = sqlc.narg(connection_id)::uuid)
+  AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status)::text)
+ORDER BY created_at DESC
+LIMIT sqlc.arg(row_limit);
+
+-- name: InterruptJobs :execrows
+UPDATE jobs
+SET status = 'failed',
+    error = sqlc.arg(reason),
+    finished_at = sqlc.arg(finished_at)
+WHERE status IN ('queued', 'running');
+
+-- name: DeleteJobsFinishedBefore :exec
+DELETE FROM jobs
+WHERE finished_at IS NOT NULL
+  AND finished_at < $1;
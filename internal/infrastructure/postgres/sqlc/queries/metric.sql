@@ -0,0 +1,47 @@
+-- name: UpsertMetricSample :exec
+INSERT INTO metric_samples (
+    connection_id,
+    resolution,
+    sampled_at,
+    total_sessions,
+    active_sessions,
+    max_connections,
+    tps,
+    cache_hit_ratio,
+    deadlocks,
+    temp_bytes,
+    database_sizes
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+)
+ON CONFLICT (connection_id, resolution, sampled_at) DO UPDATE
+SET total_sessions = EXCLUDED.total_sessions,
+    active_sessions = EXCLUDED.active_sessions,
+    max_connections = EXCLUDED.max_connections,
+    tps = EXCLUDED.tps,
+    cache_hit_ratio = EXCLUDED.cache_hit_ratio,
+    deadlocks = EXCLUDED.deadlocks,
+    temp_bytes = EXCLUDED.temp_bytes,
+    database_sizes = EXCLUDED.database_sizes;
+
+-- name: ListMetricSamples :many
+SELECT connection_id, resolution, sampled_at, total_sessions, active_sessions, max_connections, tps, cache_hit_ratio, deadlocks, temp_bytes, database_sizes
+FROM metric_samples
+WHERE connection_id = $1
+  AND resolution = $2
+  AND sampled_at >= sqlc.arg(sampled_from)
+  AND sampled_at < sqlc.arg(sampled_to)
+ORDER BY sampled_at;
+
+-- name: LatestMetricSampleTime :one
+SELECT sampled_at
+FROM metric_samples
+WHERE connection_id = $1
+  AND resolution = $2
+ORDER BY sampled_at DESC
+LIMIT 1;
+
+-- name: DeleteMetricSamplesBefore :exec
+DELETE FROM metric_samples
+WHERE resolution = $1
+  AND sampled_at < $2;
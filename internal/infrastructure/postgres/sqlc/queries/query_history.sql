@@ -0,0 +1,50 @@
+-- name: InsertHistoryEntry :exec
+INSERT INTO query_history (
+    id,
+    connection_id,
+    database,
+    source,
+    statement,
+    duration_ms,
+    rows_returned,
+    rows_affected,
+    error,
+    executed_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+);
+
+-- name: ListHistoryEntries :many
+SELECT id, connection_id, database, source, statement, duration_ms, rows_returned, rows_affected, error, executed_at
+FROM query_history
+WHERE (sqlc.narg(connection_id)::uuid IS NULL OR connection_id = sqlc.narg(connection_id)::uuid)
+  AND (sqlc.narg(database)::text IS NULL OR database = sqlc.narg(database)::text)
+  AND (sqlc.narg(source)::text IS NULL OR source = sqlc.narg(source)::text)
+  AND (sqlc.narg(search)::text IS NULL OR strpos(lower(statement), lower(sqlc.narg(search)::text)) > 0)
+  AND (sqlc.narg(since)::timestamptz IS NULL OR executed_at >= sqlc.narg(since)::timestamptz)
+  AND (sqlc.narg(until)::timestamptz IS NULL OR executed_at < sqlc.narg(until)::timestamptz)
+  AND (sqlc.arg(failed_only)::boolean = FALSE OR error <> '')
+ORDER BY executed_at DESC
+LIMIT sqlc.arg(row_limit);
+
+-- name: DeleteHistoryBefore :exec
+DELETE FROM query_history
+WHERE executed_at < $1;
+
+-- name: ListHistoryConnections :many
+SELECT DISTINCT connection_id
+FROM query_history;
+
+-- name: GetHistoryCutoff :one
+-- The executed_at of the oldest entry kept for the connection; no row when it has fewer
+-- entries than the limit.
+SELECT executed_at
+FROM query_history
+WHERE connection_id = sqlc.arg(connection_id)
+ORDER BY executed_at DESC
+LIMIT 1 OFFSET sqlc.arg(keep_offset);
+
+-- name: DeleteConnectionHistoryBefore :exec
+DELETE FROM query_history
+WHERE connection_id = sqlc.arg(connection_id)
+  AND executed_at < sqlc.arg(before);
@@ -0,0 +1,196 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: query_history.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deleteConnectionHistoryBefore = `-- name: DeleteConnectionHistoryBefore :exec
+DELETE FROM query_history
+WHERE connection_id = $1
+  AND executed_at < $2
+`
+
+type DeleteConnectionHistoryBeforeParams struct {
+	ConnectionID pgtype.UUID
+	Before       pgtype.Timestamptz
+}
+
+func (q *Queries) DeleteConnectionHistoryBefore(ctx context.Context, arg DeleteConnectionHistoryBeforeParams) error {
+	_, err := q.db.Exec(ctx, deleteConnectionHistoryBefore, arg.ConnectionID, arg.Before)
+	return err
+}
+
+const deleteHistoryBefore = `-- name: DeleteHistoryBefore :exec
+DELETE FROM query_history
+WHERE executed_at < $1
+`
+
+func (q *Queries) DeleteHistoryBefore(ctx context.Context, executedAt pgtype.Timestamptz) error {
+	_, err := q.db.Exec(ctx, deleteHistoryBefore, executedAt)
+	return err
+}
+
+const getHistoryCutoff = `-- name: GetHistoryCutoff :one
+SELECT executed_at
+FROM query_history
+WHERE connection_id = $1
+ORDER BY executed_at DESC
+LIMIT 1 OFFSET $2
+`
+
+type GetHistoryCutoffParams struct {
+	ConnectionID pgtype.UUID
+	KeepOffset   int32
+}
+
+// The executed_at of the oldest entry kept for the connection; no row when it has fewer
+// entries than the limit.
+func (q *Queries) GetHistoryCutoff(ctx context.Context, arg GetHistoryCutoffParams) (pgtype.Timestamptz, error) {
+	row := q.db.QueryRow(ctx, getHistoryCutoff, arg.ConnectionID, arg.KeepOffset)
+	var executed_at pgtype.Timestamptz
+	err := row.Scan(&executed_at)
+	return executed_at, err
+}
+
+const insertHistoryEntry = `-- name: InsertHistoryEntry :exec
+INSERT INTO query_history (
+    id,
+    connection_id,
+    database,
+    source,
+    statement,
+    duration_ms,
+    rows_returned,
+    rows_affected,
+    error,
+    executed_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+)
+`
+
+type InsertHistoryEntryParams struct {
+	ID           pgtype.UUID
+	ConnectionID pgtype.UUID
+	Database     string
+	Source       string
+	Statement    string
+	DurationMs   float64
+	RowsReturned pgtype.Int8
+	RowsAffected pgtype.Int8
+	Error        string
+	ExecutedAt   pgtype.Timestamptz
+}
+
+func (q *Queries) InsertHistoryEntry(ctx context.Context, arg InsertHistoryEntryParams) error {
+	_, err := q.db.Exec(ctx, insertHistoryEntry,
+		arg.ID,
+		arg.ConnectionID,
+		arg.Database,
+		arg.Source,
+		arg.Statement,
+		arg.DurationMs,
+		arg.RowsReturned,
+		arg.RowsAffected,
+		arg.Error,
+		arg.ExecutedAt,
+	)
+	return err
+}
+
+const listHistoryConnections = `-- name: ListHistoryConnections :many
+SELECT DISTINCT connection_id
+FROM query_history
+`
+
+func (q *Queries) ListHistoryConnections(ctx context.Context) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, listHistoryConnections)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []pgtype.UUID{}
+	for rows.Next() {
+		var connection_id pgtype.UUID
+		if err := rows.Scan(&connection_id); err != nil {
+			return nil, err
+		}
+		items = append(items, connection_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listHistoryEntries = `-- name: ListHistoryEntries :many
+SELECT id, connection_id, database, source, statement, duration_ms, rows_returned, rows_affected, error, executed_at
+FROM query_history
+WHERE ($1::uuid IS NULL OR connection_id = $1::uuid)
+  AND ($2::text IS NULL OR database = $2::text)
+  AND ($3::text IS NULL OR source = $3::text)
+  AND ($4::text IS NULL OR strpos(lower(statement), lower($4::text)) > 0)
+  AND ($5::timestamptz IS NULL OR executed_at >= $5::timestamptz)
+  AND ($6::timestamptz IS NULL OR executed_at < $6::timestamptz)
+  AND ($7::boolean = FALSE OR error <> '')
+ORDER BY executed_at DESC
+LIMIT $8
+`
+
+type ListHistoryEntriesParams struct {
+	ConnectionID pgtype.UUID
+	Database     pgtype.Text
+	Source       pgtype.Text
+	Search       pgtype.Text
+	Since        pgtype.Timestamptz
+	Until        pgtype.Timestamptz
+	FailedOnly   bool
+	RowLimit     int32
+}
+
+func (q *Queries) ListHistoryEntries(ctx context.Context, arg ListHistoryEntriesParams) ([]QueryHistory, error) {
+	rows, err := q.db.Query(ctx, listHistoryEntries,
+		arg.ConnectionID,
+		arg.Database,
+		arg.Source,
+		arg.Search,
+		arg.Since,
+		arg.Until,
+		arg.FailedOnly,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []QueryHistory{}
+	for rows.Next() {
+		var i QueryHistory
+		if err := rows.Scan(
+			&i.ID,
+			&i.ConnectionID,
+			&i.Database,
+			&i.Source,
+			&i.Statement,
+			&i.DurationMs,
+			&i.RowsReturned,
+			&i.RowsAffected,
+			&i.Error,
+			&i.ExecutedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// statementOrderColumns maps the ranking criteria to pg_stat_statements columns (PostgreSQL 13+).
+var statementOrderColumns = map[connection.StatementOrder]string{
+	connection.StatementOrderTotalTime:      "s.total_exec_time",
+	connection.StatementOrderMeanTime:       "s.mean_exec_time",
+	connection.StatementOrderCalls:          "s.calls",
+	connection.StatementOrderRows:           "s.rows",
+	connection.StatementOrderSharedBlksRead: "s.shared_blks_read",
+}
+
+func (h *Gateway) GetTopStatements(ctx context.Context, conn connection.Connection, password string, orderBy connection.StatementOrder, limit int) ([]connection.StatementStats, error) {
+	db, err := h.connect(conn, password, postgresDBName())
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	if err := requireServerVersion(ctx, db, 130000, "pg_stat_statements timings (total_exec_time)"); err != nil {
+		return nil, err
+	}
+	if err := requireStatStatements(ctx, db); err != nil {
+		return nil, err
+	}
+
+	column, ok := statementOrderColumns[orderBy]
+	if !ok {
+		return nil, connection.ErrInvalidStatementOrder
+	}
+
+	query := fmt.Sprintf(`
+SELECT
+    COALESCE(s.queryid, 0),
+    s.query,
+    COALESCE(r.rolname, ''),
+    COALESCE(d.datname, ''),
+    s.calls,
+    s.total_exec_time,
+    s.mean_exec_time,
+    s.min_exec_time,
+    s.max_exec_time,
+    s.stddev_exec_time,
+    s.rows,
+    s.shared_blks_hit,
+    s.shared_blks_read,
+    s.temp_blks_written
+FROM pg_stat_statements s
+LEFT JOIN pg_roles r ON r.oid = s.userid
+LEFT JOIN pg_database d ON d.oid = s.dbid
+ORDER BY %s DESC
+LIMIT $1;
+`, column)
+
+	rows, err := db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, statStatementsError(err)
+	}
+	defer rows.Close()
+
+	statements := make([]connection.StatementStats, 0)
+	for rows.Next() {
+		var st connection.StatementStats
+		if err := rows.Scan(
+			&st.QueryID,
+			&st.Query,
+			&st.User,
+			&st.Database,
+			&st.Calls,
+			&st.TotalTime,
+			&st.MeanTime,
+			&st.MinTime,
+			&st.MaxTime,
+			&st.StddevTime,
+			&st.Rows,
+			&st.SharedBlksHit,
+			&st.SharedBlksRead,
+			&st.TempBlksWritten,
+		); err != nil {
+			return nil, fmt.Errorf("%w: scanning statement: %v", connection.ErrQueryFailed, err)
+		}
+		statements = append(statements, st)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: iterating statements: %v", connection.ErrQueryFailed, err)
+	}
+
+	return statements, nil
+}
+
+func (h *Gateway) ResetStatementStats(ctx context.Context, conn connection.Connection, password string) error {
+	db, err := h.connect(conn, password, postgresDBName())
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if err := requireStatStatements(ctx, db); err != nil {
+		return err
+	}
+
+	if _, err := db.ExecContext(ctx, "SELECT pg_stat_statements_reset()"); err != nil {
+		return statStatementsError(err)
+	}
+
+	return nil
+}
+
+func requireStatStatements(ctx context.Context, db *sql.DB) error {
+	var installed bool
+	query := "SELECT EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'pg_stat_statements')"
+	if err := db.QueryRowContext(ctx, query).Scan(&installed); err != nil {
+		return fmt.Errorf("%w: checking pg_stat_statements: %v", connection.ErrQueryFailed, err)
+	}
+	if !installed {
+		return fmt.Errorf("%w: pg_stat_statements (run CREATE EXTENSION pg_stat_statements in the postgres database)", connection.ErrExtensionNotInstalled)
+	}
+	return nil
+}
+
+// statStatementsError reports a missing shared_preload_libraries entry as a capability problem.
+func statStatementsError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "55000" {
+		return fmt.Errorf("%w: pg_stat_statements must be loaded via shared_preload_libraries", connection.ErrExtensionNotInstalled)
+	}
+	return fmt.Errorf("%w: %v", connection.ErrQueryFailed, err)
+}
@@ -0,0 +1,75 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+)
+
+func (h *Gateway) GetServerStats(ctx context.Context, conn connection.Connection, password string) (*connection.ServerStats, error) {
+	db, err := h.connect(conn, password, postgresDBName())
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	query := `
+SELECT
+    (SELECT count(*) FROM pg_stat_activity WHERE backend_type = 'client backend')::int,
+    (SELECT count(*) FROM pg_stat_activity WHERE backend_type = 'client backend' AND state = 'active')::int,
+    current_setting('max_connections')::int,
+    COALESCE(sum(xact_commit + xact_rollback), 0)::bigint,
+    COALESCE(sum(blks_hit), 0)::bigint,
+    COALESCE(sum(blks_read), 0)::bigint,
+    COALESCE(sum(deadlocks), 0)::bigint,
+    COALESCE(sum(temp_bytes), 0)::bigint
+FROM pg_stat_database;
+`
+
+	stats := connection.ServerStats{
+		CollectedAt:   time.Now(),
+		DatabaseSizes: make(map[string]int64),
+	}
+	if err := db.QueryRowContext(ctx, query).Scan(
+		&stats.TotalSessions,
+		&stats.ActiveSessions,
+		&stats.MaxConnections,
+		&stats.Transactions,
+		&stats.BlocksHit,
+		&stats.BlocksRead,
+		&stats.Deadlocks,
+		&stats.TempBytes,
+	); err != nil {
+		return nil, fmt.Errorf("%w: reading server stats: %v", connection.ErrQueryFailed, err)
+	}
+
+	sizes := `
+SELECT datname, pg_database_size(oid)
+FROM pg_database
+WHERE NOT datistemplate
+  AND has_database_privilege(oid, 'CONNECT');
+`
+
+	rows, err := db.QueryContext(ctx, sizes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: reading database sizes: %v", connection.ErrQueryFailed, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var name string
+		var size int64
+		if err := rows.Scan(&name, &size); err != nil {
+			return nil, fmt.Errorf("%w: scanning database size: %v", connection.ErrQueryFailed, err)
+		}
+		stats.DatabaseSizes[name] = size
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: iterating database sizes: %v", connection.ErrQueryFailed, err)
+	}
+
+	return &stats, nil
+}
@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+)
+
+func (h *Gateway) GetTableHealth(ctx context.Context, conn connection.Connection, password string, dbName, schema, tableName connection.Identifier) (*connection.TableHealth, error) {
+	db, err := h.connect(conn, password, dbName)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	query := `
+SELECT
+    s.schemaname,
+    s.relname,
+    s.n_live_tup,
+    s.n_dead_tup,
+    s.n_mod_since_analyze,
+    s.seq_scan,
+    s.seq_tup_read,
+    COALESCE(s.idx_scan, 0),
+    COALESCE(s.idx_tup_fetch, 0),
+    s.n_tup_ins,
+    s.n_tup_upd,
+    s.n_tup_hot_upd,
+    s.n_tup_del,
+    s.last_vacuum,
+    s.last_autovacuum,
+    s.last_analyze,
+    s.last_autoanalyze,
+    s.vacuum_count,
+    s.autovacuum_count,
+    s.analyze_count,
+    s.autoanalyze_count,
+    pg_relation_size(s.relid),
+    COALESCE(pg_total_relation_size(NULLIF(c.reltoastrelid, 0)), 0),
+    pg_indexes_size(s.relid),
+    pg_total_relation_size(s.relid),
+    COALESCE(io.heap_blks_read, 0),
+    COALESCE(io.heap_blks_hit, 0),
+    COALESCE(io.idx_blks_read, 0),
+    COALESCE(io.idx_blks_hit, 0),
+    COALESCE(io.toast_blks_read, 0),
+    COALESCE(io.toast_blks_hit, 0)
+FROM pg_stat_user_tables s
+JOIN pg_class c ON c.oid = s.relid
+LEFT JOIN pg_statio_user_tables io ON io.relid = s.relid
+WHERE s.schemaname = $1 AND s.relname = $2;
+`
+
+	var health connection.TableHealth
+	var lastVacuum, lastAutovacuum, lastAnalyze, lastAutoanalyze sql.NullTime
+	err = db.QueryRowContext(ctx, query, schema.String(), tableName.String()).Scan(
+		&health.Schema,
+		&health.Name,
+		&health.LiveTuples,
+		&health.DeadTuples,
+		&health.ModsSinceAnalyze,
+		&health.SeqScans,
+		&health.SeqTuplesRead,
+		&health.IndexScans,
+		&health.IndexTuplesFetched,
+		&health.Inserts,
+		&health.Updates,
+		&health.HotUpdates,
+		&health.Deletes,
+		&lastVacuum,
+		&lastAutovacuum,
+		&lastAnalyze,
+		&lastAutoanalyze,
+		&health.VacuumCount,
+		&health.AutovacuumCount,
+		&health.AnalyzeCount,
+		&health.AutoanalyzeCount,
+		&health.HeapSize,
+		&health.ToastSize,
+		&health.IndexSize,
+		&health.TotalSize,
+		&health.HeapBlocksRead,
+		&health.HeapBlocksHit,
+		&health.IndexBlocksRead,
+		&health.IndexBlocksHit,
+		&health.ToastBlocksRead,
+		&health.ToastBlocksHit,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: table %s.%s", connection.ErrResourceNotFound, schema, tableName)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: reading table health: %v", connection.ErrQueryFailed, err)
+	}
+
+	health.LastVacuum = nullTime(lastVacuum)
+	health.LastAutovacuum = nullTime(lastAutovacuum)
+	health.LastAnalyze = nullTime(lastAnalyze)
+	health.LastAutoanalyze = nullTime(lastAutoanalyze)
+
+	return &health, nil
+}
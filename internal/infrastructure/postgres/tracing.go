@@ -0,0 +1,202 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// maxTracedStatement limita o tamanho do SQL gravado no span.
+const maxTracedStatement = 2048
+
+var tracer = otel.Tracer("github.com/felipemalacarne/mesa/internal/infrastructure/postgres")
+
+// queryTracer abre um span por comando enviado ao servidor. O SQL é gravado sem literais,
+// já que alguns comandos (CREATE ROLE ... PASSWORD, UPDATE de linhas) os embutem no texto.
+// Quando o contexto carrega um connection.StatementListener, o comando também é repassado a ele,
+// com e sem literais.
+type queryTracer struct{}
+
+// tracedStatement guarda no contexto o comando em andamento até TraceQueryEnd.
+type tracedStatement struct {
+	sql       string
+	redacted  string
+	startedAt time.Time
+	listener  connection.StatementListener
+}
+
+type tracedStatementKey struct{}
+
+func (queryTracer) TraceQueryStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	redacted := redactSQL(data.SQL)
+	statement := truncateStatement(redacted, maxTracedStatement)
+	operation := statementOperation(statement)
+
+	if listener := connection.StatementListenerFrom(ctx); listener != nil && !transactionControl[operation] {
+		ctx = context.WithValue(ctx, tracedStatementKey{}, tracedStatement{
+			sql:       data.SQL,
+			redacted:  redacted,
+			startedAt: time.Now(),
+			listener:  listener,
+		})
+	}
+
+	cfg := conn.Config()
+	ctx, _ = tracer.Start(ctx, "postgres "+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system.name", "postgresql"),
+			attribute.String("db.namespace", cfg.Database),
+			attribute.String("db.operation.name", operation),
+			attribute.String("db.query.text", statement),
+			attribute.String("server.address", cfg.Host),
+			attribute.Int("server.port", int(cfg.Port)),
+		),
+	)
+	return ctx
+}
+
+func (queryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	span := trace.SpanFromContext(ctx)
+	if data.Err != nil {
+		span.RecordError(data.Err)
+		span.SetStatus(codes.Error, data.Err.Error())
+	} else {
+		span.SetAttributes(attribute.Int64("db.response.returned_rows", data.CommandTag.RowsAffected()))
+	}
+	span.End()
+
+	if traced, ok := ctx.Value(tracedStatementKey{}).(tracedStatement); ok {
+		executed := connection.ExecutedStatement{
+			SQL:         traced.sql,
+			RedactedSQL: traced.redacted,
+			StartedAt:   traced.startedAt,
+			Duration:    time.Since(traced.startedAt),
+			Err:         data.Err,
+		}
+		if data.Err == nil {
+			rows := data.CommandTag.RowsAffected()
+			if data.CommandTag.Select() {
+				executed.RowsReturned = &rows
+			} else {
+				executed.RowsAffected = &rows
+			}
+		}
+		traced.listener(executed)
+	}
+}
+
+// transactionControl são os comandos que não são repassados ao StatementListener.
+var transactionControl = map[string]bool{
+	"BEGIN":    true,
+	"COMMIT":   true,
+	"ROLLBACK": true,
+}
+
+// redactSQL troca literais de texto (inclusive E'...' com escapes), dollar-quoted e numéricos
+// por "?", remove comentários e compacta os espaços. Identificadores entre aspas duplas e
+// parâmetros posicionais ($1) são preservados. A varredura é a mesma das consultas salvas.
+func redactSQL(statement string) string {
+	var b strings.Builder
+	space := false
+
+	for i := 0; i < len(statement); i++ {
+		c := statement[i]
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f':
+			space = b.Len() > 0
+			continue
+		case c == '-' && i+1 < len(statement) && statement[i+1] == '-':
+			for i < len(statement) && statement[i] != '\n' {
+				i++
+			}
+			space = b.Len() > 0
+			continue
+		case c == '/' && i+1 < len(statement) && statement[i+1] == '*':
+			i = connection.SkipBlockComment(statement, i)
+			space = b.Len() > 0
+			continue
+		}
+		if space {
+			b.WriteByte(' ')
+			space = false
+		}
+
+		switch {
+		case c == '\'':
+			i = connection.SkipQuoted(statement, i, '\'', connection.OpensEscapeString(statement, i))
+			b.WriteString("'?'")
+
+		case c == '"':
+			end := min(connection.SkipQuoted(statement, i, '"', false), len(statement)-1)
+			b.WriteString(statement[i : end+1])
+			i = end
+
+		case c == '$' && i+1 < len(statement) && isDigit(statement[i+1]):
+			end := i + 1
+			for end < len(statement) && isDigit(statement[end]) {
+				end++
+			}
+			b.WriteString(statement[i:end])
+			i = end - 1
+
+		case c == '$':
+			end := connection.SkipDollarQuoted(statement, i)
+			if end == i {
+				b.WriteByte(c)
+				continue
+			}
+			i = end
+			b.WriteString("'?'")
+
+		case isDigit(c) && (i == 0 || !connection.IsIdentChar(statement[i-1])):
+			for i++; i < len(statement) && (isDigit(statement[i]) || statement[i] == '.'); i++ {
+			}
+			i--
+			b.WriteByte('?')
+
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return b.String()
+}
+
+// truncateStatement corta o texto em até limit bytes sem partir um caractere multibyte.
+func truncateStatement(statement string, limit int) string {
+	if len(statement) <= limit {
+		return statement
+	}
+	end := limit
+	for end > 0 && !utf8.RuneStart(statement[end]) {
+		end--
+	}
+	return statement[:end] + "…"
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// statementOperation devolve a primeira palavra do comando, ex: SELECT, ALTER.
+func statementOperation(statement string) string {
+	statement = strings.TrimLeft(statement, "( ")
+	if idx := strings.IndexFunc(statement, func(r rune) bool { return !unicode.IsLetter(r) }); idx >= 0 {
+		statement = statement[:idx]
+	}
+	if statement == "" {
+		return "QUERY"
+	}
+	return strings.ToUpper(statement)
+}
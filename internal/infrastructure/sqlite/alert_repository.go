@@ -0,0 +1,164 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/domain/alert"
+	"github.com/felipemalacarne/mesa/internal/infrastructure/sqlite/sqlc"
+	"github.com/google/uuid"
+)
+
+type AlertRepository struct {
+	queries *sqlc.Queries
+}
+
+func NewAlertRepository(db *sql.DB) *AlertRepository {
+	return &AlertRepository{
+		queries: sqlc.New(db),
+	}
+}
+
+func (r *AlertRepository) SaveRule(ctx context.Context, rule *alert.Rule) error {
+	var connectionID uuid.NullUUID
+	if rule.ConnectionID != nil {
+		connectionID = uuid.NullUUID{UUID: *rule.ConnectionID, Valid: true}
+	}
+
+	return r.queries.UpsertAlertRule(ctx, sqlc.UpsertAlertRuleParams{
+		ID:                rule.ID,
+		Name:              rule.Name,
+		ConnectionID:      connectionID,
+		Condition:         string(rule.Condition),
+		Threshold:         rule.Threshold,
+		ConsecutiveChecks: int64(rule.ConsecutiveChecks),
+		Channel:           string(rule.Channel),
+		Target:            rule.Target,
+		Enabled:           rule.Enabled,
+		UpdatedAt:         rule.UpdatedAt,
+		CreatedAt:         rule.CreatedAt,
+	})
+}
+
+func (r *AlertRepository) FindRule(ctx context.Context, id uuid.UUID) (*alert.Rule, error) {
+	record, err := r.queries.GetAlertRule(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainAlertRule(record)
+}
+
+func (r *AlertRepository) ListRules(ctx context.Context) ([]*alert.Rule, error) {
+	rows, err := r.queries.ListAlertRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]*alert.Rule, 0, len(rows))
+	for _, record := range rows {
+		rule, err := toDomainAlertRule(record)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+func (r *AlertRepository) DeleteRule(ctx context.Context, id uuid.UUID) error {
+	// SQLite does not enforce the ON DELETE CASCADE unless foreign keys are enabled.
+	if err := r.queries.DeleteAlertStatesByRule(ctx, id); err != nil {
+		return err
+	}
+	return r.queries.DeleteAlertRule(ctx, id)
+}
+
+func (r *AlertRepository) SaveState(ctx context.Context, state alert.State) error {
+	return r.queries.UpsertAlertState(ctx, sqlc.UpsertAlertStateParams{
+		RuleID:              state.RuleID,
+		ConnectionID:        state.ConnectionID,
+		Status:              string(state.Status),
+		ConsecutiveBreaches: int64(state.ConsecutiveBreaches),
+		Value:               state.Value,
+		Message:             state.Message,
+		FiredAt:             nullTimeFrom(state.FiredAt),
+		ResolvedAt:          nullTimeFrom(state.ResolvedAt),
+		EvaluatedAt:         state.EvaluatedAt,
+	})
+}
+
+func (r *AlertRepository) ListStates(ctx context.Context) ([]alert.State, error) {
+	rows, err := r.queries.ListAlertStates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]alert.State, 0, len(rows))
+	for _, record := range rows {
+		states = append(states, alert.State{
+			RuleID:              record.RuleID,
+			ConnectionID:        record.ConnectionID,
+			Status:              alert.Status(record.Status),
+			ConsecutiveBreaches: int(record.ConsecutiveBreaches),
+			Value:               record.Value,
+			Message:             record.Message,
+			FiredAt:             timePtrFrom(record.FiredAt),
+			ResolvedAt:          timePtrFrom(record.ResolvedAt),
+			EvaluatedAt:         record.EvaluatedAt,
+		})
+	}
+
+	return states, nil
+}
+
+func toDomainAlertRule(record sqlc.AlertRule) (*alert.Rule, error) {
+	condition, err := alert.NewCondition(record.Condition)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, err := alert.NewChannel(record.Channel)
+	if err != nil {
+		return nil, err
+	}
+
+	var connectionID *uuid.UUID
+	if record.ConnectionID.Valid {
+		id := record.ConnectionID.UUID
+		connectionID = &id
+	}
+
+	return &alert.Rule{
+		ID:                record.ID,
+		Name:              record.Name,
+		ConnectionID:      connectionID,
+		Condition:         condition,
+		Threshold:         record.Threshold,
+		ConsecutiveChecks: int(record.ConsecutiveChecks),
+		Channel:           channel,
+		Target:            record.Target,
+		Enabled:           record.Enabled,
+		UpdatedAt:         record.UpdatedAt,
+		CreatedAt:         record.CreatedAt,
+	}, nil
+}
+
+func nullTimeFrom(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
+
+func timePtrFrom(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
@@ -3,6 +3,7 @@ package sqlite
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/felipemalacarne/mesa/internal/domain/connection"
@@ -10,6 +11,18 @@ import (
 	"github.com/google/uuid"
 )
 
+// sshTunnel is how the tunnel is stored in the ssh_tunnel JSON column; the secrets stay
+// encrypted.
+type sshTunnel struct {
+	Host       string `json:"host"`
+	Port       int    `json:"port"`
+	Username   string `json:"username"`
+	Auth       string `json:"auth"`
+	Secret     string `json:"secret"`
+	Passphrase string `json:"passphrase,omitempty"`
+	KnownHosts string `json:"known_hosts"`
+}
+
 type ConnectionRepository struct {
 	queries *sqlc.Queries
 }
@@ -21,16 +34,45 @@ func NewConnectionRepository(db *sql.DB) *ConnectionRepository {
 }
 
 func (r *ConnectionRepository) Save(ctx context.Context, conn *connection.Connection) error {
+	tags, err := json.Marshal(conn.Tags)
+	if err != nil {
+		return err
+	}
+
+	var tunnel sql.NullString
+	if t := conn.Tunnel; t != nil {
+		encoded, err := json.Marshal(sshTunnel{
+			Host:       t.Host,
+			Port:       t.Port,
+			Username:   t.Username,
+			Auth:       string(t.Auth),
+			Secret:     t.Secret,
+			Passphrase: t.Passphrase,
+			KnownHosts: t.KnownHosts,
+		})
+		if err != nil {
+			return err
+		}
+		tunnel = sql.NullString{String: string(encoded), Valid: true}
+	}
+
 	return r.queries.UpsertConnection(ctx, sqlc.UpsertConnectionParams{
-		ID:        conn.ID,
-		Name:      conn.Name,
-		Driver:    string(conn.Driver),
-		Host:      conn.Host,
-		Port:      int64(conn.Port),
-		Username:  conn.Username,
-		Password:  conn.Password,
-		UpdatedAt: sql.NullTime{Time: conn.UpdatedAt, Valid: !conn.UpdatedAt.IsZero()},
-		CreatedAt: sql.NullTime{Time: conn.CreatedAt, Valid: !conn.CreatedAt.IsZero()},
+		ID:          conn.ID,
+		Name:        conn.Name,
+		Driver:      string(conn.Driver),
+		Host:        conn.Host,
+		Port:        int64(conn.Port),
+		Username:    conn.Username,
+		Password:    conn.Password,
+		Environment: string(conn.Environment),
+		Tags:        string(tags),
+		Color:       conn.Color,
+		Description: conn.Description,
+		Protection:  string(conn.Protection),
+		ReadOnly:    conn.ReadOnly,
+		SshTunnel:   tunnel,
+		UpdatedAt:   sql.NullTime{Time: conn.UpdatedAt, Valid: !conn.UpdatedAt.IsZero()},
+		CreatedAt:   sql.NullTime{Time: conn.CreatedAt, Valid: !conn.CreatedAt.IsZero()},
 	})
 }
 
@@ -70,6 +112,38 @@ func toDomainConnection(record sqlc.Connection) (*connection.Connection, error)
 		return nil, err
 	}
 
+	environment, err := connection.NewEnvironment(record.Environment)
+	if err != nil {
+		return nil, err
+	}
+
+	protection, err := connection.NewProtection(record.Protection)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := []string{}
+	if err := json.Unmarshal([]byte(record.Tags), &tags); err != nil {
+		return nil, err
+	}
+
+	var tunnel *connection.SSHTunnel
+	if record.SshTunnel.Valid {
+		var stored sshTunnel
+		if err := json.Unmarshal([]byte(record.SshTunnel.String), &stored); err != nil {
+			return nil, err
+		}
+		tunnel = &connection.SSHTunnel{
+			Host:       stored.Host,
+			Port:       stored.Port,
+			Username:   stored.Username,
+			Auth:       connection.TunnelAuth(stored.Auth),
+			Secret:     stored.Secret,
+			Passphrase: stored.Passphrase,
+			KnownHosts: stored.KnownHosts,
+		}
+	}
+
 	var createdAt, updatedAt time.Time
 	if record.CreatedAt.Valid {
 		createdAt = record.CreatedAt.Time
@@ -79,14 +153,21 @@ func toDomainConnection(record sqlc.Connection) (*connection.Connection, error)
 	}
 
 	return &connection.Connection{
-		ID:        record.ID,
-		Name:      record.Name,
-		Driver:    *parsedDriver,
-		Host:      record.Host,
-		Port:      int(record.Port),
-		Username:  record.Username,
-		Password:  record.Password,
-		UpdatedAt: updatedAt,
-		CreatedAt: createdAt,
+		ID:          record.ID,
+		Name:        record.Name,
+		Driver:      *parsedDriver,
+		Host:        record.Host,
+		Port:        int(record.Port),
+		Username:    record.Username,
+		Password:    record.Password,
+		Environment: environment,
+		Tags:        tags,
+		Color:       record.Color,
+		Description: record.Description,
+		Protection:  protection,
+		ReadOnly:    record.ReadOnly,
+		Tunnel:      tunnel,
+		UpdatedAt:   updatedAt,
+		CreatedAt:   createdAt,
 	}, nil
 }
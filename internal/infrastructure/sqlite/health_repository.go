@@ -0,0 +1,71 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/infrastructure/sqlite/sqlc"
+	"github.com/google/uuid"
+)
+
+type HealthRepository struct {
+	queries *sqlc.Queries
+}
+
+func NewHealthRepository(db *sql.DB) *HealthRepository {
+	return &HealthRepository{
+		queries: sqlc.New(db),
+	}
+}
+
+func (r *HealthRepository) SaveHealth(ctx context.Context, check connection.HealthCheck) error {
+	return r.queries.UpsertConnectionHealth(ctx, sqlc.UpsertConnectionHealthParams{
+		ConnectionID:  check.ConnectionID,
+		Status:        string(check.Status),
+		Error:         check.Error,
+		LatencyMs:     check.Latency.Milliseconds(),
+		CheckedAt:     check.CheckedAt,
+		LastSuccessAt: nullTimeFrom(check.LastSuccessAt),
+	})
+}
+
+func (r *HealthRepository) FindHealth(ctx context.Context, connectionID uuid.UUID) (*connection.HealthCheck, error) {
+	record, err := r.queries.GetConnectionHealth(ctx, connectionID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	check := toDomainHealthCheck(record)
+	return &check, nil
+}
+
+func (r *HealthRepository) ListHealth(ctx context.Context) ([]connection.HealthCheck, error) {
+	rows, err := r.queries.ListConnectionHealth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	checks := make([]connection.HealthCheck, 0, len(rows))
+	for _, record := range rows {
+		checks = append(checks, toDomainHealthCheck(record))
+	}
+
+	return checks, nil
+}
+
+func toDomainHealthCheck(record sqlc.ConnectionHealth) connection.HealthCheck {
+	return connection.HealthCheck{
+		ConnectionID:  record.ConnectionID,
+		Status:        connection.HealthStatus(record.Status),
+		Error:         record.Error,
+		Latency:       time.Duration(record.LatencyMs) * time.Millisecond,
+		CheckedAt:     record.CheckedAt,
+		LastSuccessAt: timePtrFrom(record.LastSuccessAt),
+	}
+}
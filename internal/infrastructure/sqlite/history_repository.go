@@ -0,0 +1,168 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/domain/history"
+	"github.com/felipemalacarne/mesa/internal/infrastructure/sqlite/sqlc"
+)
+
+// defaultHistoryListLimit applies when the filter does not bound the listing.
+const defaultHistoryListLimit = 100
+
+type HistoryRepository struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+}
+
+func NewHistoryRepository(db *sql.DB) *HistoryRepository {
+	return &HistoryRepository{
+		db:      db,
+		queries: sqlc.New(db),
+	}
+}
+
+// SaveEntries inserts the entries in a single transaction.
+func (r *HistoryRepository) SaveEntries(ctx context.Context, entries []history.Entry) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	queries := r.queries.WithTx(tx)
+	for _, e := range entries {
+		err := queries.InsertHistoryEntry(ctx, sqlc.InsertHistoryEntryParams{
+			ID:           e.ID,
+			ConnectionID: e.ConnectionID,
+			Database:     e.Database,
+			Source:       string(e.Source),
+			Statement:    e.Statement,
+			DurationMs:   float64(e.Duration.Microseconds()) / 1000,
+			RowsReturned: nullInt64From(e.RowsReturned),
+			RowsAffected: nullInt64From(e.RowsAffected),
+			Error:        e.Error,
+			ExecutedAt:   e.ExecutedAt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *HistoryRepository) ListEntries(ctx context.Context, filter history.Filter) ([]history.Entry, error) {
+	params := sqlc.ListHistoryEntriesParams{
+		FailedOnly: filter.FailedOnly,
+		RowLimit:   defaultHistoryListLimit,
+	}
+	if filter.ConnectionID != nil {
+		params.ConnectionID = *filter.ConnectionID
+	}
+	if filter.Database != "" {
+		params.Database = filter.Database
+	}
+	if filter.Source != nil {
+		params.Source = string(*filter.Source)
+	}
+	if filter.Search != "" {
+		params.Search = filter.Search
+	}
+	if filter.Since != nil {
+		params.Since = filter.Since.UTC()
+	}
+	if filter.Until != nil {
+		params.Until = filter.Until.UTC()
+	}
+	if filter.Limit > 0 {
+		params.RowLimit = int64(filter.Limit)
+	}
+
+	rows, err := r.queries.ListHistoryEntries(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]history.Entry, 0, len(rows))
+	for _, record := range rows {
+		e, err := toDomainHistoryEntry(record)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+
+	return entries, nil
+}
+
+func (r *HistoryRepository) DeleteEntriesBefore(ctx context.Context, before time.Time) error {
+	return r.queries.DeleteHistoryBefore(ctx, before.UTC())
+}
+
+func (r *HistoryRepository) TrimEntries(ctx context.Context, keep int) error {
+	connections, err := r.queries.ListHistoryConnections(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, connectionID := range connections {
+		cutoff, err := r.queries.GetHistoryCutoff(ctx, sqlc.GetHistoryCutoffParams{
+			ConnectionID: connectionID,
+			KeepOffset:   int64(keep - 1),
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		err = r.queries.DeleteConnectionHistoryBefore(ctx, sqlc.DeleteConnectionHistoryBeforeParams{
+			ConnectionID: connectionID,
+			Before:       cutoff,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func toDomainHistoryEntry(record sqlc.QueryHistory) (history.Entry, error) {
+	source, err := history.NewSource(record.Source)
+	if err != nil {
+		return history.Entry{}, err
+	}
+
+	return history.Entry{
+		ID:           record.ID,
+		ConnectionID: record.ConnectionID,
+		Database:     record.Database,
+		Source:       source,
+		Statement:    record.Statement,
+		Duration:     time.Duration(record.DurationMs * float64(time.Millisecond)),
+		RowsReturned: int64PtrFrom(record.RowsReturned),
+		RowsAffected: int64PtrFrom(record.RowsAffected),
+		Error:        record.Error,
+		ExecutedAt:   record.ExecutedAt,
+	}, nil
+}
+
+func nullInt64From(n *int64) sql.NullInt64 {
+	if n == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: *n, Valid: true}
+}
+
+func int64PtrFrom(value sql.NullInt64) *int64 {
+	if !value.Valid {
+		return nil
+	}
+	return &value.Int64
+}
@@ -0,0 +1,145 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/domain/job"
+	"github.com/felipemalacarne/mesa/internal/infrastructure/sqlite/sqlc"
+	"github.com/google/uuid"
+)
+
+// defaultJobListLimit applies when the filter does not bound the listing.
+const defaultJobListLimit = 100
+
+type JobRepository struct {
+	queries *sqlc.Queries
+}
+
+func NewJobRepository(db *sql.DB) *JobRepository {
+	return &JobRepository{
+		queries: sqlc.New(db),
+	}
+}
+
+func (r *JobRepository) SaveJob(ctx context.Context, j job.Job) error {
+	log, err := json.Marshal(j.Log)
+	if err != nil {
+		return err
+	}
+
+	var progress sql.NullFloat64
+	if j.Progress != nil {
+		progress = sql.NullFloat64{Float64: *j.Progress, Valid: true}
+	}
+
+	return r.queries.UpsertJob(ctx, sqlc.UpsertJobParams{
+		ID:           j.ID,
+		ConnectionID: j.ConnectionID,
+		Kind:         j.Kind,
+		Database:     j.Database,
+		Target:       j.Target,
+		Status:       string(j.Status),
+		Progress:     progress,
+		Phase:        j.Phase,
+		Log:          string(log),
+		Error:        j.Error,
+		Result:       j.Result,
+		CreatedAt:    j.CreatedAt,
+		StartedAt:    nullTimeFrom(j.StartedAt),
+		FinishedAt:   nullTimeFrom(j.FinishedAt),
+	})
+}
+
+func (r *JobRepository) FindJob(ctx context.Context, id uuid.UUID) (*job.Job, error) {
+	record, err := r.queries.GetJob(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	j, err := toDomainJob(record)
+	if err != nil {
+		return nil, err
+	}
+	return &j, nil
+}
+
+func (r *JobRepository) ListJobs(ctx context.Context, filter job.Filter) ([]job.Job, error) {
+	params := sqlc.ListJobsParams{RowLimit: defaultJobListLimit}
+	if filter.ConnectionID != nil {
+		params.ConnectionID = *filter.ConnectionID
+	}
+	if filter.Status != nil {
+		params.Status = string(*filter.Status)
+	}
+	if filter.Limit > 0 {
+		params.RowLimit = int64(filter.Limit)
+	}
+
+	rows, err := r.queries.ListJobs(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]job.Job, 0, len(rows))
+	for _, record := range rows {
+		j, err := toDomainJob(record)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, j)
+	}
+
+	return jobs, nil
+}
+
+func (r *JobRepository) InterruptJobs(ctx context.Context, reason string, now time.Time) (int64, error) {
+	return r.queries.InterruptJobs(ctx, sqlc.InterruptJobsParams{
+		Reason:     reason,
+		FinishedAt: sql.NullTime{Time: now, Valid: true},
+	})
+}
+
+func (r *JobRepository) DeleteJobsFinishedBefore(ctx context.Context, before time.Time) error {
+	return r.queries.DeleteJobsFinishedBefore(ctx, sql.NullTime{Time: before, Valid: true})
+}
+
+func toDomainJob(record sqlc.Job) (job.Job, error) {
+	status, err := job.NewStatus(record.Status)
+	if err != nil {
+		return job.Job{}, err
+	}
+
+	log := make([]string, 0)
+	if err := json.Unmarshal([]byte(record.Log), &log); err != nil {
+		return job.Job{}, err
+	}
+
+	var progress *float64
+	if record.Progress.Valid {
+		progress = &record.Progress.Float64
+	}
+
+	return job.Job{
+		ID:           record.ID,
+		ConnectionID: record.ConnectionID,
+		Kind:         record.Kind,
+		Database:     record.Database,
+		Target:       record.Target,
+		Status:       status,
+		Progress:     progress,
+		Phase:        record.Phase,
+		Log:          log,
+		Error:        record.Error,
+		Result:       record.Result,
+		CreatedAt:    record.CreatedAt,
+		StartedAt:    timePtrFrom(record.StartedAt),
+		FinishedAt:   timePtrFrom(record.FinishedAt),
+	}, nil
+}
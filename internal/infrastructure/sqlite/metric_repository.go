@@ -0,0 +1,121 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/domain/metric"
+	"github.com/felipemalacarne/mesa/internal/infrastructure/sqlite/sqlc"
+	"github.com/google/uuid"
+)
+
+type MetricRepository struct {
+	queries *sqlc.Queries
+}
+
+func NewMetricRepository(db *sql.DB) *MetricRepository {
+	return &MetricRepository{
+		queries: sqlc.New(db),
+	}
+}
+
+func (r *MetricRepository) Save(ctx context.Context, samples ...metric.Sample) error {
+	for _, s := range samples {
+		sizes, err := json.Marshal(s.DatabaseSizes)
+		if err != nil {
+			return err
+		}
+
+		if err := r.queries.UpsertMetricSample(ctx, sqlc.UpsertMetricSampleParams{
+			ConnectionID:   s.ConnectionID,
+			Resolution:     string(s.Resolution),
+			SampledAt:      s.Time.Unix(),
+			TotalSessions:  int64(s.TotalSessions),
+			ActiveSessions: int64(s.ActiveSessions),
+			MaxConnections: int64(s.MaxConnections),
+			Tps:            s.TPS,
+			CacheHitRatio:  s.CacheHitRatio,
+			Deadlocks:      s.Deadlocks,
+			TempBytes:      s.TempBytes,
+			DatabaseSizes:  string(sizes),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *MetricRepository) Range(ctx context.Context, connectionID uuid.UUID, resolution metric.Resolution, from, to time.Time) ([]metric.Sample, error) {
+	rows, err := r.queries.ListMetricSamples(ctx, sqlc.ListMetricSamplesParams{
+		ConnectionID: connectionID,
+		Resolution:   string(resolution),
+		SampledFrom:  from.Unix(),
+		SampledTo:    to.Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	samples := make([]metric.Sample, 0, len(rows))
+	for _, record := range rows {
+		sample, err := toDomainSample(record)
+		if err != nil {
+			return nil, err
+		}
+		samples = append(samples, sample)
+	}
+
+	return samples, nil
+}
+
+func (r *MetricRepository) Latest(ctx context.Context, connectionID uuid.UUID, resolution metric.Resolution) (*time.Time, error) {
+	sampledAt, err := r.queries.LatestMetricSampleTime(ctx, sqlc.LatestMetricSampleTimeParams{
+		ConnectionID: connectionID,
+		Resolution:   string(resolution),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	latest := time.Unix(sampledAt, 0).UTC()
+	return &latest, nil
+}
+
+func (r *MetricRepository) DeleteBefore(ctx context.Context, resolution metric.Resolution, before time.Time) error {
+	return r.queries.DeleteMetricSamplesBefore(ctx, sqlc.DeleteMetricSamplesBeforeParams{
+		Resolution: string(resolution),
+		SampledAt:  before.Unix(),
+	})
+}
+
+func toDomainSample(record sqlc.MetricSample) (metric.Sample, error) {
+	resolution, err := metric.NewResolution(record.Resolution)
+	if err != nil {
+		return metric.Sample{}, err
+	}
+
+	var sizes map[string]int64
+	if err := json.Unmarshal([]byte(record.DatabaseSizes), &sizes); err != nil {
+		return metric.Sample{}, err
+	}
+
+	return metric.Sample{
+		ConnectionID:   record.ConnectionID,
+		Resolution:     resolution,
+		Time:           time.Unix(record.SampledAt, 0).UTC(),
+		TotalSessions:  int(record.TotalSessions),
+		ActiveSessions: int(record.ActiveSessions),
+		MaxConnections: int(record.MaxConnections),
+		TPS:            record.Tps,
+		CacheHitRatio:  record.CacheHitRatio,
+		Deadlocks:      record.Deadlocks,
+		TempBytes:      record.TempBytes,
+		DatabaseSizes:  sizes,
+	}, nil
+}
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS metric_samples (
+    connection_id UUID NOT NULL REFERENCES connections (id) ON DELETE CASCADE,
+    resolution TEXT NOT NULL,
+    sampled_at INTEGER NOT NULL, -- Unix seconds, keeps range scans ordered regardless of time zone
+    total_sessions INTEGER NOT NULL,
+    active_sessions INTEGER NOT NULL,
+    max_connections INTEGER NOT NULL,
+    tps REAL NOT NULL,
+    cache_hit_ratio REAL NOT NULL,
+    deadlocks INTEGER NOT NULL,
+    temp_bytes INTEGER NOT NULL,
+    database_sizes TEXT NOT NULL, -- JSON object: database name -> bytes
+    PRIMARY KEY (connection_id, resolution, sampled_at)
+);
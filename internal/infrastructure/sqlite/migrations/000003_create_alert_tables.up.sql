@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS alert_rules (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    connection_id UUID REFERENCES connections (id) ON DELETE CASCADE, -- NULL applies to every connection
+    condition TEXT NOT NULL,
+    threshold REAL NOT NULL,
+    consecutive_checks INTEGER NOT NULL,
+    channel TEXT NOT NULL,
+    target TEXT NOT NULL,
+    enabled BOOLEAN NOT NULL,
+    updated_at DATETIME NOT NULL,
+    created_at DATETIME NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS alert_states (
+    rule_id UUID NOT NULL REFERENCES alert_rules (id) ON DELETE CASCADE,
+    connection_id UUID NOT NULL REFERENCES connections (id) ON DELETE CASCADE,
+    status TEXT NOT NULL,
+    consecutive_breaches INTEGER NOT NULL,
+    value REAL NOT NULL,
+    message TEXT NOT NULL,
+    fired_at DATETIME,
+    resolved_at DATETIME,
+    evaluated_at DATETIME NOT NULL,
+    PRIMARY KEY (rule_id, connection_id)
+);
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS connection_health (
+    connection_id UUID PRIMARY KEY REFERENCES connections (id) ON DELETE CASCADE,
+    status TEXT NOT NULL,
+    error TEXT NOT NULL,
+    latency_ms INTEGER NOT NULL,
+    checked_at DATETIME NOT NULL,
+    last_success_at DATETIME
+);
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS jobs (
+    id UUID PRIMARY KEY,
+    connection_id UUID NOT NULL REFERENCES connections (id) ON DELETE CASCADE,
+    kind TEXT NOT NULL,
+    database TEXT NOT NULL,
+    target TEXT NOT NULL,
+    status TEXT NOT NULL,
+    progress REAL,
+    phase TEXT NOT NULL,
+    log TEXT NOT NULL, -- JSON array of lines
+    error TEXT NOT NULL,
+    result TEXT NOT NULL,
+    created_at DATETIME NOT NULL,
+    started_at DATETIME,
+    finished_at DATETIME
+);
+
+CREATE INDEX IF NOT EXISTS jobs_connection_created_idx ON jobs (connection_id, created_at);
+CREATE INDEX IF NOT EXISTS jobs_status_idx ON jobs (status);
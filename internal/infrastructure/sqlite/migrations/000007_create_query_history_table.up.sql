@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS query_history (
+    id UUID PRIMARY KEY,
+    connection_id UUID NOT NULL REFERENCES connections (id) ON DELETE CASCADE,
+    database TEXT NOT NULL,
+    source TEXT NOT NULL,
+    statement TEXT NOT NULL,
+    duration_ms REAL NOT NULL,
+    rows_returned INTEGER,
+    rows_affected INTEGER,
+    error TEXT NOT NULL,
+    executed_at DATETIME NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS query_history_connection_executed_idx ON query_history (connection_id, executed_at);
+CREATE INDEX IF NOT EXISTS query_history_executed_idx ON query_history (executed_at);
@@ -0,0 +1 @@
+ALTER TABLE connections ADD COLUMN read_only BOOLEAN NOT NULL DEFAULT FALSE;
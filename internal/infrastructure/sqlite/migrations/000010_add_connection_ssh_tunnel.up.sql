@@ -0,0 +1 @@
+ALTER TABLE connections ADD COLUMN ssh_tunnel TEXT; -- JSON object, secrets AES-256 Encrypted
@@ -7,10 +7,17 @@ INSERT INTO connections (
     port,
     username,
     password,
+    environment,
+    tags,
+    color,
+    description,
+    protection,
+    read_only,
+    ssh_tunnel,
     updated_at,
     created_at
 ) VALUES (
-    ?, ?, ?, ?, ?, ?, ?, ?, ?
+    ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
 )
 ON CONFLICT (id) DO UPDATE
 SET name = excluded.name,
@@ -19,16 +26,23 @@ SET name = excluded.name,
     port = excluded.port,
     username = excluded.username,
     password = excluded.password,
+    environment = excluded.environment,
+    tags = excluded.tags,
+    color = excluded.color,
+    description = excluded.description,
+    protection = excluded.protection,
+    read_only = excluded.read_only,
+    ssh_tunnel = excluded.ssh_tunnel,
     updated_at = excluded.updated_at,
     created_at = excluded.created_at;
 
 -- name: GetConnection :one
-SELECT id, name, driver, host, port, username, password, updated_at, created_at
+SELECT id, name, driver, host, port, username, password, updated_at, created_at, environment, tags, color, description, protection, read_only, ssh_tunnel
 FROM connections
 WHERE id = ?;
 
 -- name: ListConnections :many
-SELECT id, name, driver, host, port, username, password, updated_at, created_at
+SELECT id, name, driver, host, port, username, password, updated_at, created_at, environment, tags, color, description, protection, read_only, ssh_tunnel
 FROM connections
 ORDER BY created_at DESC
 LIMIT 100;
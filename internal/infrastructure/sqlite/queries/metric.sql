@@ -0,0 +1,47 @@
+-- name: UpsertMetricSample :exec
+INSERT INTO metric_samples (
+    connection_id,
+    resolution,
+    sampled_at,
+    total_sessions,
+    active_sessions,
+    max_connections,
+    tps,
+    cache_hit_ratio,
+    deadlocks,
+    temp_bytes,
+    database_sizes
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+)
+ON CONFLICT (connection_id, resolution, sampled_at) DO UPDATE
+SET total_sessions = excluded.total_sessions,
+    active_sessions = excluded.active_sessions,
+    max_connections = excluded.max_connections,
+    tps = excluded.tps,
+    cache_hit_ratio = excluded.cache_hit_ratio,
+    deadlocks = excluded.deadlocks,
+    temp_bytes = excluded.temp_bytes,
+    database_sizes = excluded.database_sizes;
+
+-- name: ListMetricSamples :many
+SELECT connection_id, resolution, sampled_at, total_sessions, active_sessions, max_connections, tps, cache_hit_ratio, deadlocks, temp_bytes, database_sizes
+FROM metric_samples
+WHERE connection_id = ?
+  AND resolution = ?
+  AND sampled_at >= sqlc.arg(sampled_from)
+  AND sampled_at < sqlc.arg(sampled_to)
+ORDER BY sampled_at;
+
+-- name: LatestMetricSampleTime :one
+SELECT sampled_at
+FROM metric_samples
+WHERE connection_id = ?
+  AND resolution = ?
+ORDER BY sampled_at DESC
+LIMIT 1;
+
+-- name: DeleteMetricSamplesBefore :exec
+DELETE FROM metric_samples
+WHERE resolution = ?
+  AND sampled_at < ?;
@@ -0,0 +1,50 @@
+-- name: InsertHistoryEntry :exec
+INSERT INTO query_history (
+    id,
+    connection_id,
+    database,
+    source,
+    statement,
+    duration_ms,
+    rows_returned,
+    rows_affected,
+    error,
+    executed_at
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+);
+
+-- name: ListHistoryEntries :many
+SELECT id, connection_id, database, source, statement, duration_ms, rows_returned, rows_affected, error, executed_at
+FROM query_history
+WHERE (sqlc.narg(connection_id) IS NULL OR connection_id = sqlc.narg(connection_id))
+  AND (sqlc.narg(database) IS NULL OR database = sqlc.narg(database))
+  AND (sqlc.narg(source) IS NULL OR source = sqlc.narg(source))
+  AND (sqlc.narg(search) IS NULL OR instr(lower(statement), lower(sqlc.narg(search))) > 0)
+  AND (sqlc.narg(since) IS NULL OR executed_at >= sqlc.narg(since))
+  AND (sqlc.narg(until) IS NULL OR executed_at < sqlc.narg(until))
+  AND (sqlc.arg(failed_only) = FALSE OR error <> '')
+ORDER BY executed_at DESC
+LIMIT sqlc.arg(row_limit);
+
+-- name: DeleteHistoryBefore :exec
+DELETE FROM query_history
+WHERE executed_at < ?;
+
+-- name: ListHistoryConnections :many
+SELECT DISTINCT connection_id
+FROM query_history;
+
+-- name: GetHistoryCutoff :one
+-- The executed_at of the oldest entry kept for the connection; no row when it has fewer
+-- entries than the limit.
+SELECT executed_at
+FROM query_history
+WHERE connection_id = sqlc.arg(connection_id)
+ORDER BY executed_at DESC
+LIMIT 1 OFFSET sqlc.arg(keep_offset);
+
+-- name: DeleteConnectionHistoryBefore :exec
+DELETE FROM query_history
+WHERE connection_id = sqlc.arg(connection_id)
+  AND executed_at < sqlc.arg(before);
@@ -0,0 +1,38 @@
+-- name: UpsertSavedQuery :exec
+INSERT INTO saved_queries (
+    id,
+    name,
+    description,
+    sql,
+    connection_id,
+    driver,
+    tags,
+    params,
+    created_at,
+    updated_at
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+)
+ON CONFLICT (id) DO UPDATE
+SET name = excluded.name,
+    description = excluded.description,
+    sql = excluded.sql,
+    connection_id = excluded.connection_id,
+    driver = excluded.driver,
+    tags = excluded.tags,
+    params = excluded.params,
+    updated_at = excluded.updated_at;
+
+-- name: GetSavedQuery :one
+SELECT id, name, description, sql, connection_id, driver, tags, params, created_at, updated_at
+FROM saved_queries
+WHERE id = ?;
+
+-- name: ListSavedQueries :many
+SELECT id, name, description, sql, connection_id, driver, tags, params, created_at, updated_at
+FROM saved_queries
+ORDER BY name, created_at;
+
+-- name: DeleteSavedQuery :exec
+DELETE FROM saved_queries
+WHERE id = ?;
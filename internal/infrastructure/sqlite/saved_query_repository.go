@@ -0,0 +1,146 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/savedquery"
+	"github.com/felipemalacarne/mesa/internal/infrastructure/sqlite/sqlc"
+	"github.com/google/uuid"
+)
+
+// savedQueryParam is how a parameter definition is stored in the params JSON column.
+type savedQueryParam struct {
+	Name        string  `json:"name"`
+	Type        string  `json:"type"`
+	Description string  `json:"description"`
+	Default     *string `json:"default,omitempty"`
+}
+
+type SavedQueryRepository struct {
+	queries *sqlc.Queries
+}
+
+func NewSavedQueryRepository(db *sql.DB) *SavedQueryRepository {
+	return &SavedQueryRepository{
+		queries: sqlc.New(db),
+	}
+}
+
+func (r *SavedQueryRepository) SaveQuery(ctx context.Context, q *savedquery.Query) error {
+	tags, err := json.Marshal(q.Tags)
+	if err != nil {
+		return err
+	}
+
+	params := make([]savedQueryParam, len(q.Params))
+	for i, p := range q.Params {
+		params[i] = savedQueryParam{Name: p.Name, Type: string(p.Type), Description: p.Description, Default: p.Default}
+	}
+	encodedParams, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	var connectionID uuid.NullUUID
+	if q.ConnectionID != nil {
+		connectionID = uuid.NullUUID{UUID: *q.ConnectionID, Valid: true}
+	}
+	var driver sql.NullString
+	if q.Driver != nil {
+		driver = sql.NullString{String: string(*q.Driver), Valid: true}
+	}
+
+	return r.queries.UpsertSavedQuery(ctx, sqlc.UpsertSavedQueryParams{
+		ID:           q.ID,
+		Name:         q.Name,
+		Description:  q.Description,
+		Sql:          q.SQL,
+		ConnectionID: connectionID,
+		Driver:       driver,
+		Tags:         string(tags),
+		Params:       string(encodedParams),
+		CreatedAt:    q.CreatedAt,
+		UpdatedAt:    q.UpdatedAt,
+	})
+}
+
+func (r *SavedQueryRepository) FindQuery(ctx context.Context, id uuid.UUID) (*savedquery.Query, error) {
+	record, err := r.queries.GetSavedQuery(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainSavedQuery(record)
+}
+
+func (r *SavedQueryRepository) ListQueries(ctx context.Context) ([]*savedquery.Query, error) {
+	rows, err := r.queries.ListSavedQueries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*savedquery.Query, 0, len(rows))
+	for _, record := range rows {
+		q, err := toDomainSavedQuery(record)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, q)
+	}
+
+	return result, nil
+}
+
+func (r *SavedQueryRepository) DeleteQuery(ctx context.Context, id uuid.UUID) error {
+	return r.queries.DeleteSavedQuery(ctx, id)
+}
+
+func toDomainSavedQuery(record sqlc.SavedQuery) (*savedquery.Query, error) {
+	tags := make([]string, 0)
+	if err := json.Unmarshal([]byte(record.Tags), &tags); err != nil {
+		return nil, err
+	}
+
+	var stored []savedQueryParam
+	if err := json.Unmarshal([]byte(record.Params), &stored); err != nil {
+		return nil, err
+	}
+	params := make([]savedquery.Param, len(stored))
+	for i, p := range stored {
+		paramType, err := savedquery.NewParamType(p.Type)
+		if err != nil {
+			return nil, err
+		}
+		params[i] = savedquery.Param{Name: p.Name, Type: paramType, Description: p.Description, Default: p.Default}
+	}
+
+	var connectionID *uuid.UUID
+	if record.ConnectionID.Valid {
+		id := record.ConnectionID.UUID
+		connectionID = &id
+	}
+	var driver *connection.Driver
+	if record.Driver.Valid {
+		d := connection.Driver(record.Driver.String)
+		driver = &d
+	}
+
+	return &savedquery.Query{
+		ID:           record.ID,
+		Name:         record.Name,
+		Description:  record.Description,
+		SQL:          record.Sql,
+		ConnectionID: connectionID,
+		Driver:       driver,
+		Tags:         tags,
+		Params:       params,
+		CreatedAt:    record.CreatedAt,
+		UpdatedAt:    record.UpdatedAt,
+	}, nil
+}
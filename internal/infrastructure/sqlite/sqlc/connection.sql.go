@@ -23,7 +23,7 @@ func (q *Queries) DeleteConnection(ctx context.Context, id uuid.UUID) error {
 }
 
 const getConnection = `-- name: GetConnection :one
-SELECT id, name, driver, host, port, username, password, updated_at, created_at
+SELECT id, name, driver, host, port, username, password, updated_at, created_at, environment, tags, color, description, protection, read_only, ssh_tunnel
 FROM connections
 WHERE id = ?
 `
@@ -41,12 +41,19 @@ func (q *Queries) GetConnection(ctx context.Context, id uuid.UUID) (Connection,
 		&i.Password,
 		&i.UpdatedAt,
 		&i.CreatedAt,
+		&i.Environment,
+		&i.Tags,
+		&i.Color,
+		&i.Description,
+		&i.Protection,
+		&i.ReadOnly,
+		&i.SshTunnel,
 	)
 	return i, err
 }
 
 const listConnections = `-- name: ListConnections :many
-SELECT id, name, driver, host, port, username, password, updated_at, created_at
+SELECT id, name, driver, host, port, username, password, updated_at, created_at, environment, tags, color, description, protection, read_only, ssh_tunnel
 FROM connections
 ORDER BY created_at DESC
 LIMIT 100
@@ -71,6 +78,13 @@ func (q *Queries) ListConnections(ctx context.Context) ([]Connection, error) {
 			&i.Password,
 			&i.UpdatedAt,
 			&i.CreatedAt,
+			&i.Environment,
+			&i.Tags,
+			&i.Color,
+			&i.Description,
+			&i.Protection,
+			&i.ReadOnly,
+			&i.SshTunnel,
 		); err != nil {
 			return nil, err
 		}
@@ -94,10 +108,17 @@ INSERT INTO connections (
     port,
     username,
     password,
+    environment,
+    tags,
+    color,
+    description,
+    protection,
+    read_only,
+    ssh_tunnel,
     updated_at,
     created_at
 ) VALUES (
-    ?, ?, ?, ?, ?, ?, ?, ?, ?
+    ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
 )
 ON CONFLICT (id) DO UPDATE
 SET name = excluded.name,
@@ -106,20 +127,34 @@ SET name = excluded.name,
     port = excluded.port,
     username = excluded.username,
     password = excluded.password,
+    environment = excluded.environment,
+    tags = excluded.tags,
+    color = excluded.color,
+    description = excluded.description,
+    protection = excluded.protection,
+    read_only = excluded.read_only,
+    ssh_tunnel = excluded.ssh_tunnel,
     updated_at = excluded.updated_at,
     created_at = excluded.created_at
 `
 
 type UpsertConnectionParams struct {
-	ID        uuid.UUID
-	Name      string
-	Driver    string
-	Host      string
-	Port      int64
-	Username  string
-	Password  string
-	UpdatedAt sql.NullTime
-	CreatedAt sql.NullTime
+	ID          uuid.UUID
+	Name        string
+	Driver      string
+	Host        string
+	Port        int64
+	Username    string
+	Password    string
+	Environment string
+	Tags        string
+	Color       string
+	Description string
+	Protection  string
+	ReadOnly    bool
+	SshTunnel   sql.NullString
+	UpdatedAt   sql.NullTime
+	CreatedAt   sql.NullTime
 }
 
 func (q *Queries) UpsertConnection(ctx context.Context, arg UpsertConnectionParams) error {
@@ -131,6 +166,13 @@ func (q *Queries) UpsertConnection(ctx context.Context, arg UpsertConnectionPara
 		arg.Port,
 		arg.Username,
 		arg.Password,
+		arg.Environment,
+		arg.Tags,
+		arg.Color,
+		arg.Description,
+		arg.Protection,
+		arg.ReadOnly,
+		arg.SshTunnel,
 		arg.UpdatedAt,
 		arg.CreatedAt,
 	)
@@ -0,0 +1,194 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: job.sql
+
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const deleteJobsFinishedBefore = `-- name: DeleteJobsFinishedBefore :exec
+DELETE FROM jobs
+WHERE finished_at IS NOT NULL
+  AND finished_at < ?
+`
+
+func (q *Queries) DeleteJobsFinishedBefore(ctx context.Context, finishedAt sql.NullTime) error {
+	_, err := q.db.ExecContext(ctx, deleteJobsFinishedBefore, finishedAt)
+	return err
+}
+
+const getJob = `-- name: GetJob :one
+SELECT id, connection_id, kind, database, target, status, progress, phase, log, error, result, created_at, started_at, finished_at
+FROM jobs
+WHERE id = ?
+`
+
+func (q *Queries) GetJob(ctx context.Context, id uuid.UUID) (Job, error) {
+	row := q.db.QueryRowContext(ctx, getJob, id)
+	var i Job
+	err := row.Scan(
+		&i.ID,
+		&i.ConnectionID,
+		&i.Kind,
+		&i.Database,
+		&i.Target,
+		&i.Status,
+		&i.Progress,
+		&i.Phase,
+		&i.Log,
+		&i.Error,
+		&i.Result,
+		&i.CreatedAt,
+		&i.StartedAt,
+		&i.FinishedAt,
+	)
+	return i, err
+}
+
+const interruptJobs = `-- name: InterruptJobs :execrows
+UPDATE jobs
+SET status = 'failed',
+    error = ?1,
+    finished_at = ?2
+WHERE status IN ('queued', 'running')
+`
+
+type InterruptJobsParams struct {
+	Reason     string
+	FinishedAt sql.NullTime
+}
+
+func (q *Queries) InterruptJobs(ctx context.Context, arg InterruptJobsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, interruptJobs, arg.Reason, arg.FinishedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const listJobs = `-- name: ListJobs :many
+SELECT id, connection_id, kind, database, target, status, progress, phase, log, error, result, created_at, started_at, finished_at
+FROM jobs
+WHERE (?1 IS NULL OR connection_id = ?1)
+  AND (?2 IS NULL OR status = ?2)
+ORDER BY created_at DESC
+LIMIT ?3
+`
+
+type ListJobsParams struct {
+	ConnectionID interface{}
+	Status       interface{}
+	RowLimit     int64
+}
+
+func (q *Queries) ListJobs(ctx context.Context, arg ListJobsParams) ([]Job, error) {
+	rows, err := q.db.QueryContext(ctx, listJobs, arg.ConnectionID, arg.Status, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Job{}
+	for rows.Next() {
+		var i Job
+		if err := rows.Scan(
+			&i.ID,
+			&i.ConnectionID,
+			&i.Kind,
+			&i.Database,
+			&i.Target,
+			&i.Status,
+			&i.Progress,
+			&i.Phase,
+			&i.Log,
+			&i.Error,
+			&i.Result,
+			&i.CreatedAt,
+			&i.StartedAt,
+			&i.FinishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertJob = `-- name: UpsertJob :exec
+INSERT INTO jobs (
+    id,
+    connection_id,
+    kind,
+    database,
+    target,
+    status,
+    progress,
+    phase,
+    log,
+    error,
+    result,
+    created_at,
+    started_at,
+    finished_at
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+)
+ON CONFLICT (id) DO UPDATE
+SET status = excluded.status,
+    progress = excluded.progress,
+    phase = excluded.phase,
+    log = excluded.log,
+    error = excluded.error,
+    result = excluded.result,
+    started_at = excluded.started_at,
+    finished_at = excluded.finished_at
+`
+
+type UpsertJobParams struct {
+	ID           uuid.UUID
+	ConnectionID uuid.UUID
+	Kind         string
+	Database     string
+	Target       string
+	Status       string
+	Progress     sql.NullFloat64
+	Phase        string
+	Log          string
+	Error        string
+	Result       string
+	CreatedAt    time.Time
+	StartedAt    sql.NullTime
+	FinishedAt   sql.NullTime
+}
+
+func (q *Queries) UpsertJob(ctx context.Context, arg UpsertJobParams) error {
+	_, err := q.db.ExecContext(ctx, upsertJob,
+		arg.ID,
+		arg.ConnectionID,
+		arg.Kind,
+		arg.Database,
+		arg.Target,
+		arg.Status,
+		arg.Progress,
+		arg.Phase,
+		arg.Log,
+		arg.Error,
+		arg.Result,
+		arg.CreatedAt,
+		arg.StartedAt,
+		arg.FinishedAt,
+	)
+	return err
+}
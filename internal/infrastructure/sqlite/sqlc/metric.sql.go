@@ -0,0 +1,164 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: metric.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const deleteMetricSamplesBefore = `-- name: DeleteMetricSamplesBefore :exec
+DELETE FROM metric_samples
+WHERE resolution = ?
+  AND sampled_at < ?
+`
+
+type DeleteMetricSamplesBeforeParams struct {
+	Resolution string
+	SampledAt  int64
+}
+
+func (q *Queries) DeleteMetricSamplesBefore(ctx context.Context, arg DeleteMetricSamplesBeforeParams) error {
+	_, err := q.db.ExecContext(ctx, deleteMetricSamplesBefore, arg.Resolution, arg.SampledAt)
+	return err
+}
+
+const latestMetricSampleTime = `-- name: LatestMetricSampleTime :one
+SELECT sampled_at
+FROM metric_samples
+WHERE connection_id = ?
+  AND resolution = ?
+ORDER BY sampled_at DESC
+LIMIT 1
+`
+
+type LatestMetricSampleTimeParams struct {
+	ConnectionID uuid.UUID
+	Resolution   string
+}
+
+func (q *Queries) LatestMetricSampleTime(ctx context.Context, arg LatestMetricSampleTimeParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, latestMetricSampleTime, arg.ConnectionID, arg.Resolution)
+	var sampled_at int64
+	err := row.Scan(&sampled_at)
+	return sampled_at, err
+}
+
+const listMetricSamples = `-- name: ListMetricSamples :many
+SELECT connection_id, resolution, sampled_at, total_sessions, active_sessions, max_connections, tps, cache_hit_ratio, deadlocks, temp_bytes, database_sizes
+FROM metric_samples
+WHERE connection_id = ?
+  AND resolution = ?
+  AND sampled_at >= ?3
+  AND sampled_at < ?4
+ORDER BY sampled_at
+`
+
+type ListMetricSamplesParams struct {
+	ConnectionID uuid.UUID
+	Resolution   string
+	SampledFrom  int64
+	SampledTo    int64
+}
+
+func (q *Queries) ListMetricSamples(ctx context.Context, arg ListMetricSamplesParams) ([]MetricSample, error) {
+	rows, err := q.db.QueryContext(ctx, listMetricSamples,
+		arg.ConnectionID,
+		arg.Resolution,
+		arg.SampledFrom,
+		arg.SampledTo,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []MetricSample{}
+	for rows.Next() {
+		var i MetricSample
+		if err := rows.Scan(
+			&i.ConnectionID,
+			&i.Resolution,
+			&i.SampledAt,
+			&i.TotalSessions,
+			&i.ActiveSessions,
+			&i.MaxConnections,
+			&i.Tps,
+			&i.CacheHitRatio,
+			&i.Deadlocks,
+			&i.TempBytes,
+			&i.DatabaseSizes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertMetricSample = `-- name: UpsertMetricSample :exec
+INSERT INTO metric_samples (
+    connection_id,
+    resolution,
+    sampled_at,
+    total_sessions,
+    active_sessions,
+    max_connections,
+    tps,
+    cache_hit_ratio,
+    deadlocks,
+    temp_bytes,
+    database_sizes
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+)
+ON CONFLICT (connection_id, resolution, sampled_at) DO UPDATE
+SET total_sessions = excluded.total_sessions,
+    active_sessions = excluded.active_sessions,
+    max_connections = excluded.max_connections,
+    tps = excluded.tps,
+    cache_hit_ratio = excluded.cache_hit_ratio,
+    deadlocks = excluded.deadlocks,
+    temp_bytes = excluded.temp_bytes,
+    database_sizes = excluded.database_sizes
+`
+
+type UpsertMetricSampleParams struct {
+	ConnectionID   uuid.UUID
+	Resolution     string
+	SampledAt      int64
+	TotalSessions  int64
+	ActiveSessions int64
+	MaxConnections int64
+	Tps            float64
+	CacheHitRatio  float64
+	Deadlocks      int64
+	TempBytes      int64
+	DatabaseSizes  string
+}
+
+func (q *Queries) UpsertMetricSample(ctx context.Context, arg UpsertMetricSampleParams) error {
+	_, err := q.db.ExecContext(ctx, upsertMetricSample,
+		arg.ConnectionID,
+		arg.Resolution,
+		arg.SampledAt,
+		arg.TotalSessions,
+		arg.ActiveSessions,
+		arg.MaxConnections,
+		arg.Tps,
+		arg.CacheHitRatio,
+		arg.Deadlocks,
+		arg.TempBytes,
+		arg.DatabaseSizes,
+	)
+	return err
+}
@@ -6,18 +6,118 @@ package sqlc
 
 import (
 	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 )
 
+type AlertRule struct {
+	ID                uuid.UUID
+	Name              string
+	ConnectionID      uuid.NullUUID
+	Condition         string
+	Threshold         float64
+	ConsecutiveChecks int64
+	Channel           string
+	Target            string
+	Enabled           bool
+	UpdatedAt         time.Time
+	CreatedAt         time.Time
+}
+
+type AlertState struct {
+	RuleID              uuid.UUID
+	ConnectionID        uuid.UUID
+	Status              string
+	ConsecutiveBreaches int64
+	Value               float64
+	Message             string
+	FiredAt             sql.NullTime
+	ResolvedAt          sql.NullTime
+	EvaluatedAt         time.Time
+}
+
 type Connection struct {
-	ID        uuid.UUID
-	Name      string
-	Driver    string
-	Host      string
-	Port      int64
-	Username  string
-	Password  string
-	UpdatedAt sql.NullTime
-	CreatedAt sql.NullTime
+	ID          uuid.UUID
+	Name        string
+	Driver      string
+	Host        string
+	Port        int64
+	Username    string
+	Password    string
+	UpdatedAt   sql.NullTime
+	CreatedAt   sql.NullTime
+	Environment string
+	Tags        string
+	Color       string
+	Description string
+	Protection  string
+	ReadOnly    bool
+	SshTunnel   sql.NullString
+}
+
+type ConnectionHealth struct {
+	ConnectionID  uuid.UUID
+	Status        string
+	Error         string
+	LatencyMs     int64
+	CheckedAt     time.Time
+	LastSuccessAt sql.NullTime
+}
+
+type Job struct {
+	ID           uuid.UUID
+	ConnectionID uuid.UUID
+	Kind         string
+	Database     string
+	Target       string
+	Status       string
+	Progress     sql.NullFloat64
+	Phase        string
+	Log          string
+	Error        string
+	Result       string
+	CreatedAt    time.Time
+	StartedAt    sql.NullTime
+	FinishedAt   sql.NullTime
+}
+
+type MetricSample struct {
+	ConnectionID   uuid.UUID
+	Resolution     string
+	SampledAt      int64
+	TotalSessions  int64
+	ActiveSessions int64
+	MaxConnections int64
+	Tps            float64
+	CacheHitRatio  float64
+	Deadlocks      int64
+	TempBytes      int64
+	DatabaseSizes  string
+}
+
+type QueryHistory struct {
+	ID           uuid.UUID
+	ConnectionID uuid.UUID
+	Database     string
+	Source       string
+	Statement    string
+	DurationMs   float64
+	RowsReturned sql.NullInt64
+	RowsAffected sql.NullInt64
+	Error        string
+	ExecutedAt   time.Time
+}
+
+type SavedQuery struct {
+	ID           uuid.UUID
+	Name         string
+	Description  string
+	Sql          string
+	ConnectionID uuid.NullUUID
+	Driver       sql.NullString
+	Tags         string
+	Params       string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
 }
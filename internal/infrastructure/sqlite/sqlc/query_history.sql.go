@@ -0,0 +1,204 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: query_history.sql
+
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const deleteConnectionHistoryBefore = `-- name: DeleteConnectionHistoryBefore :exec
+DELETE FROM query_history
+WHERE connection_id = ?1
+  AND executed_at < ?2
+`
+
+type DeleteConnectionHistoryBeforeParams struct {
+	ConnectionID uuid.UUID
+	Before       time.Time
+}
+
+func (q *Queries) DeleteConnectionHistoryBefore(ctx context.Context, arg DeleteConnectionHistoryBeforeParams) error {
+	_, err := q.db.ExecContext(ctx, deleteConnectionHistoryBefore, arg.ConnectionID, arg.Before)
+	return err
+}
+
+const deleteHistoryBefore = `-- name: DeleteHistoryBefore :exec
+DELETE FROM query_history
+WHERE executed_at < ?
+`
+
+func (q *Queries) DeleteHistoryBefore(ctx context.Context, executedAt time.Time) error {
+	_, err := q.db.ExecContext(ctx, deleteHistoryBefore, executedAt)
+	return err
+}
+
+const getHistoryCutoff = `-- name: GetHistoryCutoff :one
+SELECT executed_at
+FROM query_history
+WHERE connection_id = ?1
+ORDER BY executed_at DESC
+LIMIT 1 OFFSET ?2
+`
+
+type GetHistoryCutoffParams struct {
+	ConnectionID uuid.UUID
+	KeepOffset   int64
+}
+
+// The executed_at of the oldest entry kept for the connection; no row when it has fewer
+// entries than the limit.
+func (q *Queries) GetHistoryCutoff(ctx context.Context, arg GetHistoryCutoffParams) (time.Time, error) {
+	row := q.db.QueryRowContext(ctx, getHistoryCutoff, arg.ConnectionID, arg.KeepOffset)
+	var executed_at time.Time
+	err := row.Scan(&executed_at)
+	return executed_at, err
+}
+
+const insertHistoryEntry = `-- name: InsertHistoryEntry :exec
+INSERT INTO query_history (
+    id,
+    connection_id,
+    database,
+    source,
+    statement,
+    duration_ms,
+    rows_returned,
+    rows_affected,
+    error,
+    executed_at
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+)
+`
+
+type InsertHistoryEntryParams struct {
+	ID           uuid.UUID
+	ConnectionID uuid.UUID
+	Database     string
+	Source       string
+	Statement    string
+	DurationMs   float64
+	RowsReturned sql.NullInt64
+	RowsAffected sql.NullInt64
+	Error        string
+	ExecutedAt   time.Time
+}
+
+func (q *Queries) InsertHistoryEntry(ctx context.Context, arg InsertHistoryEntryParams) error {
+	_, err := q.db.ExecContext(ctx, insertHistoryEntry,
+		arg.ID,
+		arg.ConnectionID,
+		arg.Database,
+		arg.Source,
+		arg.Statement,
+		arg.DurationMs,
+		arg.RowsReturned,
+		arg.RowsAffected,
+		arg.Error,
+		arg.ExecutedAt,
+	)
+	return err
+}
+
+const listHistoryConnections = `-- name: ListHistoryConnections :many
+SELECT DISTINCT connection_id
+FROM query_history
+`
+
+func (q *Queries) ListHistoryConnections(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, listHistoryConnections)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var connection_id uuid.UUID
+		if err := rows.Scan(&connection_id); err != nil {
+			return nil, err
+		}
+		items = append(items, connection_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listHistoryEntries = `-- name: ListHistoryEntries :many
+SELECT id, connection_id, database, source, statement, duration_ms, rows_returned, rows_affected, error, executed_at
+FROM query_history
+WHERE (?1 IS NULL OR connection_id = ?1)
+  AND (?2 IS NULL OR database = ?2)
+  AND (?3 IS NULL OR source = ?3)
+  AND (?4 IS NULL OR instr(lower(statement), lower(?4)) > 0)
+  AND (?5 IS NULL OR executed_at >= ?5)
+  AND (?6 IS NULL OR executed_at < ?6)
+  AND (?7 = FALSE OR error <> '')
+ORDER BY executed_at DESC
+LIMIT ?8
+`
+
+type ListHistoryEntriesParams struct {
+	ConnectionID interface{}
+	Database     interface{}
+	Source       interface{}
+	Search       interface{}
+	Since        interface{}
+	Until        interface{}
+	FailedOnly   interface{}
+	RowLimit     int64
+}
+
+func (q *Queries) ListHistoryEntries(ctx context.Context, arg ListHistoryEntriesParams) ([]QueryHistory, error) {
+	rows, err := q.db.QueryContext(ctx, listHistoryEntries,
+		arg.ConnectionID,
+		arg.Database,
+		arg.Source,
+		arg.Search,
+		arg.Since,
+		arg.Until,
+		arg.FailedOnly,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []QueryHistory{}
+	for rows.Next() {
+		var i QueryHistory
+		if err := rows.Scan(
+			&i.ID,
+			&i.ConnectionID,
+			&i.Database,
+			&i.Source,
+			&i.Statement,
+			&i.DurationMs,
+			&i.RowsReturned,
+			&i.RowsAffected,
+			&i.Error,
+			&i.ExecutedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
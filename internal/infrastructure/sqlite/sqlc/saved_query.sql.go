@@ -0,0 +1,143 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: saved_query.sql
+
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const deleteSavedQuery = `-- name: DeleteSavedQuery :exec
+DELETE FROM saved_queries
+WHERE id = ?
+`
+
+func (q *Queries) DeleteSavedQuery(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteSavedQuery, id)
+	return err
+}
+
+const getSavedQuery = `-- name: GetSavedQuery :one
+SELECT id, name, description, sql, connection_id, driver, tags, params, created_at, updated_at
+FROM saved_queries
+WHERE id = ?
+`
+
+func (q *Queries) GetSavedQuery(ctx context.Context, id uuid.UUID) (SavedQuery, error) {
+	row := q.db.QueryRowContext(ctx, getSavedQuery, id)
+	var i SavedQuery
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Sql,
+		&i.ConnectionID,
+		&i.Driver,
+		&i.Tags,
+		&i.Params,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listSavedQueries = `-- name: ListSavedQueries :many
+SELECT id, name, description, sql, connection_id, driver, tags, params, created_at, updated_at
+FROM saved_queries
+ORDER BY name, created_at
+`
+
+func (q *Queries) ListSavedQueries(ctx context.Context) ([]SavedQuery, error) {
+	rows, err := q.db.QueryContext(ctx, listSavedQueries)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SavedQuery{}
+	for rows.Next() {
+		var i SavedQuery
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.Sql,
+			&i.ConnectionID,
+			&i.Driver,
+			&i.Tags,
+			&i.Params,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertSavedQuery = `-- name: UpsertSavedQuery :exec
+INSERT INTO saved_queries (
+    id,
+    name,
+    description,
+    sql,
+    connection_id,
+    driver,
+    tags,
+    params,
+    created_at,
+    updated_at
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+)
+ON CONFLICT (id) DO UPDATE
+SET name = excluded.name,
+    description = excluded.description,
+    sql = excluded.sql,
+    connection_id = excluded.connection_id,
+    driver = excluded.driver,
+    tags = excluded.tags,
+    params = excluded.params,
+    updated_at = excluded.updated_at
+`
+
+type UpsertSavedQueryParams struct {
+	ID           uuid.UUID
+	Name         string
+	Description  string
+	Sql          string
+	ConnectionID uuid.NullUUID
+	Driver       sql.NullString
+	Tags         string
+	Params       string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (q *Queries) UpsertSavedQuery(ctx context.Context, arg UpsertSavedQueryParams) error {
+	_, err := q.db.ExecContext(ctx, upsertSavedQuery,
+		arg.ID,
+		arg.Name,
+		arg.Description,
+		arg.Sql,
+		arg.ConnectionID,
+		arg.Driver,
+		arg.Tags,
+		arg.Params,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
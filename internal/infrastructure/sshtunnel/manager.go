@@ -0,0 +1,300 @@
+// Package sshtunnel dials the database servers of connections that sit behind an SSH bastion.
+package sshtunnel
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+const (
+	// dialTimeout bounds the TCP connection and the SSH handshake with the bastion when the
+	// context carries no deadline of its own.
+	dialTimeout = 10 * time.Second
+	// idleTimeout closes the SSH connections that no session has used for this long.
+	idleTimeout = 10 * time.Minute
+)
+
+// Manager keeps one SSH connection per tunnel configuration and opens the sessions of every
+// connection that uses it as channels over it, instead of a handshake per call.
+type Manager struct {
+	crypto domain.Cryptographer
+
+	mu      sync.Mutex
+	tunnels map[string]*tunnel
+}
+
+type tunnel struct {
+	client *ssh.Client
+	// active counts the channels still open; the tunnel only idles once it reaches zero.
+	active   int
+	lastUsed time.Time
+}
+
+func NewManager(crypto domain.Cryptographer) *Manager {
+	return &Manager{
+		crypto:  crypto,
+		tunnels: make(map[string]*tunnel),
+	}
+}
+
+// DialContext opens a channel to addr, resolved by the bastion. An SSH connection found dead
+// is replaced once before giving up.
+func (m *Manager) DialContext(ctx context.Context, cfg connection.SSHTunnel, network, addr string) (net.Conn, error) {
+	key := tunnelKey(cfg)
+
+	for attempt := 0; ; attempt++ {
+		t, err := m.acquire(ctx, key, cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		conn, err := t.client.DialContext(ctx, network, addr)
+		if err == nil {
+			return &tunnelConn{Conn: conn, release: sync.OnceFunc(func() { m.release(t) })}, nil
+		}
+		m.release(t)
+
+		if attempt == 0 && ctx.Err() == nil && !alive(t.client) {
+			m.discard(key, t)
+			continue
+		}
+		return nil, fmt.Errorf("%w: %s through %s: %v", connection.ErrTunnelUnreachable, addr, cfg.Host, err)
+	}
+}
+
+// Close closes every SSH connection, and with them the sessions still using them.
+func (m *Manager) Close() error {
+	m.mu.Lock()
+	tunnels := m.tunnels
+	m.tunnels = make(map[string]*tunnel)
+	m.mu.Unlock()
+
+	var errs []error
+	for _, t := range tunnels {
+		if err := t.client.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (m *Manager) acquire(ctx context.Context, key string, cfg connection.SSHTunnel) (*tunnel, error) {
+	m.mu.Lock()
+	idle := m.takeIdle()
+	t, ok := m.tunnels[key]
+	if ok {
+		t.active++
+		t.lastUsed = time.Now()
+	}
+	m.mu.Unlock()
+
+	for _, client := range idle {
+		_ = client.Close()
+	}
+	if ok {
+		return t, nil
+	}
+
+	// The handshake runs without the lock; a concurrent call may open the same tunnel.
+	client, err := m.dial(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if existing, ok := m.tunnels[key]; ok {
+		_ = client.Close()
+		existing.active++
+		existing.lastUsed = time.Now()
+		return existing, nil
+	}
+
+	t = &tunnel{client: client, active: 1, lastUsed: time.Now()}
+	m.tunnels[key] = t
+	go func() {
+		_ = client.Wait()
+		m.discard(key, t)
+	}()
+	return t, nil
+}
+
+func (m *Manager) release(t *tunnel) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t.active--
+	t.lastUsed = time.Now()
+}
+
+// discard forgets the tunnel, if it is still the one registered for key, and closes it.
+func (m *Manager) discard(key string, t *tunnel) {
+	m.mu.Lock()
+	if m.tunnels[key] == t {
+		delete(m.tunnels, key)
+	}
+	m.mu.Unlock()
+	_ = t.client.Close()
+}
+
+// takeIdle removes the tunnels idle for longer than idleTimeout and returns their clients, to
+// be closed once the lock is released. The caller holds m.mu.
+func (m *Manager) takeIdle() []*ssh.Client {
+	var idle []*ssh.Client
+	for key, t := range m.tunnels {
+		if t.active == 0 && time.Since(t.lastUsed) > idleTimeout {
+			delete(m.tunnels, key)
+			idle = append(idle, t.client)
+		}
+	}
+	return idle
+}
+
+func (m *Manager) dial(ctx context.Context, cfg connection.SSHTunnel) (*ssh.Client, error) {
+	auth, err := m.authMethod(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	hostKeys, err := hostKeyCallback(cfg.KnownHosts)
+	if err != nil {
+		return nil, err
+	}
+	var verifyErr error
+	config := &ssh.ClientConfig{
+		User: cfg.Username,
+		Auth: []ssh.AuthMethod{auth},
+		HostKeyCallback: func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+			verifyErr = hostKeys(hostname, remote, key)
+			return verifyErr
+		},
+	}
+
+	addr := net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port))
+	dialer := net.Dialer{Timeout: dialTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", connection.ErrTunnelUnreachable, err)
+	}
+
+	// The SSH handshake does not take a context, so its deadline is set on the connection.
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(dialTimeout)
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("%w: %v", connection.ErrTunnelUnreachable, err)
+	}
+
+	sshConn, channels, requests, err := ssh.NewClientConn(conn, addr, config)
+	if err != nil {
+		_ = conn.Close()
+		if verifyErr != nil {
+			return nil, fmt.Errorf("%w: %s: %v", connection.ErrHostKeyVerification, addr, verifyErr)
+		}
+		return nil, fmt.Errorf("%w: %s: %v", connection.ErrTunnelUnreachable, addr, err)
+	}
+
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		_ = sshConn.Close()
+		return nil, fmt.Errorf("%w: %v", connection.ErrTunnelUnreachable, err)
+	}
+
+	return ssh.NewClient(sshConn, channels, requests), nil
+}
+
+func (m *Manager) authMethod(cfg connection.SSHTunnel) (ssh.AuthMethod, error) {
+	secret, err := m.crypto.Decrypt(cfg.Secret)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Auth == connection.TunnelAuthPassword {
+		return ssh.Password(secret), nil
+	}
+
+	var signer ssh.Signer
+	if cfg.Passphrase != "" {
+		passphrase, err := m.crypto.Decrypt(cfg.Passphrase)
+		if err != nil {
+			return nil, err
+		}
+		signer, err = ssh.ParsePrivateKeyWithPassphrase([]byte(secret), []byte(passphrase))
+		if err != nil {
+			return nil, fmt.Errorf("%w: SSH private key: %v", connection.ErrInvalidConfiguration, err)
+		}
+	} else {
+		signer, err = ssh.ParsePrivateKey([]byte(secret))
+		if err != nil {
+			return nil, fmt.Errorf("%w: SSH private key: %v", connection.ErrInvalidConfiguration, err)
+		}
+	}
+	return ssh.PublicKeys(signer), nil
+}
+
+// hostKeyCallback verifies the bastion against the known_hosts lines of the tunnel, with the
+// matching rules of OpenSSH (hashed names, wildcards, @revoked). knownhosts only reads files, so
+// the lines go through a temporary file removed as soon as it is parsed.
+func hostKeyCallback(knownHosts string) (ssh.HostKeyCallback, error) {
+	file, err := os.CreateTemp("", "mesa-known-hosts-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(file.Name())
+
+	_, err = file.WriteString(knownHosts + "\n")
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	callback, err := knownhosts.New(file.Name())
+	if err != nil {
+		return nil, fmt.Errorf("%w: known_hosts: %v", connection.ErrInvalidConfiguration, err)
+	}
+	return callback, nil
+}
+
+// alive reports whether the SSH connection still answers; a server that does not know the
+// keepalive request still replies, with a failure.
+func alive(client *ssh.Client) bool {
+	_, _, err := client.SendRequest("keepalive@openssh.com", true, nil)
+	return err == nil
+}
+
+// tunnelKey identifies a tunnel configuration. Connections with the same bastion, user and
+// credentials share the SSH connection; any change to them opens a new one.
+func tunnelKey(cfg connection.SSHTunnel) string {
+	h := sha256.New()
+	for _, field := range []string{cfg.Host, strconv.Itoa(cfg.Port), cfg.Username, string(cfg.Auth), cfg.Secret, cfg.Passphrase, cfg.KnownHosts} {
+		h.Write([]byte(field))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// tunnelConn is a channel of the tunnel; closing it marks the tunnel as used one less time.
+type tunnelConn struct {
+	net.Conn
+	release func()
+}
+
+func (c *tunnelConn) Close() error {
+	defer c.release()
+	return c.Conn.Close()
+}
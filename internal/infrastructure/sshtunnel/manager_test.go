@@ -0,0 +1,264 @@
+package sshtunnel
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// plainCrypto stores secrets as they are.
+type plainCrypto struct{}
+
+func (plainCrypto) Encrypt(plainText string) (string, error)  { return plainText, nil }
+func (plainCrypto) Decrypt(cipherText string) (string, error) { return cipherText, nil }
+
+// bastion is an in-process SSH server that accepts the password "secret" or clientKey, and
+// echoes back whatever is written to its direct-tcpip channels.
+type bastion struct {
+	addr       string
+	hostKey    ssh.PublicKey
+	handshakes atomic.Int32
+}
+
+func startBastion(t *testing.T, clientKey ssh.PublicKey) *bastion {
+	t.Helper()
+
+	_, hostPriv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hostSigner, err := ssh.NewSignerFromKey(hostPriv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := &ssh.ServerConfig{
+		PasswordCallback: func(_ ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+			if string(password) != "secret" {
+				return nil, errors.New("wrong password")
+			}
+			return nil, nil
+		},
+		PublicKeyCallback: func(_ ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if !bytes.Equal(key.Marshal(), clientKey.Marshal()) {
+				return nil, errors.New("unknown key")
+			}
+			return nil, nil
+		},
+	}
+	config.AddHostKey(hostSigner)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = listener.Close() })
+
+	b := &bastion{addr: listener.Addr().String(), hostKey: hostSigner.PublicKey()}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go b.serve(conn, config)
+		}
+	}()
+	return b
+}
+
+func (b *bastion) serve(conn net.Conn, config *ssh.ServerConfig) {
+	sshConn, channels, requests, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		_ = conn.Close()
+		return
+	}
+	defer sshConn.Close()
+	b.handshakes.Add(1)
+
+	go ssh.DiscardRequests(requests)
+	for newChannel := range channels {
+		if newChannel.ChannelType() != "direct-tcpip" {
+			_ = newChannel.Reject(ssh.UnknownChannelType, "only direct-tcpip is supported")
+			continue
+		}
+		channel, channelRequests, err := newChannel.Accept()
+		if err != nil {
+			continue
+		}
+		go ssh.DiscardRequests(channelRequests)
+		go func() {
+			defer channel.Close()
+			_, _ = io.Copy(channel, channel)
+		}()
+	}
+}
+
+func (b *bastion) tunnel(t *testing.T, auth connection.TunnelAuth, secret string, hostKey ssh.PublicKey) connection.SSHTunnel {
+	t.Helper()
+	host, port, err := net.SplitHostPort(b.addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	portNumber, err := strconv.Atoi(port)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return connection.SSHTunnel{
+		Host:       host,
+		Port:       portNumber,
+		Username:   "mesa",
+		Auth:       auth,
+		Secret:     secret,
+		KnownHosts: knownhosts.Line([]string{knownhosts.Normalize(b.addr)}, hostKey),
+	}
+}
+
+func echo(t *testing.T, conn net.Conn, message string) {
+	t.Helper()
+	if _, err := conn.Write([]byte(message)); err != nil {
+		t.Fatalf("writing through the tunnel: %v", err)
+	}
+	got := make([]byte, len(message))
+	if _, err := io.ReadFull(conn, got); err != nil {
+		t.Fatalf("reading through the tunnel: %v", err)
+	}
+	if string(got) != message {
+		t.Fatalf("tunnel echoed %q, want %q", got, message)
+	}
+}
+
+func TestManagerDialContext(t *testing.T) {
+	_, clientPriv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientSigner, err := ssh.NewSignerFromKey(clientPriv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, err := ssh.MarshalPrivateKey(clientPriv, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateKey := string(pem.EncodeToMemory(block))
+
+	_, otherPriv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherSigner, err := ssh.NewSignerFromKey(otherPriv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	t.Run("password auth reuses one SSH connection", func(t *testing.T) {
+		b := startBastion(t, clientSigner.PublicKey())
+		m := NewManager(plainCrypto{})
+		defer m.Close()
+		cfg := b.tunnel(t, connection.TunnelAuthPassword, "secret", b.hostKey)
+
+		first, err := m.DialContext(ctx, cfg, "tcp", "db.internal:5432")
+		if err != nil {
+			t.Fatalf("DialContext() error = %v", err)
+		}
+		defer first.Close()
+		second, err := m.DialContext(ctx, cfg, "tcp", "db.internal:5432")
+		if err != nil {
+			t.Fatalf("DialContext() error = %v", err)
+		}
+		defer second.Close()
+
+		echo(t, first, "first")
+		echo(t, second, "second")
+		if got := b.handshakes.Load(); got != 1 {
+			t.Fatalf("bastion saw %d handshakes, want 1", got)
+		}
+	})
+
+	t.Run("private key auth", func(t *testing.T) {
+		b := startBastion(t, clientSigner.PublicKey())
+		m := NewManager(plainCrypto{})
+		defer m.Close()
+
+		conn, err := m.DialContext(ctx, b.tunnel(t, connection.TunnelAuthPrivateKey, privateKey, b.hostKey), "tcp", "db.internal:5432")
+		if err != nil {
+			t.Fatalf("DialContext() error = %v", err)
+		}
+		defer conn.Close()
+		echo(t, conn, "key")
+	})
+
+	t.Run("host key mismatch", func(t *testing.T) {
+		b := startBastion(t, clientSigner.PublicKey())
+		m := NewManager(plainCrypto{})
+		defer m.Close()
+
+		_, err := m.DialContext(ctx, b.tunnel(t, connection.TunnelAuthPassword, "secret", otherSigner.PublicKey()), "tcp", "db.internal:5432")
+		if !errors.Is(err, connection.ErrHostKeyVerification) {
+			t.Fatalf("DialContext() error = %v, want %v", err, connection.ErrHostKeyVerification)
+		}
+		if got := b.handshakes.Load(); got != 0 {
+			t.Fatalf("bastion saw %d handshakes, want 0", got)
+		}
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		b := startBastion(t, clientSigner.PublicKey())
+		m := NewManager(plainCrypto{})
+		defer m.Close()
+
+		_, err := m.DialContext(ctx, b.tunnel(t, connection.TunnelAuthPassword, "guess", b.hostKey), "tcp", "db.internal:5432")
+		if !errors.Is(err, connection.ErrTunnelUnreachable) {
+			t.Fatalf("DialContext() error = %v, want %v", err, connection.ErrTunnelUnreachable)
+		}
+	})
+
+	t.Run("idle connection is closed and replaced", func(t *testing.T) {
+		b := startBastion(t, clientSigner.PublicKey())
+		m := NewManager(plainCrypto{})
+		defer m.Close()
+		cfg := b.tunnel(t, connection.TunnelAuthPassword, "secret", b.hostKey)
+
+		conn, err := m.DialContext(ctx, cfg, "tcp", "db.internal:5432")
+		if err != nil {
+			t.Fatalf("DialContext() error = %v", err)
+		}
+		_ = conn.Close()
+
+		m.mu.Lock()
+		idle := m.tunnels[tunnelKey(cfg)]
+		idle.lastUsed = time.Now().Add(-idleTimeout - time.Second)
+		m.mu.Unlock()
+
+		conn, err = m.DialContext(ctx, cfg, "tcp", "db.internal:5432")
+		if err != nil {
+			t.Fatalf("DialContext() error = %v", err)
+		}
+		defer conn.Close()
+		echo(t, conn, "again")
+
+		if got := b.handshakes.Load(); got != 2 {
+			t.Fatalf("bastion saw %d handshakes, want 2", got)
+		}
+		if alive(idle.client) {
+			t.Fatal("the idle SSH connection is still open")
+		}
+	})
+}
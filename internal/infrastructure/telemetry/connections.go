@@ -0,0 +1,122 @@
+package telemetry
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// maxConcurrentScrapes limits how many connections are checked at once during a scrape.
+	maxConcurrentScrapes    = 4
+	connectionScrapeTimeout = 5 * time.Second
+)
+
+var connectionLabels = []string{"connection_id", "connection_name", "driver"}
+
+var (
+	connectionUpDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "connection", "up"),
+		"Whether the monitored database answered a ping (1) or not (0).",
+		connectionLabels, nil,
+	)
+	connectionPingDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "connection", "ping_duration_seconds"),
+		"Round trip of the last ping to the monitored database.",
+		connectionLabels, nil,
+	)
+	connectionSessionsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "connection", "sessions"),
+		"Client sessions open on the monitored database.",
+		connectionLabels, nil,
+	)
+	connectionMaxDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "connection", "max_connections"),
+		"max_connections setting of the monitored database.",
+		connectionLabels, nil,
+	)
+)
+
+// ConnectionCollector gathers health gauges of every saved connection through the Monitor
+// interface at scrape time.
+type ConnectionCollector struct {
+	repo     connection.Repository
+	crypto   domain.Cryptographer
+	gateways connection.GatewayFactory
+}
+
+func NewConnectionCollector(repo connection.Repository, crypto domain.Cryptographer, gateways connection.GatewayFactory) *ConnectionCollector {
+	return &ConnectionCollector{repo: repo, crypto: crypto, gateways: gateways}
+}
+
+func (c *ConnectionCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- connectionUpDesc
+	ch <- connectionPingDesc
+	ch <- connectionSessionsDesc
+	ch <- connectionMaxDesc
+}
+
+func (c *ConnectionCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx := context.Background()
+
+	conns, err := c.repo.ListAll(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "connection collector: listing connections", "error", err)
+		return
+	}
+
+	sem := make(chan struct{}, maxConcurrentScrapes)
+	var wg sync.WaitGroup
+	for _, conn := range conns {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(conn connection.Connection) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			c.collect(tracing.WithConnectionID(ctx, conn.ID), conn, ch)
+		}(*conn)
+	}
+	wg.Wait()
+}
+
+func (c *ConnectionCollector) collect(ctx context.Context, conn connection.Connection, ch chan<- prometheus.Metric) {
+	labels := []string{conn.ID.String(), conn.Name, string(conn.Driver)}
+
+	gateway, err := c.gateways.ForDriver(conn.Driver)
+	if err != nil {
+		slog.WarnContext(ctx, "connection collector: resolving gateway", "error", err)
+		return
+	}
+
+	password, err := c.crypto.Decrypt(conn.Password)
+	if err != nil {
+		slog.WarnContext(ctx, "connection collector: decrypting password", "error", err)
+		return
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, connectionScrapeTimeout)
+	defer cancel()
+
+	start := time.Now()
+	if err := gateway.Ping(timedCtx, conn, password); err != nil {
+		ch <- prometheus.MustNewConstMetric(connectionUpDesc, prometheus.GaugeValue, 0, labels...)
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(connectionUpDesc, prometheus.GaugeValue, 1, labels...)
+	ch <- prometheus.MustNewConstMetric(connectionPingDesc, prometheus.GaugeValue, time.Since(start).Seconds(), labels...)
+
+	stats, err := gateway.GetServerStats(timedCtx, conn, password)
+	if err != nil {
+		slog.WarnContext(ctx, "connection collector: reading stats", "error", err)
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(connectionSessionsDesc, prometheus.GaugeValue, float64(stats.TotalSessions), labels...)
+	ch <- prometheus.MustNewConstMetric(connectionMaxDesc, prometheus.GaugeValue, float64(stats.MaxConnections), labels...)
+}
@@ -0,0 +1,369 @@
+package telemetry
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentFactory wraps every Gateway returned by the factory so each call is counted, timed
+// per driver and operation and traced as a span.
+func InstrumentFactory(factory connection.GatewayFactory, metrics *Metrics) connection.GatewayFactory {
+	return &instrumentedFactory{next: factory, metrics: metrics}
+}
+
+type instrumentedFactory struct {
+	next    connection.GatewayFactory
+	metrics *Metrics
+}
+
+func (f *instrumentedFactory) ForDriver(driver connection.Driver) (connection.Gateway, error) {
+	gw, err := f.next.ForDriver(driver)
+	if err != nil {
+		return nil, err
+	}
+	return &instrumentedGateway{next: gw, driver: string(driver), metrics: f.metrics}, nil
+}
+
+// instrumentedGateway decorates a Gateway; new Gateway methods must be added here as well.
+type instrumentedGateway struct {
+	next    connection.Gateway
+	driver  string
+	metrics *Metrics
+}
+
+var _ connection.Gateway = (*instrumentedGateway)(nil)
+
+// start opens the span of a gateway call; done records the call metrics and closes the span.
+func (g *instrumentedGateway) start(ctx context.Context, operation string, conn connection.Connection) (context.Context, func(error)) {
+	begin := time.Now()
+	ctx, span := tracing.Tracer().Start(ctx, "gateway."+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("mesa.driver", g.driver),
+			attribute.String("mesa.connection_id", conn.ID.String()),
+			attribute.String("server.address", conn.Host),
+			attribute.Int("server.port", conn.Port),
+		),
+	)
+	return ctx, func(err error) {
+		g.metrics.observeGateway(g.driver, operation, time.Since(begin), err)
+		tracing.End(span, err)
+	}
+}
+
+func (g *instrumentedGateway) GetDatabases(ctx context.Context, conn connection.Connection, password string) (result []connection.Database, err error) {
+	ctx, done := g.start(ctx, "GetDatabases", conn)
+	defer func() { done(err) }()
+	return g.next.GetDatabases(ctx, conn, password)
+}
+
+func (g *instrumentedGateway) GetTables(ctx context.Context, conn connection.Connection, password string, dbName connection.Identifier) (result []connection.Table, err error) {
+	ctx, done := g.start(ctx, "GetTables", conn)
+	defer func() { done(err) }()
+	return g.next.GetTables(ctx, conn, password, dbName)
+}
+
+func (g *instrumentedGateway) GetColumns(ctx context.Context, conn connection.Connection, password string, dbName, tableName connection.Identifier) (result []connection.Column, err error) {
+	ctx, done := g.start(ctx, "GetColumns", conn)
+	defer func() { done(err) }()
+	return g.next.GetColumns(ctx, conn, password, dbName, tableName)
+}
+
+func (g *instrumentedGateway) GetIndexes(ctx context.Context, conn connection.Connection, password string, dbName, tableName connection.Identifier) (result []connection.Index, err error) {
+	ctx, done := g.start(ctx, "GetIndexes", conn)
+	defer func() { done(err) }()
+	return g.next.GetIndexes(ctx, conn, password, dbName, tableName)
+}
+
+func (g *instrumentedGateway) QueryTableRows(ctx context.Context, conn connection.Connection, password string, dbName, tableName connection.Identifier, limit, offset int, sortBy *connection.Identifier, sortOrder string) (result *connection.TableRows, err error) {
+	ctx, done := g.start(ctx, "QueryTableRows", conn)
+	defer func() { done(err) }()
+	return g.next.QueryTableRows(ctx, conn, password, dbName, tableName, limit, offset, sortBy, sortOrder)
+}
+
+func (g *instrumentedGateway) GetObjectDDL(ctx context.Context, conn connection.Connection, password string, dbName connection.Identifier, kind connection.ObjectKind, schema, name connection.Identifier) (result *connection.ObjectDDL, err error) {
+	ctx, done := g.start(ctx, "GetObjectDDL", conn)
+	defer func() { done(err) }()
+	return g.next.GetObjectDDL(ctx, conn, password, dbName, kind, schema, name)
+}
+
+func (g *instrumentedGateway) GetSchemaDDL(ctx context.Context, conn connection.Connection, password string, dbName, schema connection.Identifier) (result *connection.SchemaDDL, err error) {
+	ctx, done := g.start(ctx, "GetSchemaDDL", conn)
+	defer func() { done(err) }()
+	return g.next.GetSchemaDDL(ctx, conn, password, dbName, schema)
+}
+
+func (g *instrumentedGateway) GetViews(ctx context.Context, conn connection.Connection, password string, dbName, schema connection.Identifier) (result []connection.View, err error) {
+	ctx, done := g.start(ctx, "GetViews", conn)
+	defer func() { done(err) }()
+	return g.next.GetViews(ctx, conn, password, dbName, schema)
+}
+
+func (g *instrumentedGateway) GetMaterializedViews(ctx context.Context, conn connection.Connection, password string, dbName, schema connection.Identifier) (result []connection.MaterializedView, err error) {
+	ctx, done := g.start(ctx, "GetMaterializedViews", conn)
+	defer func() { done(err) }()
+	return g.next.GetMaterializedViews(ctx, conn, password, dbName, schema)
+}
+
+func (g *instrumentedGateway) GetFunctions(ctx context.Context, conn connection.Connection, password string, dbName, schema connection.Identifier) (result []connection.Function, err error) {
+	ctx, done := g.start(ctx, "GetFunctions", conn)
+	defer func() { done(err) }()
+	return g.next.GetFunctions(ctx, conn, password, dbName, schema)
+}
+
+func (g *instrumentedGateway) GetTriggers(ctx context.Context, conn connection.Connection, password string, dbName, schema, tableName connection.Identifier) (result []connection.Trigger, err error) {
+	ctx, done := g.start(ctx, "GetTriggers", conn)
+	defer func() { done(err) }()
+	return g.next.GetTriggers(ctx, conn, password, dbName, schema, tableName)
+}
+
+func (g *instrumentedGateway) GetSequences(ctx context.Context, conn connection.Connection, password string, dbName, schema connection.Identifier) (result []connection.Sequence, err error) {
+	ctx, done := g.start(ctx, "GetSequences", conn)
+	defer func() { done(err) }()
+	return g.next.GetSequences(ctx, conn, password, dbName, schema)
+}
+
+func (g *instrumentedGateway) GetTypes(ctx context.Context, conn connection.Connection, password string, dbName, schema connection.Identifier) (result []connection.UserType, err error) {
+	ctx, done := g.start(ctx, "GetTypes", conn)
+	defer func() { done(err) }()
+	return g.next.GetTypes(ctx, conn, password, dbName, schema)
+}
+
+func (g *instrumentedGateway) GetTableHealth(ctx context.Context, conn connection.Connection, password string, dbName, schema, tableName connection.Identifier) (result *connection.TableHealth, err error) {
+	ctx, done := g.start(ctx, "GetTableHealth", conn)
+	defer func() { done(err) }()
+	return g.next.GetTableHealth(ctx, conn, password, dbName, schema, tableName)
+}
+
+func (g *instrumentedGateway) Explain(ctx context.Context, conn connection.Connection, password string, dbName connection.Identifier, statement string, opts connection.ExplainOptions) (result *connection.QueryPlan, err error) {
+	ctx, done := g.start(ctx, "Explain", conn)
+	defer func() { done(err) }()
+	return g.next.Explain(ctx, conn, password, dbName, statement, opts)
+}
+
+func (g *instrumentedGateway) GetSettings(ctx context.Context, conn connection.Connection, password string) (result []connection.Setting, err error) {
+	ctx, done := g.start(ctx, "GetSettings", conn)
+	defer func() { done(err) }()
+	return g.next.GetSettings(ctx, conn, password)
+}
+
+func (g *instrumentedGateway) ListPrivileges(ctx context.Context, conn connection.Connection, password string, dbName connection.Identifier, filter connection.PrivilegeFilter) (result *connection.DatabasePrivileges, err error) {
+	ctx, done := g.start(ctx, "ListPrivileges", conn)
+	defer func() { done(err) }()
+	return g.next.ListPrivileges(ctx, conn, password, dbName, filter)
+}
+
+func (g *instrumentedGateway) GetEffectivePrivileges(ctx context.Context, conn connection.Connection, password string, dbName, role connection.Identifier, schema *connection.Identifier) (result *connection.EffectivePrivileges, err error) {
+	ctx, done := g.start(ctx, "GetEffectivePrivileges", conn)
+	defer func() { done(err) }()
+	return g.next.GetEffectivePrivileges(ctx, conn, password, dbName, role, schema)
+}
+
+func (g *instrumentedGateway) Ping(ctx context.Context, conn connection.Connection, password string) (err error) {
+	ctx, done := g.start(ctx, "Ping", conn)
+	defer func() { done(err) }()
+	return g.next.Ping(ctx, conn, password)
+}
+
+func (g *instrumentedGateway) GetServerHealth(ctx context.Context, conn connection.Connection, password string) (result *connection.ServerHealth, err error) {
+	ctx, done := g.start(ctx, "GetServerHealth", conn)
+	defer func() { done(err) }()
+	return g.next.GetServerHealth(ctx, conn, password)
+}
+
+func (g *instrumentedGateway) GetServerStats(ctx context.Context, conn connection.Connection, password string) (result *connection.ServerStats, err error) {
+	ctx, done := g.start(ctx, "GetServerStats", conn)
+	defer func() { done(err) }()
+	return g.next.GetServerStats(ctx, conn, password)
+}
+
+func (g *instrumentedGateway) ListSessions(ctx context.Context, conn connection.Connection, password string, filter connection.SessionFilter, limit, offset int) (result *connection.SessionPage, err error) {
+	ctx, done := g.start(ctx, "ListSessions", conn)
+	defer func() { done(err) }()
+	return g.next.ListSessions(ctx, conn, password, filter, limit, offset)
+}
+
+func (g *instrumentedGateway) GetTopStatements(ctx context.Context, conn connection.Connection, password string, orderBy connection.StatementOrder, limit int) (result []connection.StatementStats, err error) {
+	ctx, done := g.start(ctx, "GetTopStatements", conn)
+	defer func() { done(err) }()
+	return g.next.GetTopStatements(ctx, conn, password, orderBy, limit)
+}
+
+func (g *instrumentedGateway) GetLockWaits(ctx context.Context, conn connection.Connection, password string) (result []connection.LockWait, err error) {
+	ctx, done := g.start(ctx, "GetLockWaits", conn)
+	defer func() { done(err) }()
+	return g.next.GetLockWaits(ctx, conn, password)
+}
+
+func (g *instrumentedGateway) GetReplicaStatus(ctx context.Context, conn connection.Connection, password string) (result *connection.ReplicaStatus, err error) {
+	ctx, done := g.start(ctx, "GetReplicaStatus", conn)
+	defer func() { done(err) }()
+	return g.next.GetReplicaStatus(ctx, conn, password)
+}
+
+func (g *instrumentedGateway) ListReplicationClients(ctx context.Context, conn connection.Connection, password string) (result []connection.ReplicationClient, err error) {
+	ctx, done := g.start(ctx, "ListReplicationClients", conn)
+	defer func() { done(err) }()
+	return g.next.ListReplicationClients(ctx, conn, password)
+}
+
+func (g *instrumentedGateway) ListReplicationSlots(ctx context.Context, conn connection.Connection, password string) (result []connection.ReplicationSlot, err error) {
+	ctx, done := g.start(ctx, "ListReplicationSlots", conn)
+	defer func() { done(err) }()
+	return g.next.ListReplicationSlots(ctx, conn, password)
+}
+
+func (g *instrumentedGateway) KillSession(ctx context.Context, conn connection.Connection, password string, pid int) (err error) {
+	ctx, done := g.start(ctx, "KillSession", conn)
+	defer func() { done(err) }()
+	return g.next.KillSession(ctx, conn, password, pid)
+}
+
+func (g *instrumentedGateway) CancelSession(ctx context.Context, conn connection.Connection, password string, pid int) (err error) {
+	ctx, done := g.start(ctx, "CancelSession", conn)
+	defer func() { done(err) }()
+	return g.next.CancelSession(ctx, conn, password, pid)
+}
+
+func (g *instrumentedGateway) SignalSessions(ctx context.Context, conn connection.Connection, password string, filter connection.SessionFilter, signal connection.SessionSignal) (result []connection.SignalResult, err error) {
+	ctx, done := g.start(ctx, "SignalSessions", conn)
+	defer func() { done(err) }()
+	return g.next.SignalSessions(ctx, conn, password, filter, signal)
+}
+
+func (g *instrumentedGateway) ResetStatementStats(ctx context.Context, conn connection.Connection, password string) (err error) {
+	ctx, done := g.start(ctx, "ResetStatementStats", conn)
+	defer func() { done(err) }()
+	return g.next.ResetStatementStats(ctx, conn, password)
+}
+
+func (g *instrumentedGateway) AlterSystem(ctx context.Context, conn connection.Connection, password string, changes []connection.SettingChange) (result []connection.Setting, err error) {
+	ctx, done := g.start(ctx, "AlterSystem", conn)
+	defer func() { done(err) }()
+	return g.next.AlterSystem(ctx, conn, password, changes)
+}
+
+func (g *instrumentedGateway) ListUsers(ctx context.Context, conn connection.Connection, password string) (result []connection.DBUser, err error) {
+	ctx, done := g.start(ctx, "ListUsers", conn)
+	defer func() { done(err) }()
+	return g.next.ListUsers(ctx, conn, password)
+}
+
+func (g *instrumentedGateway) CreateUser(ctx context.Context, conn connection.Connection, password string, user connection.DBUser, secret string) (err error) {
+	ctx, done := g.start(ctx, "CreateUser", conn)
+	defer func() { done(err) }()
+	return g.next.CreateUser(ctx, conn, password, user, secret)
+}
+
+func (g *instrumentedGateway) AlterUser(ctx context.Context, conn connection.Connection, password string, username connection.Identifier, changes connection.RoleChanges) (err error) {
+	ctx, done := g.start(ctx, "AlterUser", conn)
+	defer func() { done(err) }()
+	return g.next.AlterUser(ctx, conn, password, username, changes)
+}
+
+func (g *instrumentedGateway) DropUser(ctx context.Context, conn connection.Connection, password string, username connection.Identifier, opts connection.DropRoleOptions) (err error) {
+	ctx, done := g.start(ctx, "DropUser", conn)
+	defer func() { done(err) }()
+	return g.next.DropUser(ctx, conn, password, username, opts)
+}
+
+func (g *instrumentedGateway) GrantRole(ctx context.Context, conn connection.Connection, password string, role, member connection.Identifier, withAdmin bool) (err error) {
+	ctx, done := g.start(ctx, "GrantRole", conn)
+	defer func() { done(err) }()
+	return g.next.GrantRole(ctx, conn, password, role, member, withAdmin)
+}
+
+func (g *instrumentedGateway) RevokeRole(ctx context.Context, conn connection.Connection, password string, role, member connection.Identifier) (err error) {
+	ctx, done := g.start(ctx, "RevokeRole", conn)
+	defer func() { done(err) }()
+	return g.next.RevokeRole(ctx, conn, password, role, member)
+}
+
+func (g *instrumentedGateway) GrantPrivileges(ctx context.Context, conn connection.Connection, password string, dbName connection.Identifier, change connection.PrivilegeChange) (err error) {
+	ctx, done := g.start(ctx, "GrantPrivileges", conn)
+	defer func() { done(err) }()
+	return g.next.GrantPrivileges(ctx, conn, password, dbName, change)
+}
+
+func (g *instrumentedGateway) RevokePrivileges(ctx context.Context, conn connection.Connection, password string, dbName connection.Identifier, change connection.PrivilegeChange) (err error) {
+	ctx, done := g.start(ctx, "RevokePrivileges", conn)
+	defer func() { done(err) }()
+	return g.next.RevokePrivileges(ctx, conn, password, dbName, change)
+}
+
+func (g *instrumentedGateway) CreateDatabase(ctx context.Context, conn connection.Connection, password string, dbName connection.Identifier, opts connection.DatabaseOptions) (err error) {
+	ctx, done := g.start(ctx, "CreateDatabase", conn)
+	defer func() { done(err) }()
+	return g.next.CreateDatabase(ctx, conn, password, dbName, opts)
+}
+
+func (g *instrumentedGateway) DropDatabase(ctx context.Context, conn connection.Connection, password string, dbName connection.Identifier, force bool) (err error) {
+	ctx, done := g.start(ctx, "DropDatabase", conn)
+	defer func() { done(err) }()
+	return g.next.DropDatabase(ctx, conn, password, dbName, force)
+}
+
+func (g *instrumentedGateway) RenameDatabase(ctx context.Context, conn connection.Connection, password string, dbName, newName connection.Identifier) (err error) {
+	ctx, done := g.start(ctx, "RenameDatabase", conn)
+	defer func() { done(err) }()
+	return g.next.RenameDatabase(ctx, conn, password, dbName, newName)
+}
+
+func (g *instrumentedGateway) UpdateTableRow(ctx context.Context, conn connection.Connection, password string, dbName, tableName connection.Identifier, where, set map[connection.Identifier]any) (err error) {
+	ctx, done := g.start(ctx, "UpdateTableRow", conn)
+	defer func() { done(err) }()
+	return g.next.UpdateTableRow(ctx, conn, password, dbName, tableName, where, set)
+}
+
+func (g *instrumentedGateway) ExecuteStatement(ctx context.Context, conn connection.Connection, password string, dbName connection.Identifier, statement string, args []any, maxRows int) (result *connection.QueryResult, err error) {
+	ctx, done := g.start(ctx, "ExecuteStatement", conn)
+	defer func() { done(err) }()
+	return g.next.ExecuteStatement(ctx, conn, password, dbName, statement, args, maxRows)
+}
+
+func (g *instrumentedGateway) RunMaintenance(ctx context.Context, conn connection.Connection, password string, task connection.MaintenanceTask, observer connection.MaintenanceObserver) (err error) {
+	ctx, done := g.start(ctx, "RunMaintenance", conn)
+	defer func() { done(err) }()
+	return g.next.RunMaintenance(ctx, conn, password, task, observer)
+}
+
+func (g *instrumentedGateway) GetMaintenanceProgress(ctx context.Context, conn connection.Connection, password string, dbName connection.Identifier, pid int) (result *connection.MaintenanceProgress, err error) {
+	ctx, done := g.start(ctx, "GetMaintenanceProgress", conn)
+	defer func() { done(err) }()
+	return g.next.GetMaintenanceProgress(ctx, conn, password, dbName, pid)
+}
+
+func (g *instrumentedGateway) DumpDatabase(ctx context.Context, conn connection.Connection, password string, dbName connection.Identifier, scope connection.DumpScope, w connection.DumpWriter, observer connection.MaintenanceObserver) (err error) {
+	ctx, done := g.start(ctx, "DumpDatabase", conn)
+	defer func() { done(err) }()
+	return g.next.DumpDatabase(ctx, conn, password, dbName, scope, w, observer)
+}
+
+func (g *instrumentedGateway) RestoreDatabase(ctx context.Context, conn connection.Connection, password string, dbName connection.Identifier, dump connection.DumpReader, opts connection.RestoreOptions, observer connection.MaintenanceObserver) (err error) {
+	ctx, done := g.start(ctx, "RestoreDatabase", conn)
+	defer func() { done(err) }()
+	return g.next.RestoreDatabase(ctx, conn, password, dbName, dump, opts, observer)
+}
+
+func (g *instrumentedGateway) CreateTable(ctx context.Context, conn connection.Connection, password string, dbName connection.Identifier, def connection.TableDefinition) (err error) {
+	ctx, done := g.start(ctx, "CreateTable", conn)
+	defer func() { done(err) }()
+	return g.next.CreateTable(ctx, conn, password, dbName, def)
+}
+
+func (g *instrumentedGateway) CreateIndex(ctx context.Context, conn connection.Connection, password string, dbName, schema, tableName connection.Identifier, def connection.IndexDefinition) (err error) {
+	ctx, done := g.start(ctx, "CreateIndex", conn)
+	defer func() { done(err) }()
+	return g.next.CreateIndex(ctx, conn, password, dbName, schema, tableName, def)
+}
+
+func (g *instrumentedGateway) DropIndex(ctx context.Context, conn connection.Connection, password string, schema, indexName connection.Identifier) (err error) {
+	ctx, done := g.start(ctx, "DropIndex", conn)
+	defer func() { done(err) }()
+	return g.next.DropIndex(ctx, conn, password, schema, indexName)
+}
@@ -0,0 +1,91 @@
+// Package telemetry exposes Mesa's own Prometheus metrics and instruments the database gateways.
+package telemetry
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "mesa"
+
+// Metrics owns the registry served on /metrics.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpDuration    *prometheus.HistogramVec
+	gatewayCalls    *prometheus.CounterVec
+	gatewayErrors   *prometheus.CounterVec
+	gatewayDuration *prometheus.HistogramVec
+}
+
+func NewMetrics() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "Latency of API requests by method, route pattern and status code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		gatewayCalls: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "gateway",
+			Name:      "calls_total",
+			Help:      "Calls made to monitored databases by driver and operation.",
+		}, []string{"driver", "operation"}),
+		gatewayErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "gateway",
+			Name:      "errors_total",
+			Help:      "Failed calls to monitored databases by driver and operation.",
+		}, []string{"driver", "operation"}),
+		gatewayDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "gateway",
+			Name:      "call_duration_seconds",
+			Help:      "Duration of calls to monitored databases by driver and operation.",
+			Buckets:   []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+		}, []string{"driver", "operation"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpDuration,
+		m.gatewayCalls,
+		m.gatewayErrors,
+		m.gatewayDuration,
+	)
+
+	return m
+}
+
+// Handler serves the registry in the Prometheus text format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// MustRegister adds an extra collector, ex: the per-connection health gauges.
+func (m *Metrics) MustRegister(c prometheus.Collector) {
+	m.registry.MustRegister(c)
+}
+
+// ObserveHTTP records a finished API request. route must be the route pattern, not the raw
+// path, to keep the label cardinality bounded.
+func (m *Metrics) ObserveHTTP(method, route string, status int, elapsed time.Duration) {
+	m.httpDuration.WithLabelValues(method, route, strconv.Itoa(status)).Observe(elapsed.Seconds())
+}
+
+func (m *Metrics) observeGateway(driver, operation string, elapsed time.Duration, err error) {
+	m.gatewayCalls.WithLabelValues(driver, operation).Inc()
+	m.gatewayDuration.WithLabelValues(driver, operation).Observe(elapsed.Seconds())
+	if err != nil {
+		m.gatewayErrors.WithLabelValues(driver, operation).Inc()
+	}
+}
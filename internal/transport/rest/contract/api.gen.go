@@ -13,29 +13,49 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for AlertChannel.
+const (
+	Slack   AlertChannel = "slack"
+	Webhook AlertChannel = "webhook"
+)
+
+// Defines values for AlertCondition.
+const (
+	ConnectionUsage  AlertCondition = "connection_usage"
+	LongRunningQuery AlertCondition = "long_running_query"
+	Unreachable      AlertCondition = "unreachable"
+)
+
+// Defines values for AlertStatus.
+const (
+	AlertStatusFiring  AlertStatus = "firing"
+	AlertStatusOk      AlertStatus = "ok"
+	AlertStatusPending AlertStatus = "pending"
+)
+
 // Defines values for ColumnDataType.
 const (
-	Bigint          ColumnDataType = "bigint"
-	Bigserial       ColumnDataType = "bigserial"
-	Boolean         ColumnDataType = "boolean"
-	Bytea           ColumnDataType = "bytea"
-	Char            ColumnDataType = "char"
-	Date            ColumnDataType = "date"
-	Decimal         ColumnDataType = "decimal"
-	DoublePrecision ColumnDataType = "double_precision"
-	Integer         ColumnDataType = "integer"
-	Json            ColumnDataType = "json"
-	Jsonb           ColumnDataType = "jsonb"
-	Numeric         ColumnDataType = "numeric"
-	Real            ColumnDataType = "real"
-	Serial          ColumnDataType = "serial"
-	Smallint        ColumnDataType = "smallint"
-	Text            ColumnDataType = "text"
-	Time            ColumnDataType = "time"
-	Timestamp       ColumnDataType = "timestamp"
-	Timestamptz     ColumnDataType = "timestamptz"
-	Uuid            ColumnDataType = "uuid"
-	Varchar         ColumnDataType = "varchar"
+	ColumnDataTypeBigint          ColumnDataType = "bigint"
+	ColumnDataTypeBigserial       ColumnDataType = "bigserial"
+	ColumnDataTypeBoolean         ColumnDataType = "boolean"
+	ColumnDataTypeBytea           ColumnDataType = "bytea"
+	ColumnDataTypeChar            ColumnDataType = "char"
+	ColumnDataTypeDate            ColumnDataType = "date"
+	ColumnDataTypeDecimal         ColumnDataType = "decimal"
+	ColumnDataTypeDoublePrecision ColumnDataType = "double_precision"
+	ColumnDataTypeInteger         ColumnDataType = "integer"
+	ColumnDataTypeJson            ColumnDataType = "json"
+	ColumnDataTypeJsonb           ColumnDataType = "jsonb"
+	ColumnDataTypeNumeric         ColumnDataType = "numeric"
+	ColumnDataTypeReal            ColumnDataType = "real"
+	ColumnDataTypeSerial          ColumnDataType = "serial"
+	ColumnDataTypeSmallint        ColumnDataType = "smallint"
+	ColumnDataTypeText            ColumnDataType = "text"
+	ColumnDataTypeTime            ColumnDataType = "time"
+	ColumnDataTypeTimestamp       ColumnDataType = "timestamp"
+	ColumnDataTypeTimestamptz     ColumnDataType = "timestamptz"
+	ColumnDataTypeUuid            ColumnDataType = "uuid"
+	ColumnDataTypeVarchar         ColumnDataType = "varchar"
 )
 
 // Defines values for ConnectionDriver.
@@ -46,8 +66,22 @@ const (
 
 // Defines values for ConnectionStatus.
 const (
-	ConnectionStatusError ConnectionStatus = "error"
-	ConnectionStatusOk    ConnectionStatus = "ok"
+	ConnectionStatusError   ConnectionStatus = "error"
+	ConnectionStatusOk      ConnectionStatus = "ok"
+	ConnectionStatusUnknown ConnectionStatus = "unknown"
+)
+
+// Defines values for ConnectionEnvironment.
+const (
+	Development ConnectionEnvironment = "development"
+	Production  ConnectionEnvironment = "production"
+	Staging     ConnectionEnvironment = "staging"
+)
+
+// Defines values for ConnectionProtection.
+const (
+	Confirm ConnectionProtection = "confirm"
+	None    ConnectionProtection = "none"
 )
 
 // Defines values for CreateConnectionRequestDriver.
@@ -66,18 +100,325 @@ const (
 	Spgist CreateTableIndexMethod = "spgist"
 )
 
+// Defines values for FunctionKind.
+const (
+	FunctionKindAggregate FunctionKind = "aggregate"
+	FunctionKindFunction  FunctionKind = "function"
+	FunctionKindProcedure FunctionKind = "procedure"
+	FunctionKindWindow    FunctionKind = "window"
+)
+
+// Defines values for FunctionVolatility.
+const (
+	Immutable FunctionVolatility = "immutable"
+	Stable    FunctionVolatility = "stable"
+	Volatile  FunctionVolatility = "volatile"
+)
+
+// Defines values for HistorySource.
+const (
+	HistorySourceAdmin      HistorySource = "admin"
+	HistorySourceBrowse     HistorySource = "browse"
+	HistorySourceEdit       HistorySource = "edit"
+	HistorySourceExplain    HistorySource = "explain"
+	HistorySourceSavedQuery HistorySource = "saved_query"
+)
+
+// Defines values for JobKind.
+const (
+	JobKindAnalyze                 JobKind = "analyze"
+	JobKindBackup                  JobKind = "backup"
+	JobKindRefreshMaterializedView JobKind = "refresh_materialized_view"
+	JobKindReindex                 JobKind = "reindex"
+	JobKindRestore                 JobKind = "restore"
+	JobKindVacuum                  JobKind = "vacuum"
+)
+
+// Defines values for JobStatus.
+const (
+	Cancelled JobStatus = "cancelled"
+	Failed    JobStatus = "failed"
+	Queued    JobStatus = "queued"
+	Running   JobStatus = "running"
+	Succeeded JobStatus = "succeeded"
+)
+
+// Defines values for MaintenanceKind.
+const (
+	MaintenanceKindAnalyze                 MaintenanceKind = "analyze"
+	MaintenanceKindRefreshMaterializedView MaintenanceKind = "refresh_materialized_view"
+	MaintenanceKindReindex                 MaintenanceKind = "reindex"
+	MaintenanceKindVacuum                  MaintenanceKind = "vacuum"
+)
+
+// Defines values for MetricResolution.
+const (
+	N1h MetricResolution = "1h"
+	N5m MetricResolution = "5m"
+	Raw MetricResolution = "raw"
+)
+
+// Defines values for ObjectKind.
+const (
+	ObjectKindFunction         ObjectKind = "function"
+	ObjectKindIndex            ObjectKind = "index"
+	ObjectKindMaterializedView ObjectKind = "materialized_view"
+	ObjectKindTable            ObjectKind = "table"
+	ObjectKindTrigger          ObjectKind = "trigger"
+	ObjectKindView             ObjectKind = "view"
+)
+
 // Defines values for OverviewResponseStatus.
 const (
 	ONLINE      OverviewResponseStatus = "ONLINE"
 	UNREACHABLE OverviewResponseStatus = "UNREACHABLE"
 )
 
+// Defines values for PrivilegeObjectType.
+const (
+	PrivilegeObjectTypeDatabase PrivilegeObjectType = "database"
+	PrivilegeObjectTypeFunction PrivilegeObjectType = "function"
+	PrivilegeObjectTypeSchema   PrivilegeObjectType = "schema"
+	PrivilegeObjectTypeSequence PrivilegeObjectType = "sequence"
+	PrivilegeObjectTypeTable    PrivilegeObjectType = "table"
+)
+
+// Defines values for SSHTunnelAuth.
+const (
+	Password   SSHTunnelAuth = "password"
+	PrivateKey SSHTunnelAuth = "private_key"
+)
+
+// Defines values for SavedQueryParamType.
+const (
+	SavedQueryParamTypeBoolean   SavedQueryParamType = "boolean"
+	SavedQueryParamTypeDate      SavedQueryParamType = "date"
+	SavedQueryParamTypeInteger   SavedQueryParamType = "integer"
+	SavedQueryParamTypeNumeric   SavedQueryParamType = "numeric"
+	SavedQueryParamTypeText      SavedQueryParamType = "text"
+	SavedQueryParamTypeTimestamp SavedQueryParamType = "timestamp"
+	SavedQueryParamTypeUuid      SavedQueryParamType = "uuid"
+)
+
+// Defines values for SessionState.
+const (
+	Active                   SessionState = "active"
+	Disabled                 SessionState = "disabled"
+	FastpathFunctionCall     SessionState = "fastpath_function_call"
+	Idle                     SessionState = "idle"
+	IdleInTransaction        SessionState = "idle_in_transaction"
+	IdleInTransactionAborted SessionState = "idle_in_transaction_aborted"
+)
+
+// Defines values for SettingVartype.
+const (
+	SettingVartypeBool    SettingVartype = "bool"
+	SettingVartypeEnum    SettingVartype = "enum"
+	SettingVartypeInteger SettingVartype = "integer"
+	SettingVartypeReal    SettingVartype = "real"
+	SettingVartypeString  SettingVartype = "string"
+)
+
+// Defines values for SignalSessionsRequestAction.
+const (
+	Cancel    SignalSessionsRequestAction = "cancel"
+	Terminate SignalSessionsRequestAction = "terminate"
+)
+
+// Defines values for StatementOrder.
+const (
+	Calls          StatementOrder = "calls"
+	MeanTime       StatementOrder = "mean_time"
+	Rows           StatementOrder = "rows"
+	SharedBlksRead StatementOrder = "shared_blks_read"
+	TotalTime      StatementOrder = "total_time"
+)
+
+// Defines values for SubmitJobRequestCommand.
+const (
+	CreateBackup   SubmitJobRequestCommand = "create_backup"
+	RestoreBackup  SubmitJobRequestCommand = "restore_backup"
+	RunMaintenance SubmitJobRequestCommand = "run_maintenance"
+)
+
+// Defines values for TriggerLevel.
+const (
+	ROW       TriggerLevel = "ROW"
+	STATEMENT TriggerLevel = "STATEMENT"
+)
+
+// Defines values for TriggerTiming.
+const (
+	AFTER     TriggerTiming = "AFTER"
+	BEFORE    TriggerTiming = "BEFORE"
+	INSTEADOF TriggerTiming = "INSTEAD OF"
+)
+
+// Defines values for UserTypeKind.
+const (
+	UserTypeKindComposite UserTypeKind = "composite"
+	UserTypeKindDomain    UserTypeKind = "domain"
+	UserTypeKindEnum      UserTypeKind = "enum"
+)
+
 // Defines values for QueryTableRowsParamsSortOrder.
 const (
 	Asc  QueryTableRowsParamsSortOrder = "asc"
 	Desc QueryTableRowsParamsSortOrder = "desc"
 )
 
+// ACLEntry defines model for ACLEntry.
+type ACLEntry struct {
+	// Grantee Role name, or PUBLIC
+	Grantee    string             `json:"grantee"`
+	Grantor    string             `json:"grantor"`
+	Privileges []GrantedPrivilege `json:"privileges"`
+}
+
+// Alert defines model for Alert.
+type Alert struct {
+	// Condition connection_usage: sessions above `threshold` percent of max_connections.
+	// long_running_query: an active query running for more than `threshold` seconds.
+	// unreachable: the connection cannot be reached; `threshold` is ignored.
+	Condition           AlertCondition     `json:"condition"`
+	ConnectionId        openapi_types.UUID `json:"connection_id"`
+	ConsecutiveBreaches int                `json:"consecutive_breaches"`
+	EvaluatedAt         time.Time          `json:"evaluated_at"`
+	FiredAt             *time.Time         `json:"fired_at,omitempty"`
+	Message             string             `json:"message"`
+	ResolvedAt          *time.Time         `json:"resolved_at,omitempty"`
+	RuleId              openapi_types.UUID `json:"rule_id"`
+	RuleName            string             `json:"rule_name"`
+	Status              AlertStatus        `json:"status"`
+
+	// Value Last observed value (percent, seconds, or 1 when unreachable)
+	Value float64 `json:"value"`
+}
+
+// AlertChannel defines model for AlertChannel.
+type AlertChannel string
+
+// AlertCondition connection_usage: sessions above `threshold` percent of max_connections.
+// long_running_query: an active query running for more than `threshold` seconds.
+// unreachable: the connection cannot be reached; `threshold` is ignored.
+type AlertCondition string
+
+// AlertRule defines model for AlertRule.
+type AlertRule struct {
+	Channel AlertChannel `json:"channel"`
+
+	// Condition connection_usage: sessions above `threshold` percent of max_connections.
+	// long_running_query: an active query running for more than `threshold` seconds.
+	// unreachable: the connection cannot be reached; `threshold` is ignored.
+	Condition         AlertCondition      `json:"condition"`
+	ConnectionId      *openapi_types.UUID `json:"connection_id,omitempty"`
+	ConsecutiveChecks int                 `json:"consecutive_checks"`
+	CreatedAt         time.Time           `json:"created_at"`
+	Enabled           bool                `json:"enabled"`
+	Id                openapi_types.UUID  `json:"id"`
+	Name              string              `json:"name"`
+	Target            string              `json:"target"`
+	Threshold         float64             `json:"threshold"`
+	UpdatedAt         time.Time           `json:"updated_at"`
+}
+
+// AlertRuleRequest defines model for AlertRuleRequest.
+type AlertRuleRequest struct {
+	Channel AlertChannel `json:"channel"`
+
+	// Condition connection_usage: sessions above `threshold` percent of max_connections.
+	// long_running_query: an active query running for more than `threshold` seconds.
+	// unreachable: the connection cannot be reached; `threshold` is ignored.
+	Condition AlertCondition `json:"condition"`
+
+	// ConnectionId Restricts the rule to one connection. Omit to watch every connection.
+	ConnectionId *openapi_types.UUID `json:"connection_id,omitempty"`
+
+	// ConsecutiveChecks Evaluations in a row that must breach before the alert fires
+	ConsecutiveChecks *int   `json:"consecutive_checks,omitempty"`
+	Enabled           *bool  `json:"enabled,omitempty"`
+	Name              string `json:"name"`
+
+	// Target Webhook URL that receives the notifications
+	Target    string   `json:"target"`
+	Threshold *float64 `json:"threshold,omitempty"`
+}
+
+// AlertStatus defines model for AlertStatus.
+type AlertStatus string
+
+// AlterSettingsRequest defines model for AlterSettingsRequest.
+type AlterSettingsRequest struct {
+	Changes []SettingChange `json:"changes"`
+}
+
+// AlterSettingsResponse defines model for AlterSettingsResponse.
+type AlterSettingsResponse struct {
+	// RestartRequired At least one change only applies after a server restart
+	RestartRequired bool      `json:"restart_required"`
+	Settings        []Setting `json:"settings"`
+}
+
+// AlterUserRequest Only the fields present are changed.
+type AlterUserRequest struct {
+	CanCreateDb   *bool `json:"can_create_db,omitempty"`
+	CanCreateRole *bool `json:"can_create_role,omitempty"`
+	CanLogin      *bool `json:"can_login,omitempty"`
+
+	// ClearValidUntil Makes the password valid forever.
+	ClearValidUntil *bool      `json:"clear_valid_until,omitempty"`
+	ConnLimit       *int       `json:"conn_limit,omitempty"`
+	IsSuperuser     *bool      `json:"is_superuser,omitempty"`
+	Password        *string    `json:"password,omitempty"`
+	Replication     *bool      `json:"replication,omitempty"`
+	ValidUntil      *time.Time `json:"valid_until,omitempty"`
+}
+
+// Backup defines model for Backup.
+type Backup struct {
+	// Checksum Hex-encoded SHA-256 of the archive.
+	Checksum  string             `json:"checksum"`
+	CreatedAt time.Time          `json:"created_at"`
+	Database  string             `json:"database"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// Size Size of the compressed archive in bytes.
+	Size int64 `json:"size"`
+
+	// Tables Dumped tables as schema.table; empty for a whole-database backup.
+	Tables []string `json:"tables"`
+}
+
+// BlockingNode defines model for BlockingNode.
+type BlockingNode struct {
+	ApplicationName string         `json:"application_name"`
+	Blocked         []BlockingNode `json:"blocked"`
+
+	// BlockedBy Every session the wait depends on, holding the lock or ahead of it in the queue.
+	// The node appears only under one of them, the one holding the lock when there is one.
+	BlockedBy []int   `json:"blocked_by"`
+	Database  string  `json:"database"`
+	LockMode  *string `json:"lock_mode,omitempty"`
+
+	// LockType Type of the lock being waited for
+	LockType                   *string `json:"lock_type,omitempty"`
+	Pid                        int     `json:"pid"`
+	Query                      string  `json:"query"`
+	Relation                   *string `json:"relation,omitempty"`
+	State                      string  `json:"state"`
+	TransactionDurationSeconds float64 `json:"transaction_duration_seconds"`
+	User                       string  `json:"user"`
+	WaitDurationSeconds        float64 `json:"wait_duration_seconds"`
+	WaitEvent                  *string `json:"wait_event,omitempty"`
+	WaitEventType              *string `json:"wait_event_type,omitempty"`
+}
+
+// BlockingTree defines model for BlockingTree.
+type BlockingTree struct {
+	Roots        []BlockingNode `json:"roots"`
+	WaitingCount int            `json:"waiting_count"`
+}
+
 // Column defines model for Column.
 type Column struct {
 	DefaultValue *string `json:"default_value,omitempty"`
@@ -92,43 +433,109 @@ type ColumnDataType string
 
 // Connection defines model for Connection.
 type Connection struct {
-	CreatedAt *time.Time       `json:"createdAt,omitempty"`
-	Driver    ConnectionDriver `json:"driver"`
-	Host      string           `json:"host"`
-	Id        string           `json:"id"`
-	Name      string           `json:"name"`
+	Color       *string          `json:"color,omitempty"`
+	CreatedAt   *time.Time       `json:"createdAt,omitempty"`
+	Description *string          `json:"description,omitempty"`
+	Driver      ConnectionDriver `json:"driver"`
+
+	// Environment Defaults to development.
+	Environment   *ConnectionEnvironment `json:"environment,omitempty"`
+	Host          string                 `json:"host"`
+	Id            string                 `json:"id"`
+	LastSuccessAt *time.Time             `json:"last_success_at,omitempty"`
+	LatencyMs     *int64                 `json:"latency_ms,omitempty"`
+	Name          string                 `json:"name"`
 
 	// Port A port value between 0 and 65535
-	Port        int               `json:"port"`
-	Status      *ConnectionStatus `json:"status,omitempty"`
-	StatusError *string           `json:"status_error,omitempty"`
-	UpdatedAt   *time.Time        `json:"updatedAt,omitempty"`
-	Username    string            `json:"username"`
+	Port int `json:"port"`
+
+	// Protection With confirm, every command that changes the server must carry the connection name in
+	// the X-Confirm-Connection header. Defaults to confirm for production connections and to
+	// none otherwise.
+	Protection *ConnectionProtection `json:"protection,omitempty"`
+	ReadOnly   *bool                 `json:"read_only,omitempty"`
+
+	// SshTunnel The SSH bastion the sessions are dialed through; the secrets are never returned.
+	SshTunnel *SSHTunnel `json:"ssh_tunnel,omitempty"`
+
+	// Status Result of the last background health check; unknown until the first check.
+	Status          *ConnectionStatus `json:"status,omitempty"`
+	StatusCheckedAt *time.Time        `json:"status_checked_at,omitempty"`
+	StatusError     *string           `json:"status_error,omitempty"`
+	Tags            *[]string         `json:"tags,omitempty"`
+	UpdatedAt       *time.Time        `json:"updatedAt,omitempty"`
+	Username        string            `json:"username"`
 }
 
 // ConnectionDriver defines model for Connection.Driver.
 type ConnectionDriver string
 
-// ConnectionStatus defines model for Connection.Status.
+// ConnectionStatus Result of the last background health check; unknown until the first check.
 type ConnectionStatus string
 
+// ConnectionEnvironment Defaults to development.
+type ConnectionEnvironment string
+
+// ConnectionProtection With confirm, every command that changes the server must carry the connection name in
+// the X-Confirm-Connection header. Defaults to confirm for production connections and to
+// none otherwise.
+type ConnectionProtection string
+
+// ConnectionReadOnly Sessions start with default_transaction_read_only = on, so the server rejects writes,
+// and every command that changes the server answers 403.
+type ConnectionReadOnly = bool
+
+// CreateBackupRequest defines model for CreateBackupRequest.
+type CreateBackupRequest struct {
+	// Tables Tables to dump, as schema.table or table (public schema). Omit to dump the whole database.
+	Tables *[]string `json:"tables,omitempty"`
+}
+
 // CreateConnectionRequest defines model for CreateConnectionRequest.
 type CreateConnectionRequest struct {
-	Driver   CreateConnectionRequestDriver `json:"driver"`
-	Host     string                        `json:"host"`
-	Name     string                        `json:"name"`
-	Password string                        `json:"password"`
-	Port     int                           `json:"port"`
-	Username string                        `json:"username"`
+	// Color Hex color such as #d73a49
+	Color       *string                       `json:"color,omitempty"`
+	Description *string                       `json:"description,omitempty"`
+	Driver      CreateConnectionRequestDriver `json:"driver"`
+
+	// Environment Defaults to development.
+	Environment *ConnectionEnvironment `json:"environment,omitempty"`
+	Host        string                 `json:"host"`
+	Name        string                 `json:"name"`
+	Password    string                 `json:"password"`
+	Port        int                    `json:"port"`
+
+	// Protection With confirm, every command that changes the server must carry the connection name in
+	// the X-Confirm-Connection header. Defaults to confirm for production connections and to
+	// none otherwise.
+	Protection *ConnectionProtection `json:"protection,omitempty"`
+
+	// ReadOnly Sessions start with default_transaction_read_only = on, so the server rejects writes,
+	// and every command that changes the server answers 403.
+	ReadOnly *ConnectionReadOnly `json:"read_only,omitempty"`
+
+	// SshTunnel Authenticates with private_key when given, with password otherwise.
+	SshTunnel *SSHTunnelRequest `json:"ssh_tunnel,omitempty"`
+	Tags      *[]string         `json:"tags,omitempty"`
+	Username  string            `json:"username"`
 }
 
 // CreateConnectionRequestDriver defines model for CreateConnectionRequest.Driver.
 type CreateConnectionRequestDriver string
 
-// CreateDatabaseRequest defines model for CreateDatabaseRequest.
+// CreateDatabaseRequest Omitted options come from the template. An encoding or locale different from the template's requires template0.
 type CreateDatabaseRequest struct {
-	Name  string `json:"name"`
-	Owner string `json:"owner"`
+	ConnLimit *int    `json:"conn_limit,omitempty"`
+	Encoding  *string `json:"encoding,omitempty"`
+	LcCollate *string `json:"lc_collate,omitempty"`
+	LcCtype   *string `json:"lc_ctype,omitempty"`
+
+	// Locale Sets both lc_collate and lc_ctype
+	Locale     *string `json:"locale,omitempty"`
+	Name       string  `json:"name"`
+	Owner      string  `json:"owner"`
+	Tablespace *string `json:"tablespace,omitempty"`
+	Template   *string `json:"template,omitempty"`
 }
 
 // CreateTableColumn defines model for CreateTableColumn.
@@ -162,27 +569,80 @@ type CreateTableRequest struct {
 
 // CreateUserRequest defines model for CreateUserRequest.
 type CreateUserRequest struct {
-	CanLogin    *bool  `json:"can_login,omitempty"`
-	ConnLimit   *int   `json:"conn_limit,omitempty"`
-	IsSuperuser *bool  `json:"is_superuser,omitempty"`
-	Password    string `json:"password"`
-	Username    string `json:"username"`
+	CanCreateDb   *bool      `json:"can_create_db,omitempty"`
+	CanCreateRole *bool      `json:"can_create_role,omitempty"`
+	CanLogin      *bool      `json:"can_login,omitempty"`
+	ConnLimit     *int       `json:"conn_limit,omitempty"`
+	IsSuperuser   *bool      `json:"is_superuser,omitempty"`
+	Password      string     `json:"password"`
+	Replication   *bool      `json:"replication,omitempty"`
+	Username      string     `json:"username"`
+	ValidUntil    *time.Time `json:"valid_until,omitempty"`
 }
 
 // DBUser defines model for DBUser.
 type DBUser struct {
-	CanLogin    bool   `json:"can_login"`
-	ConnLimit   int    `json:"conn_limit"`
-	IsSuperuser bool   `json:"is_superuser"`
-	Name        string `json:"name"`
+	CanCreateDb   bool             `json:"can_create_db"`
+	CanCreateRole bool             `json:"can_create_role"`
+	CanLogin      bool             `json:"can_login"`
+	ConnLimit     int              `json:"conn_limit"`
+	IsSuperuser   bool             `json:"is_superuser"`
+	MemberOf      []RoleMembership `json:"member_of"`
+	Name          string           `json:"name"`
+	Replication   bool             `json:"replication"`
+
+	// ValidUntil Password expiry; absent when the password never expires.
+	ValidUntil *time.Time `json:"valid_until,omitempty"`
 }
 
 // Database defines model for Database.
 type Database struct {
-	Encoding      string `json:"encoding"`
-	Name          string `json:"name"`
-	Owner         string `json:"owner"`
-	SizeFormatted string `json:"size_formatted"`
+	Collation string `json:"collation"`
+	Commits   int64  `json:"commits"`
+
+	// ConnLimit -1 means unlimited
+	ConnLimit   int    `json:"conn_limit"`
+	Connections int    `json:"connections"`
+	Ctype       string `json:"ctype"`
+	Encoding    string `json:"encoding"`
+
+	// LastActivity Latest state change among the connected sessions; absent without sessions
+	LastActivity  *time.Time `json:"last_activity,omitempty"`
+	Name          string     `json:"name"`
+	Owner         string     `json:"owner"`
+	Rollbacks     int64      `json:"rollbacks"`
+	Size          int64      `json:"size"`
+	SizeFormatted string     `json:"size_formatted"`
+
+	// TableCount Absent when the database could not be inspected
+	TableCount *int   `json:"table_count,omitempty"`
+	Tablespace string `json:"tablespace"`
+}
+
+// DatabasePrivileges defines model for DatabasePrivileges.
+type DatabasePrivileges struct {
+	Defaults []DefaultPrivileges `json:"defaults"`
+	Objects  []ObjectPrivileges  `json:"objects"`
+}
+
+// DefaultPrivileges defines model for DefaultPrivileges.
+type DefaultPrivileges struct {
+	Acl        []ACLEntry          `json:"acl"`
+	ObjectType PrivilegeObjectType `json:"object_type"`
+
+	// Role Role whose future objects receive the ACL
+	Role string `json:"role"`
+
+	// Schema Absent when the entry applies to every schema
+	Schema *string `json:"schema,omitempty"`
+}
+
+// EffectivePrivileges defines model for EffectivePrivileges.
+type EffectivePrivileges struct {
+	// Privileges Matrix columns
+	Privileges []string      `json:"privileges"`
+	Role       string        `json:"role"`
+	Tables     []TableAccess `json:"tables"`
 }
 
 // Error defines model for Error.
@@ -190,6 +650,91 @@ type Error struct {
 	Message string `json:"message"`
 }
 
+// ExecuteSavedQueryRequest defines model for ExecuteSavedQueryRequest.
+type ExecuteSavedQueryRequest struct {
+	ConnectionId openapi_types.UUID `json:"connection_id"`
+	Database     string             `json:"database"`
+	MaxRows      *int               `json:"max_rows,omitempty"`
+
+	// Params Values by parameter name. null binds SQL NULL.
+	Params *map[string]interface{} `json:"params,omitempty"`
+}
+
+// ExplainRequest defines model for ExplainRequest.
+type ExplainRequest struct {
+	Analyze *bool  `json:"analyze,omitempty"`
+	Buffers *bool  `json:"buffers,omitempty"`
+	Query   string `json:"query"`
+	Verbose *bool  `json:"verbose,omitempty"`
+}
+
+// ExplainResponse defines model for ExplainResponse.
+type ExplainResponse struct {
+	Analyzed            bool     `json:"analyzed"`
+	ExecutionTimeMs     *float64 `json:"execution_time_ms,omitempty"`
+	MisestimatedNodeIds []int    `json:"misestimated_node_ids"`
+	Plan                PlanNode `json:"plan"`
+	PlanningTimeMs      float64  `json:"planning_time_ms"`
+
+	// Raw EXPLAIN output as returned by the server
+	Raw            string `json:"raw"`
+	SlowestNodeIds []int  `json:"slowest_node_ids"`
+}
+
+// Function defines model for Function.
+type Function struct {
+	Arguments       string             `json:"arguments"`
+	Kind            FunctionKind       `json:"kind"`
+	Language        string             `json:"language"`
+	Name            string             `json:"name"`
+	Owner           string             `json:"owner"`
+	ReturnType      string             `json:"return_type"`
+	Schema          string             `json:"schema"`
+	SecurityDefiner bool               `json:"security_definer"`
+	Signature       string             `json:"signature"`
+	Volatility      FunctionVolatility `json:"volatility"`
+}
+
+// FunctionKind defines model for Function.Kind.
+type FunctionKind string
+
+// FunctionVolatility defines model for Function.Volatility.
+type FunctionVolatility string
+
+// GrantRoleRequest defines model for GrantRoleRequest.
+type GrantRoleRequest struct {
+	Role      string `json:"role"`
+	WithAdmin *bool  `json:"with_admin,omitempty"`
+}
+
+// GrantedPrivilege defines model for GrantedPrivilege.
+type GrantedPrivilege struct {
+	// Grantable Granted WITH GRANT OPTION
+	Grantable bool   `json:"grantable"`
+	Privilege string `json:"privilege"`
+}
+
+// HistoryEntry defines model for HistoryEntry.
+type HistoryEntry struct {
+	ConnectionId openapi_types.UUID `json:"connection_id"`
+	Database     string             `json:"database"`
+	DurationMs   float64            `json:"duration_ms"`
+	Error        *string            `json:"error,omitempty"`
+	ExecutedAt   time.Time          `json:"executed_at"`
+	Id           openapi_types.UUID `json:"id"`
+
+	// RowsAffected Set for statements that change rows
+	RowsAffected *int64 `json:"rows_affected,omitempty"`
+
+	// RowsReturned Set for statements that return rows
+	RowsReturned *int64        `json:"rows_returned,omitempty"`
+	Source       HistorySource `json:"source"`
+	Statement    string        `json:"statement"`
+}
+
+// HistorySource defines model for HistorySource.
+type HistorySource string
+
 // Index defines model for Index.
 type Index struct {
 	Columns []string `json:"columns"`
@@ -199,6 +744,120 @@ type Index struct {
 	Unique  bool     `json:"unique"`
 }
 
+// Job defines model for Job.
+type Job struct {
+	ConnectionId openapi_types.UUID `json:"connection_id"`
+	CreatedAt    time.Time          `json:"created_at"`
+	Database     string             `json:"database"`
+	Error        *string            `json:"error,omitempty"`
+	FinishedAt   *time.Time         `json:"finished_at,omitempty"`
+	Id           openapi_types.UUID `json:"id"`
+	Kind         JobKind            `json:"kind"`
+	Log          []string           `json:"log"`
+	Phase        string             `json:"phase"`
+
+	// Progress Completed percentage, absent while the command cannot estimate it.
+	Progress *float64 `json:"progress,omitempty"`
+
+	// Result What the job produced, ex the backup ID of a backup.
+	Result    *string    `json:"result,omitempty"`
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	Status    JobStatus  `json:"status"`
+
+	// Target The relation or database of a maintenance command, the tables of a backup or the backup ID of a restore.
+	Target string `json:"target"`
+}
+
+// JobKind defines model for JobKind.
+type JobKind string
+
+// JobStatus defines model for JobStatus.
+type JobStatus string
+
+// MaintenanceKind defines model for MaintenanceKind.
+type MaintenanceKind string
+
+// MaintenanceRequest defines model for MaintenanceRequest.
+type MaintenanceRequest struct {
+	Analyze      *bool           `json:"analyze,omitempty"`
+	Concurrently *bool           `json:"concurrently,omitempty"`
+	Full         *bool           `json:"full,omitempty"`
+	Kind         MaintenanceKind `json:"kind"`
+	Schema       *string         `json:"schema,omitempty"`
+
+	// Target Table or materialized view name. Omit to run against the whole database.
+	Target  *string `json:"target,omitempty"`
+	Verbose *bool   `json:"verbose,omitempty"`
+}
+
+// MaterializedView defines model for MaterializedView.
+type MaterializedView struct {
+	Comment *string `json:"comment,omitempty"`
+
+	// DataFileModifiedAt Modification time of the view's data file. This is not the last REFRESH time, only an upper bound for it: VACUUM, hint-bit writes and checkpoints also write the file, so the last REFRESH may be much older. Do not label it as "last refreshed". Absent when the role may not call pg_stat_file.
+	DataFileModifiedAt *time.Time `json:"data_file_modified_at,omitempty"`
+	Name               string     `json:"name"`
+	Owner              string     `json:"owner"`
+	Populated          bool       `json:"populated"`
+	Schema             string     `json:"schema"`
+	Size               int64      `json:"size"`
+}
+
+// MetricResolution defines model for MetricResolution.
+type MetricResolution string
+
+// MetricSample defines model for MetricSample.
+type MetricSample struct {
+	ActiveSessions int `json:"active_sessions"`
+
+	// CacheHitRatio Buffer cache hit ratio over the period (0-1)
+	CacheHitRatio float64 `json:"cache_hit_ratio"`
+
+	// DatabaseSizes Size in bytes of each database
+	DatabaseSizes map[string]int64 `json:"database_sizes"`
+
+	// Deadlocks Deadlocks detected during the period
+	Deadlocks      int64 `json:"deadlocks"`
+	MaxConnections int   `json:"max_connections"`
+
+	// TempBytes Bytes written to temporary files during the period
+	TempBytes     int64     `json:"temp_bytes"`
+	Time          time.Time `json:"time"`
+	TotalSessions int       `json:"total_sessions"`
+
+	// Tps Committed and rolled back transactions per second
+	Tps float64 `json:"tps"`
+}
+
+// MetricSeries defines model for MetricSeries.
+type MetricSeries struct {
+	Resolution MetricResolution `json:"resolution"`
+	Samples    []MetricSample   `json:"samples"`
+}
+
+// ObjectDDL defines model for ObjectDDL.
+type ObjectDDL struct {
+	Ddl    string     `json:"ddl"`
+	Kind   ObjectKind `json:"kind"`
+	Name   string     `json:"name"`
+	Schema string     `json:"schema"`
+}
+
+// ObjectKind defines model for ObjectKind.
+type ObjectKind string
+
+// ObjectPrivileges defines model for ObjectPrivileges.
+type ObjectPrivileges struct {
+	Acl []ACLEntry `json:"acl"`
+
+	// Arguments Identity arguments, functions only
+	Arguments  *string             `json:"arguments,omitempty"`
+	Name       string              `json:"name"`
+	ObjectType PrivilegeObjectType `json:"object_type"`
+	Owner      string              `json:"owner"`
+	Schema     *string             `json:"schema,omitempty"`
+}
+
 // OverviewResponse defines model for OverviewResponse.
 type OverviewResponse struct {
 	LatencyMs int                    `json:"latency_ms"`
@@ -211,17 +870,394 @@ type OverviewResponse struct {
 // OverviewResponseStatus defines model for OverviewResponse.Status.
 type OverviewResponseStatus string
 
+// PlanBuffers defines model for PlanBuffers.
+type PlanBuffers struct {
+	SharedDirtied int64 `json:"shared_dirtied"`
+	SharedHit     int64 `json:"shared_hit"`
+	SharedRead    int64 `json:"shared_read"`
+	SharedWritten int64 `json:"shared_written"`
+	TempRead      int64 `json:"temp_read"`
+	TempWritten   int64 `json:"temp_written"`
+}
+
+// PlanNode defines model for PlanNode.
+type PlanNode struct {
+	ActualLoops         *float64    `json:"actual_loops,omitempty"`
+	ActualRows          *float64    `json:"actual_rows,omitempty"`
+	ActualStartupTimeMs *float64    `json:"actual_startup_time_ms,omitempty"`
+	ActualTotalTimeMs   *float64    `json:"actual_total_time_ms,omitempty"`
+	Alias               *string     `json:"alias,omitempty"`
+	Buffers             PlanBuffers `json:"buffers"`
+	Children            []PlanNode  `json:"children"`
+	EstimateFactor      *float64    `json:"estimate_factor,omitempty"`
+	ExclusiveTimeMs     *float64    `json:"exclusive_time_ms,omitempty"`
+	Filter              *string     `json:"filter,omitempty"`
+	HashCond            *string     `json:"hash_cond,omitempty"`
+	Id                  int         `json:"id"`
+	IndexCond           *string     `json:"index_cond,omitempty"`
+	IndexName           *string     `json:"index_name,omitempty"`
+	JoinFilter          *string     `json:"join_filter,omitempty"`
+	JoinType            *string     `json:"join_type,omitempty"`
+	NodeType            string      `json:"node_type"`
+	Output              *[]string   `json:"output,omitempty"`
+	ParentRelationship  *string     `json:"parent_relationship,omitempty"`
+	PlanRows            float64     `json:"plan_rows"`
+	PlanWidth           int         `json:"plan_width"`
+	RelationName        *string     `json:"relation_name,omitempty"`
+	RowsRemovedByFilter *float64    `json:"rows_removed_by_filter,omitempty"`
+	Schema              *string     `json:"schema,omitempty"`
+	StartupCost         float64     `json:"startup_cost"`
+	Strategy            *string     `json:"strategy,omitempty"`
+	TotalCost           float64     `json:"total_cost"`
+}
+
+// PrivilegeChangeRequest database and schema targets use name; table, sequence and function targets use schema plus name (and arguments for functions) or schema plus all_in_schema. With default the change applies to objects created later by for_role (or the connection's role).
+type PrivilegeChangeRequest struct {
+	AllInSchema *bool `json:"all_in_schema,omitempty"`
+
+	// Arguments Function identity arguments as returned by ListPrivileges, ex "integer, text"
+	Arguments *string `json:"arguments,omitempty"`
+
+	// Cascade Revoke only; also revoke privileges granted onwards
+	Cascade *bool   `json:"cascade,omitempty"`
+	Default *bool   `json:"default,omitempty"`
+	ForRole *string `json:"for_role,omitempty"`
+
+	// GrantOption Grant WITH GRANT OPTION, or revoke only the grant option
+	GrantOption *bool `json:"grant_option,omitempty"`
+
+	// Grantee Role name; absent or PUBLIC for every role
+	Grantee    *string             `json:"grantee,omitempty"`
+	Name       *string             `json:"name,omitempty"`
+	ObjectType PrivilegeObjectType `json:"object_type"`
+
+	// Privileges Empty or absent for ALL PRIVILEGES
+	Privileges *[]string `json:"privileges,omitempty"`
+	Schema     *string   `json:"schema,omitempty"`
+}
+
+// PrivilegeObjectType defines model for PrivilegeObjectType.
+type PrivilegeObjectType string
+
+// QueryResult defines model for QueryResult.
+type QueryResult struct {
+	Columns      []string        `json:"columns"`
+	DurationMs   float64         `json:"duration_ms"`
+	Rows         [][]interface{} `json:"rows"`
+	RowsAffected int64           `json:"rows_affected"`
+
+	// Truncated The query returned more than max_rows rows
+	Truncated bool `json:"truncated"`
+}
+
+// RenameDatabaseRequest defines model for RenameDatabaseRequest.
+type RenameDatabaseRequest struct {
+	Name string `json:"name"`
+}
+
+// ReplicaStatus defines model for ReplicaStatus.
+type ReplicaStatus struct {
+	// InRecovery True on a standby
+	InRecovery bool `json:"in_recovery"`
+
+	// LastReplayAt Commit time on the primary of the last replayed transaction
+	LastReplayAt *time.Time `json:"last_replay_at,omitempty"`
+	ReceiveLsn   *string    `json:"receive_lsn,omitempty"`
+
+	// ReplayDelaySeconds Time since last_replay_at; keeps growing while the primary is idle
+	ReplayDelaySeconds *float64 `json:"replay_delay_seconds,omitempty"`
+
+	// ReplayLagBytes WAL received but not yet replayed
+	ReplayLagBytes int64   `json:"replay_lag_bytes"`
+	ReplayLsn      *string `json:"replay_lsn,omitempty"`
+	ReplayPaused   bool    `json:"replay_paused"`
+}
+
+// Replication defines model for Replication.
+type Replication struct {
+	Clients []ReplicationClient `json:"clients"`
+
+	// SlotRetentionWarningBytes Retained WAL above which an inactive slot is flagged as at risk
+	SlotRetentionWarningBytes int64             `json:"slot_retention_warning_bytes"`
+	Slots                     []ReplicationSlot `json:"slots"`
+	Status                    ReplicaStatus     `json:"status"`
+}
+
+// ReplicationClient defines model for ReplicationClient.
+type ReplicationClient struct {
+	ApplicationName  string    `json:"application_name"`
+	BackendStart     time.Time `json:"backend_start"`
+	ClientAddr       string    `json:"client_addr"`
+	FlushLagSeconds  *float64  `json:"flush_lag_seconds,omitempty"`
+	FlushLsn         *string   `json:"flush_lsn,omitempty"`
+	Pid              int       `json:"pid"`
+	ReplayLagBytes   int64     `json:"replay_lag_bytes"`
+	ReplayLagSeconds *float64  `json:"replay_lag_seconds,omitempty"`
+	ReplayLsn        *string   `json:"replay_lsn,omitempty"`
+	SentLsn          *string   `json:"sent_lsn,omitempty"`
+	State            string    `json:"state"`
+	SyncState        string    `json:"sync_state"`
+	User             string    `json:"user"`
+	WriteLagSeconds  *float64  `json:"write_lag_seconds,omitempty"`
+	WriteLsn         *string   `json:"write_lsn,omitempty"`
+}
+
+// ReplicationSlot defines model for ReplicationSlot.
+type ReplicationSlot struct {
+	Active    bool `json:"active"`
+	ActivePid *int `json:"active_pid,omitempty"`
+
+	// AtRisk Inactive slot retaining enough WAL to threaten the disk
+	AtRisk            bool    `json:"at_risk"`
+	ConfirmedFlushLsn *string `json:"confirmed_flush_lsn,omitempty"`
+	Database          *string `json:"database,omitempty"`
+	Name              string  `json:"name"`
+	Plugin            *string `json:"plugin,omitempty"`
+	RestartLsn        *string `json:"restart_lsn,omitempty"`
+	RetainedWalBytes  int64   `json:"retained_wal_bytes"`
+	SlotType          string  `json:"slot_type"`
+	WalStatus         *string `json:"wal_status,omitempty"`
+}
+
+// RestoreBackupRequest defines model for RestoreBackupRequest.
+type RestoreBackupRequest struct {
+	// Clean Drop the objects of the backup, and what depends on them, before recreating them.
+	Clean *bool `json:"clean,omitempty"`
+
+	// Create Create the target database before restoring.
+	Create *bool `json:"create,omitempty"`
+
+	// Database Target database. Defaults to the database the backup was taken from.
+	Database *string `json:"database,omitempty"`
+}
+
+// RoleMembership defines model for RoleMembership.
+type RoleMembership struct {
+	AdminOption bool   `json:"admin_option"`
+	Role        string `json:"role"`
+}
+
+// SSHTunnel The SSH bastion the sessions are dialed through; the secrets are never returned.
+type SSHTunnel struct {
+	Auth     SSHTunnelAuth `json:"auth"`
+	Host     string        `json:"host"`
+	Port     int           `json:"port"`
+	Username string        `json:"username"`
+}
+
+// SSHTunnelAuth defines model for SSHTunnel.Auth.
+type SSHTunnelAuth string
+
+// SSHTunnelRequest Authenticates with private_key when given, with password otherwise.
+type SSHTunnelRequest struct {
+	Host string `json:"host"`
+
+	// KnownHosts known_hosts lines for the bastion, as printed by ssh-keyscan; connections to a bastion
+	// whose host key does not match are refused.
+	KnownHosts string `json:"known_hosts"`
+
+	// Passphrase Unlocks an encrypted private key.
+	Passphrase *string `json:"passphrase,omitempty"`
+	Password   *string `json:"password,omitempty"`
+
+	// Port Defaults to 22.
+	Port *int `json:"port,omitempty"`
+
+	// PrivateKey PEM encoded private key, such as the content of ~/.ssh/id_ed25519.
+	PrivateKey *string `json:"private_key,omitempty"`
+	Username   string  `json:"username"`
+}
+
+// SavedQuery defines model for SavedQuery.
+type SavedQuery struct {
+	ConnectionId *openapi_types.UUID `json:"connection_id,omitempty"`
+	CreatedAt    time.Time           `json:"created_at"`
+	Description  string              `json:"description"`
+	Driver       *string             `json:"driver,omitempty"`
+	Id           openapi_types.UUID  `json:"id"`
+	Name         string              `json:"name"`
+	Params       []SavedQueryParam   `json:"params"`
+	Sql          string              `json:"sql"`
+	Tags         []string            `json:"tags"`
+	UpdatedAt    time.Time           `json:"updated_at"`
+}
+
+// SavedQueryParam defines model for SavedQueryParam.
+type SavedQueryParam struct {
+	// Default Used when the execution gives no value. Without it the parameter is required.
+	Default     *string `json:"default,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// Name Written as :name in the SQL
+	Name string              `json:"name"`
+	Type SavedQueryParamType `json:"type"`
+}
+
+// SavedQueryParamType defines model for SavedQueryParamType.
+type SavedQueryParamType string
+
+// SavedQueryRequest defines model for SavedQueryRequest.
+type SavedQueryRequest struct {
+	// ConnectionId Restricts the query to one connection
+	ConnectionId *openapi_types.UUID `json:"connection_id,omitempty"`
+	Description  *string             `json:"description,omitempty"`
+
+	// Driver Restricts the query to the connections of a driver. Excludes connection_id.
+	Driver *string            `json:"driver,omitempty"`
+	Name   string             `json:"name"`
+	Params *[]SavedQueryParam `json:"params,omitempty"`
+	Sql    string             `json:"sql"`
+	Tags   *[]string          `json:"tags,omitempty"`
+}
+
+// SchemaDDL defines model for SchemaDDL.
+type SchemaDDL struct {
+	Ddl    string `json:"ddl"`
+	Schema string `json:"schema"`
+}
+
+// Sequence defines model for Sequence.
+type Sequence struct {
+	Cache        int64   `json:"cache"`
+	CurrentValue *int64  `json:"current_value,omitempty"`
+	Cycle        bool    `json:"cycle"`
+	DataType     string  `json:"data_type"`
+	Increment    int64   `json:"increment"`
+	Max          int64   `json:"max"`
+	Min          int64   `json:"min"`
+	Name         string  `json:"name"`
+	OwnedBy      *string `json:"owned_by,omitempty"`
+	Schema       string  `json:"schema"`
+	Start        int64   `json:"start"`
+}
+
 // Session defines model for Session.
 type Session struct {
-	Database string `json:"database"`
-	Duration string `json:"duration"`
-	IsSlow   bool   `json:"is_slow"`
-	Pid      int    `json:"pid"`
-	Query    string `json:"query"`
-	State    string `json:"state"`
-	User     string `json:"user"`
+	ApplicationName *string `json:"application_name,omitempty"`
+	BackendType     *string `json:"backend_type,omitempty"`
+	ClientAddr      *string `json:"client_addr,omitempty"`
+	Database        string  `json:"database"`
+	Duration        string  `json:"duration"`
+	IsSlow          bool    `json:"is_slow"`
+	Pid             int     `json:"pid"`
+	Query           string  `json:"query"`
+	State           string  `json:"state"`
+	User            string  `json:"user"`
+	WaitEvent       *string `json:"wait_event,omitempty"`
+	WaitEventType   *string `json:"wait_event_type,omitempty"`
+}
+
+// SessionFilter defines model for SessionFilter.
+type SessionFilter struct {
+	Database           *string         `json:"database,omitempty"`
+	MinDurationSeconds *int            `json:"min_duration_seconds,omitempty"`
+	Pids               *[]int          `json:"pids,omitempty"`
+	States             *[]SessionState `json:"states,omitempty"`
+	User               *string         `json:"user,omitempty"`
+}
+
+// SessionState defines model for SessionState.
+type SessionState string
+
+// Setting defines model for Setting.
+type Setting struct {
+	BootValue string `json:"boot_value"`
+	Category  string `json:"category"`
+
+	// Context When a change takes effect (postmaster, sighup, user, ...)
+	Context     string   `json:"context"`
+	Description string   `json:"description"`
+	EnumValues  []string `json:"enum_values"`
+	MaxValue    *string  `json:"max_value,omitempty"`
+	MinValue    *string  `json:"min_value,omitempty"`
+	Name        string   `json:"name"`
+
+	// PendingRestart The configuration file holds a value that only applies after a restart
+	PendingRestart bool `json:"pending_restart"`
+	ReadOnly       bool `json:"read_only"`
+
+	// RequiresRestart Changes to this parameter need a server restart
+	RequiresRestart bool           `json:"requires_restart"`
+	ResetValue      string         `json:"reset_value"`
+	Source          string         `json:"source"`
+	Unit            *string        `json:"unit,omitempty"`
+	Value           string         `json:"value"`
+	Vartype         SettingVartype `json:"vartype"`
+}
+
+// SettingVartype defines model for Setting.Vartype.
+type SettingVartype string
+
+// SettingCategory defines model for SettingCategory.
+type SettingCategory struct {
+	Category string    `json:"category"`
+	Settings []Setting `json:"settings"`
+}
+
+// SettingChange defines model for SettingChange.
+type SettingChange struct {
+	Name string `json:"name"`
+
+	// Reset Remove the parameter from postgresql.auto.conf
+	Reset *bool `json:"reset,omitempty"`
+
+	// Value New value; required unless reset is true. List parameters such as
+	// shared_preload_libraries or search_path take comma-separated elements, double
+	// quoted when they contain a comma.
+	Value *string `json:"value,omitempty"`
+}
+
+// SignalSessionsRequest defines model for SignalSessionsRequest.
+type SignalSessionsRequest struct {
+	Action SignalSessionsRequestAction `json:"action"`
+	DryRun *bool                       `json:"dry_run,omitempty"`
+	Filter SessionFilter               `json:"filter"`
+}
+
+// SignalSessionsRequestAction defines model for SignalSessionsRequest.Action.
+type SignalSessionsRequestAction string
+
+// SignalSessionsResponse defines model for SignalSessionsResponse.
+type SignalSessionsResponse struct {
+	DryRun bool `json:"dry_run"`
+
+	// Failed PIDs the server refused to signal (permission denied or already gone)
+	Failed    []int `json:"failed"`
+	Matched   []int `json:"matched"`
+	Signalled []int `json:"signalled"`
 }
 
+// StatementOrder defines model for StatementOrder.
+type StatementOrder string
+
+// StatementStats defines model for StatementStats.
+type StatementStats struct {
+	CacheHitRatio   float64 `json:"cache_hit_ratio"`
+	Calls           int64   `json:"calls"`
+	Database        string  `json:"database"`
+	MaxTimeMs       float64 `json:"max_time_ms"`
+	MeanTimeMs      float64 `json:"mean_time_ms"`
+	MinTimeMs       float64 `json:"min_time_ms"`
+	Query           string  `json:"query"`
+	QueryId         int64   `json:"query_id"`
+	Rows            int64   `json:"rows"`
+	SharedBlksHit   int64   `json:"shared_blks_hit"`
+	SharedBlksRead  int64   `json:"shared_blks_read"`
+	StddevTimeMs    float64 `json:"stddev_time_ms"`
+	TempBlksWritten int64   `json:"temp_blks_written"`
+	TotalTimeMs     float64 `json:"total_time_ms"`
+	User            string  `json:"user"`
+}
+
+// SubmitJobRequest defines model for SubmitJobRequest.
+type SubmitJobRequest struct {
+	Command SubmitJobRequestCommand `json:"command"`
+	Input   map[string]interface{}  `json:"input"`
+}
+
+// SubmitJobRequestCommand defines model for SubmitJobRequest.Command.
+type SubmitJobRequestCommand string
+
 // Table defines model for Table.
 type Table struct {
 	Name     string `json:"name"`
@@ -230,6 +1266,54 @@ type Table struct {
 	Type     string `json:"type"`
 }
 
+// TableAccess defines model for TableAccess.
+type TableAccess struct {
+	Granted map[string]bool `json:"granted"`
+
+	// Owner The role owns the table or inherits from its owner
+	Owner  bool   `json:"owner"`
+	Schema string `json:"schema"`
+
+	// SchemaUsage False when the role cannot reach the schema, whatever the table grants
+	SchemaUsage bool   `json:"schema_usage"`
+	Table       string `json:"table"`
+}
+
+// TableHealth defines model for TableHealth.
+type TableHealth struct {
+	AnalyzeCount     int64   `json:"analyze_count"`
+	AutoanalyzeCount int64   `json:"autoanalyze_count"`
+	AutovacuumCount  int64   `json:"autovacuum_count"`
+	CacheHitRatio    float64 `json:"cache_hit_ratio"`
+	DeadTupleRatio   float64 `json:"dead_tuple_ratio"`
+	DeadTuples       int64   `json:"dead_tuples"`
+	Deletes          int64   `json:"deletes"`
+
+	// EstimatedBloat Approximate heap bytes held by dead tuples
+	EstimatedBloat     int64      `json:"estimated_bloat"`
+	HeapSize           int64      `json:"heap_size"`
+	HotUpdates         int64      `json:"hot_updates"`
+	IndexScanRatio     float64    `json:"index_scan_ratio"`
+	IndexScans         int64      `json:"index_scans"`
+	IndexSize          int64      `json:"index_size"`
+	IndexTuplesFetched int64      `json:"index_tuples_fetched"`
+	Inserts            int64      `json:"inserts"`
+	LastAnalyze        *time.Time `json:"last_analyze,omitempty"`
+	LastAutoanalyze    *time.Time `json:"last_autoanalyze,omitempty"`
+	LastAutovacuum     *time.Time `json:"last_autovacuum,omitempty"`
+	LastVacuum         *time.Time `json:"last_vacuum,omitempty"`
+	LiveTuples         int64      `json:"live_tuples"`
+	ModsSinceAnalyze   int64      `json:"mods_since_analyze"`
+	Name               string     `json:"name"`
+	Schema             string     `json:"schema"`
+	SeqScans           int64      `json:"seq_scans"`
+	SeqTuplesRead      int64      `json:"seq_tuples_read"`
+	ToastSize          int64      `json:"toast_size"`
+	TotalSize          int64      `json:"total_size"`
+	Updates            int64      `json:"updates"`
+	VacuumCount        int64      `json:"vacuum_count"`
+}
+
 // TableRowsResponse defines model for TableRowsResponse.
 type TableRowsResponse struct {
 	Columns []string        `json:"columns"`
@@ -239,6 +1323,58 @@ type TableRowsResponse struct {
 	Total   int64           `json:"total"`
 }
 
+// TopStatementsResponse defines model for TopStatementsResponse.
+type TopStatementsResponse struct {
+	// Available False when pg_stat_statements is not installed or not preloaded, or the server is older than PostgreSQL 13
+	Available  bool             `json:"available"`
+	Reason     *string          `json:"reason,omitempty"`
+	Statements []StatementStats `json:"statements"`
+}
+
+// Trigger defines model for Trigger.
+type Trigger struct {
+	Definition string        `json:"definition"`
+	Enabled    bool          `json:"enabled"`
+	Events     []string      `json:"events"`
+	Function   string        `json:"function"`
+	Level      TriggerLevel  `json:"level"`
+	Name       string        `json:"name"`
+	Table      string        `json:"table"`
+	Timing     TriggerTiming `json:"timing"`
+}
+
+// TriggerLevel defines model for Trigger.Level.
+type TriggerLevel string
+
+// TriggerTiming defines model for Trigger.Timing.
+type TriggerTiming string
+
+// TypeAttribute defines model for TypeAttribute.
+type TypeAttribute struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// UpdateConnectionRequest defines model for UpdateConnectionRequest.
+type UpdateConnectionRequest struct {
+	// Color Hex color such as #d73a49; empty clears it
+	Color       *string `json:"color,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// Environment Defaults to development.
+	Environment *ConnectionEnvironment `json:"environment,omitempty"`
+
+	// Protection With confirm, every command that changes the server must carry the connection name in
+	// the X-Confirm-Connection header. Defaults to confirm for production connections and to
+	// none otherwise.
+	Protection *ConnectionProtection `json:"protection,omitempty"`
+
+	// ReadOnly Sessions start with default_transaction_read_only = on, so the server rejects writes,
+	// and every command that changes the server answers 403.
+	ReadOnly *ConnectionReadOnly `json:"read_only,omitempty"`
+	Tags     *[]string           `json:"tags,omitempty"`
+}
+
 // UpdateTableRowRequest defines model for UpdateTableRowRequest.
 type UpdateTableRowRequest struct {
 	// Set Column(s) and new values to apply
@@ -248,15 +1384,140 @@ type UpdateTableRowRequest struct {
 	Where map[string]interface{} `json:"where"`
 }
 
+// UserType defines model for UserType.
+type UserType struct {
+	Attributes  *[]TypeAttribute `json:"attributes,omitempty"`
+	BaseType    *string          `json:"base_type,omitempty"`
+	Comment     *string          `json:"comment,omitempty"`
+	Constraints *[]string        `json:"constraints,omitempty"`
+	Default     *string          `json:"default,omitempty"`
+	Kind        UserTypeKind     `json:"kind"`
+	Labels      *[]string        `json:"labels,omitempty"`
+	Name        string           `json:"name"`
+	NotNull     *bool            `json:"not_null,omitempty"`
+	Schema      string           `json:"schema"`
+}
+
+// UserTypeKind defines model for UserType.Kind.
+type UserTypeKind string
+
+// View defines model for View.
+type View struct {
+	Comment *string `json:"comment,omitempty"`
+	Name    string  `json:"name"`
+	Owner   string  `json:"owner"`
+	Schema  string  `json:"schema"`
+}
+
+// AlertRuleId defines model for AlertRuleId.
+type AlertRuleId = openapi_types.UUID
+
+// BackupId defines model for BackupId.
+type BackupId = openapi_types.UUID
+
 // ConnectionId defines model for ConnectionId.
 type ConnectionId = openapi_types.UUID
 
 // DatabaseName defines model for DatabaseName.
 type DatabaseName = string
 
+// JobId defines model for JobId.
+type JobId = openapi_types.UUID
+
+// SavedQueryId defines model for SavedQueryId.
+type SavedQueryId = openapi_types.UUID
+
+// SchemaName defines model for SchemaName.
+type SchemaName = string
+
 // TableName defines model for TableName.
 type TableName = string
 
+// Username defines model for Username.
+type Username = string
+
+// ConfirmationRequired defines model for ConfirmationRequired.
+type ConfirmationRequired = Error
+
+// ReadOnlyConnection defines model for ReadOnlyConnection.
+type ReadOnlyConnection = Error
+
+// ListAlertsParams defines parameters for ListAlerts.
+type ListAlertsParams struct {
+	ConnectionId *openapi_types.UUID `form:"connection_id,omitempty" json:"connection_id,omitempty"`
+
+	// Status Defaults to every status
+	Status *[]AlertStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// ListConnectionsParams defines parameters for ListConnections.
+type ListConnectionsParams struct {
+	Environment *ConnectionEnvironment `form:"environment,omitempty" json:"environment,omitempty"`
+	Tag         *string                `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Search Matches the name, host or description, ignoring case
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+}
+
+// DropDatabaseParams defines parameters for DropDatabase.
+type DropDatabaseParams struct {
+	// Confirm Must repeat the database name
+	Confirm string `form:"confirm" json:"confirm"`
+
+	// Force Terminate the sessions connected to the database first (PostgreSQL 13+)
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
+
+// GetSchemaDDLParams defines parameters for GetSchemaDDL.
+type GetSchemaDDLParams struct {
+	// Schema Schema name
+	Schema *SchemaName `form:"schema,omitempty" json:"schema,omitempty"`
+}
+
+// GetObjectDDLParams defines parameters for GetObjectDDL.
+type GetObjectDDLParams struct {
+	// Schema Schema name
+	Schema *SchemaName `form:"schema,omitempty" json:"schema,omitempty"`
+}
+
+// ListFunctionsParams defines parameters for ListFunctions.
+type ListFunctionsParams struct {
+	// Schema Schema name
+	Schema *SchemaName `form:"schema,omitempty" json:"schema,omitempty"`
+}
+
+// ListMaterializedViewsParams defines parameters for ListMaterializedViews.
+type ListMaterializedViewsParams struct {
+	// Schema Schema name
+	Schema *SchemaName `form:"schema,omitempty" json:"schema,omitempty"`
+}
+
+// ListPrivilegesParams defines parameters for ListPrivileges.
+type ListPrivilegesParams struct {
+	ObjectType *PrivilegeObjectType `form:"object_type,omitempty" json:"object_type,omitempty"`
+
+	// Schema Only objects of this schema (and the schema itself)
+	Schema *string `form:"schema,omitempty" json:"schema,omitempty"`
+}
+
+// GetEffectivePrivilegesParams defines parameters for GetEffectivePrivileges.
+type GetEffectivePrivilegesParams struct {
+	Role   string  `form:"role" json:"role"`
+	Schema *string `form:"schema,omitempty" json:"schema,omitempty"`
+}
+
+// ListSequencesParams defines parameters for ListSequences.
+type ListSequencesParams struct {
+	// Schema Schema name
+	Schema *SchemaName `form:"schema,omitempty" json:"schema,omitempty"`
+}
+
+// GetTableHealthParams defines parameters for GetTableHealth.
+type GetTableHealthParams struct {
+	// Schema Schema name
+	Schema *SchemaName `form:"schema,omitempty" json:"schema,omitempty"`
+}
+
 // QueryTableRowsParams defines parameters for QueryTableRows.
 type QueryTableRowsParams struct {
 	Limit     *int                           `form:"limit,omitempty" json:"limit,omitempty"`
@@ -268,38 +1529,264 @@ type QueryTableRowsParams struct {
 // QueryTableRowsParamsSortOrder defines parameters for QueryTableRows.
 type QueryTableRowsParamsSortOrder string
 
+// ListTriggersParams defines parameters for ListTriggers.
+type ListTriggersParams struct {
+	// Schema Schema name
+	Schema *SchemaName `form:"schema,omitempty" json:"schema,omitempty"`
+}
+
+// ListTypesParams defines parameters for ListTypes.
+type ListTypesParams struct {
+	// Schema Schema name
+	Schema *SchemaName `form:"schema,omitempty" json:"schema,omitempty"`
+}
+
+// ListViewsParams defines parameters for ListViews.
+type ListViewsParams struct {
+	// Schema Schema name
+	Schema *SchemaName `form:"schema,omitempty" json:"schema,omitempty"`
+}
+
+// QueryMetricsParams defines parameters for QueryMetrics.
+type QueryMetricsParams struct {
+	// From Start of the range (inclusive). Defaults to one hour before `to`.
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To End of the range (exclusive). Defaults to now.
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Resolution Series tier to read. Picked from the range length when omitted.
+	Resolution *MetricResolution `form:"resolution,omitempty" json:"resolution,omitempty"`
+}
+
+// ListSessionsParams defines parameters for ListSessions.
+type ListSessionsParams struct {
+	User     *string `form:"user,omitempty" json:"user,omitempty"`
+	Database *string `form:"database,omitempty" json:"database,omitempty"`
+
+	// State Defaults to every state except idle
+	State              *[]SessionState `form:"state,omitempty" json:"state,omitempty"`
+	MinDurationSeconds *int            `form:"min_duration_seconds,omitempty" json:"min_duration_seconds,omitempty"`
+	Limit              *int            `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset             *int            `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListSettingsParams defines parameters for ListSettings.
+type ListSettingsParams struct {
+	// Search Filters parameters by name or description
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+}
+
+// ListTopStatementsParams defines parameters for ListTopStatements.
+type ListTopStatementsParams struct {
+	OrderBy *StatementOrder `form:"order_by,omitempty" json:"order_by,omitempty"`
+	Limit   *int            `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// DropUserParams defines parameters for DropUser.
+type DropUserParams struct {
+	ReassignTo *string `form:"reassign_to,omitempty" json:"reassign_to,omitempty"`
+	DropOwned  *bool   `form:"drop_owned,omitempty" json:"drop_owned,omitempty"`
+}
+
+// SearchHistoryParams defines parameters for SearchHistory.
+type SearchHistoryParams struct {
+	ConnectionId *openapi_types.UUID `form:"connection_id,omitempty" json:"connection_id,omitempty"`
+	Database     *string             `form:"database,omitempty" json:"database,omitempty"`
+	Source       *HistorySource      `form:"source,omitempty" json:"source,omitempty"`
+
+	// Search Matches a part of the statement, ignoring case
+	Search *string    `form:"search,omitempty" json:"search,omitempty"`
+	Since  *time.Time `form:"since,omitempty" json:"since,omitempty"`
+	Until  *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// Failed Only the statements that returned an error
+	Failed *bool `form:"failed,omitempty" json:"failed,omitempty"`
+
+	// Limit Defaults to 100
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListJobsParams defines parameters for ListJobs.
+type ListJobsParams struct {
+	ConnectionId *openapi_types.UUID `form:"connection_id,omitempty" json:"connection_id,omitempty"`
+	Status       *JobStatus          `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Defaults to 100
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListSavedQueriesParams defines parameters for ListSavedQueries.
+type ListSavedQueriesParams struct {
+	// ConnectionId Keep only the queries that may run on the connection
+	ConnectionId *openapi_types.UUID `form:"connection_id,omitempty" json:"connection_id,omitempty"`
+	Tag          *string             `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Search Matches the name, description or SQL, ignoring case
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+}
+
+// CreateAlertRuleJSONRequestBody defines body for CreateAlertRule for application/json ContentType.
+type CreateAlertRuleJSONRequestBody = AlertRuleRequest
+
+// UpdateAlertRuleJSONRequestBody defines body for UpdateAlertRule for application/json ContentType.
+type UpdateAlertRuleJSONRequestBody = AlertRuleRequest
+
 // CreateConnectionJSONRequestBody defines body for CreateConnection for application/json ContentType.
 type CreateConnectionJSONRequestBody = CreateConnectionRequest
 
+// UpdateConnectionJSONRequestBody defines body for UpdateConnection for application/json ContentType.
+type UpdateConnectionJSONRequestBody = UpdateConnectionRequest
+
+// RestoreBackupJSONRequestBody defines body for RestoreBackup for application/json ContentType.
+type RestoreBackupJSONRequestBody = RestoreBackupRequest
+
 // CreateDatabaseJSONRequestBody defines body for CreateDatabase for application/json ContentType.
 type CreateDatabaseJSONRequestBody = CreateDatabaseRequest
 
+// RenameDatabaseJSONRequestBody defines body for RenameDatabase for application/json ContentType.
+type RenameDatabaseJSONRequestBody = RenameDatabaseRequest
+
+// CreateBackupJSONRequestBody defines body for CreateBackup for application/json ContentType.
+type CreateBackupJSONRequestBody = CreateBackupRequest
+
+// ExplainQueryJSONRequestBody defines body for ExplainQuery for application/json ContentType.
+type ExplainQueryJSONRequestBody = ExplainRequest
+
+// RunMaintenanceJSONRequestBody defines body for RunMaintenance for application/json ContentType.
+type RunMaintenanceJSONRequestBody = MaintenanceRequest
+
+// GrantPrivilegesJSONRequestBody defines body for GrantPrivileges for application/json ContentType.
+type GrantPrivilegesJSONRequestBody = PrivilegeChangeRequest
+
+// RevokePrivilegesJSONRequestBody defines body for RevokePrivileges for application/json ContentType.
+type RevokePrivilegesJSONRequestBody = PrivilegeChangeRequest
+
 // CreateTableJSONRequestBody defines body for CreateTable for application/json ContentType.
 type CreateTableJSONRequestBody = CreateTableRequest
 
 // UpdateTableRowJSONRequestBody defines body for UpdateTableRow for application/json ContentType.
 type UpdateTableRowJSONRequestBody = UpdateTableRowRequest
 
+// SignalSessionsJSONRequestBody defines body for SignalSessions for application/json ContentType.
+type SignalSessionsJSONRequestBody = SignalSessionsRequest
+
+// AlterSettingsJSONRequestBody defines body for AlterSettings for application/json ContentType.
+type AlterSettingsJSONRequestBody = AlterSettingsRequest
+
+// SetSSHTunnelJSONRequestBody defines body for SetSSHTunnel for application/json ContentType.
+type SetSSHTunnelJSONRequestBody = SSHTunnelRequest
+
 // CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
 type CreateUserJSONRequestBody = CreateUserRequest
 
+// AlterUserJSONRequestBody defines body for AlterUser for application/json ContentType.
+type AlterUserJSONRequestBody = AlterUserRequest
+
+// GrantRoleJSONRequestBody defines body for GrantRole for application/json ContentType.
+type GrantRoleJSONRequestBody = GrantRoleRequest
+
+// SubmitJobJSONRequestBody defines body for SubmitJob for application/json ContentType.
+type SubmitJobJSONRequestBody = SubmitJobRequest
+
+// CreateSavedQueryJSONRequestBody defines body for CreateSavedQuery for application/json ContentType.
+type CreateSavedQueryJSONRequestBody = SavedQueryRequest
+
+// UpdateSavedQueryJSONRequestBody defines body for UpdateSavedQuery for application/json ContentType.
+type UpdateSavedQueryJSONRequestBody = SavedQueryRequest
+
+// ExecuteSavedQueryJSONRequestBody defines body for ExecuteSavedQuery for application/json ContentType.
+type ExecuteSavedQueryJSONRequestBody = ExecuteSavedQueryRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List the alert state of each rule on each connection
+	// (GET /alerts)
+	ListAlerts(w http.ResponseWriter, r *http.Request, params ListAlertsParams)
+	// List alert rules
+	// (GET /alerts/rules)
+	ListAlertRules(w http.ResponseWriter, r *http.Request)
+	// Create an alert rule
+	// (POST /alerts/rules)
+	CreateAlertRule(w http.ResponseWriter, r *http.Request)
+	// Delete an alert rule and its alert state
+	// (DELETE /alerts/rules/{ruleID})
+	DeleteAlertRule(w http.ResponseWriter, r *http.Request, ruleID AlertRuleId)
+	// Replace the definition of an alert rule
+	// (PUT /alerts/rules/{ruleID})
+	UpdateAlertRule(w http.ResponseWriter, r *http.Request, ruleID AlertRuleId)
+	// Send a test notification to the rule target
+	// (POST /alerts/rules/{ruleID}/test)
+	TestAlertRule(w http.ResponseWriter, r *http.Request, ruleID AlertRuleId)
 	// List Connections
 	// (GET /connections)
-	ListConnections(w http.ResponseWriter, r *http.Request)
+	ListConnections(w http.ResponseWriter, r *http.Request, params ListConnectionsParams)
 	// Create Connection
 	// (POST /connections)
 	CreateConnection(w http.ResponseWriter, r *http.Request)
 	// Retrieves a connection by ID
 	// (GET /connections/{connectionID})
 	FindConnection(w http.ResponseWriter, r *http.Request, connectionID ConnectionId)
+	// Updates the environment, tags, color, description, protection or read-only mode of a connection
+	// (PATCH /connections/{connectionID})
+	UpdateConnection(w http.ResponseWriter, r *http.Request, connectionID ConnectionId)
+	// List the finished backups of a connection, most recent first
+	// (GET /connections/{connectionID}/backups)
+	ListBackups(w http.ResponseWriter, r *http.Request, connectionID ConnectionId)
+	// Delete a backup archive
+	// (DELETE /connections/{connectionID}/backups/{backupID})
+	DeleteBackup(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, backupID BackupId)
+	// Queue the restore of a backup into a new or existing database
+	// (POST /connections/{connectionID}/backups/{backupID}/restore)
+	RestoreBackup(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, backupID BackupId)
 	// List databases from a connection
 	// (GET /connections/{connectionID}/databases)
 	ListDatabases(w http.ResponseWriter, r *http.Request, connectionID ConnectionId)
 	// Create a new database
 	// (POST /connections/{connectionID}/databases)
 	CreateDatabase(w http.ResponseWriter, r *http.Request, connectionID ConnectionId)
+	// Drop a database
+	// (DELETE /connections/{connectionID}/databases/{databaseName})
+	DropDatabase(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, params DropDatabaseParams)
+	// Rename a database
+	// (PATCH /connections/{connectionID}/databases/{databaseName})
+	RenameDatabase(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName)
+	// Queue a logical backup of a database or some of its tables
+	// (POST /connections/{connectionID}/databases/{databaseName}/backups)
+	CreateBackup(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName)
+	// Dump the DDL of every object in a schema
+	// (GET /connections/{connectionID}/databases/{databaseName}/ddl)
+	GetSchemaDDL(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, params GetSchemaDDLParams)
+	// Reconstruct the CREATE statement of a schema object
+	// (GET /connections/{connectionID}/databases/{databaseName}/ddl/{objectKind}/{objectName})
+	GetObjectDDL(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, objectKind ObjectKind, objectName string, params GetObjectDDLParams)
+	// Show the execution plan of a statement
+	// (POST /connections/{connectionID}/databases/{databaseName}/explain)
+	ExplainQuery(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName)
+	// List functions and procedures of a schema
+	// (GET /connections/{connectionID}/databases/{databaseName}/functions)
+	ListFunctions(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, params ListFunctionsParams)
+	// Queue a VACUUM, ANALYZE, REINDEX or REFRESH MATERIALIZED VIEW job
+	// (POST /connections/{connectionID}/databases/{databaseName}/maintenance)
+	RunMaintenance(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName)
+	// List materialized views of a schema
+	// (GET /connections/{connectionID}/databases/{databaseName}/materialized-views)
+	ListMaterializedViews(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, params ListMaterializedViewsParams)
+	// Decode the ACLs of a database and its objects, plus default privileges
+	// (GET /connections/{connectionID}/databases/{databaseName}/privileges)
+	ListPrivileges(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, params ListPrivilegesParams)
+	// Effective table privileges of a role
+	// (GET /connections/{connectionID}/databases/{databaseName}/privileges/effective)
+	GetEffectivePrivileges(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, params GetEffectivePrivilegesParams)
+	// GRANT privileges, or ALTER DEFAULT PRIVILEGES ... GRANT
+	// (POST /connections/{connectionID}/databases/{databaseName}/privileges/grant)
+	GrantPrivileges(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName)
+	// REVOKE privileges, or ALTER DEFAULT PRIVILEGES ... REVOKE
+	// (POST /connections/{connectionID}/databases/{databaseName}/privileges/revoke)
+	RevokePrivileges(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName)
+	// List sequences of a schema
+	// (GET /connections/{connectionID}/databases/{databaseName}/sequences)
+	ListSequences(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, params ListSequencesParams)
 	// List tables from a database
 	// (GET /connections/{connectionID}/databases/{databaseName}/tables)
 	ListTables(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName)
@@ -309,6 +1796,9 @@ type ServerInterface interface {
 	// ListColumns
 	// (GET /connections/{connectionID}/databases/{databaseName}/tables/{tableName}/columns)
 	ListColumns(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, tableName TableName)
+	// Get usage, maintenance and cache statistics of a table
+	// (GET /connections/{connectionID}/databases/{databaseName}/tables/{tableName}/health)
+	GetTableHealth(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, tableName TableName, params GetTableHealthParams)
 	// ListIndexes
 	// (GET /connections/{connectionID}/databases/{databaseName}/tables/{tableName}/indexes)
 	ListIndexes(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, tableName TableName)
@@ -318,33 +1808,159 @@ type ServerInterface interface {
 	// Update a row in a table
 	// (PUT /connections/{connectionID}/databases/{databaseName}/tables/{tableName}/rows)
 	UpdateTableRow(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, tableName TableName)
+	// List triggers of a table
+	// (GET /connections/{connectionID}/databases/{databaseName}/tables/{tableName}/triggers)
+	ListTriggers(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, tableName TableName, params ListTriggersParams)
+	// List enum, domain and composite types of a schema
+	// (GET /connections/{connectionID}/databases/{databaseName}/types)
+	ListTypes(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, params ListTypesParams)
+	// List views of a schema
+	// (GET /connections/{connectionID}/databases/{databaseName}/views)
+	ListViews(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, params ListViewsParams)
+	// Show which sessions block which, rooted at the blocking sessions
+	// (GET /connections/{connectionID}/locks)
+	GetBlockingTree(w http.ResponseWriter, r *http.Request, connectionID ConnectionId)
+	// Read the sampled metrics history of a connection
+	// (GET /connections/{connectionID}/metrics)
+	QueryMetrics(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, params QueryMetricsParams)
 	// Get Server Health & Overview
 	// (GET /connections/{connectionID}/overview)
 	GetConnectionOverview(w http.ResponseWriter, r *http.Request, connectionID ConnectionId)
 	// Checks the connection status
 	// (GET /connections/{connectionID}/ping)
 	PingConnection(w http.ResponseWriter, r *http.Request, connectionID ConnectionId)
-	// List active sessions
+	// Show recovery status, streaming replication clients and replication slots
+	// (GET /connections/{connectionID}/replication)
+	GetReplication(w http.ResponseWriter, r *http.Request, connectionID ConnectionId)
+	// List sessions
 	// (GET /connections/{connectionID}/sessions)
-	ListSessions(w http.ResponseWriter, r *http.Request, connectionID ConnectionId)
+	ListSessions(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, params ListSessionsParams)
+	// Cancel or terminate every session matching a filter
+	// (POST /connections/{connectionID}/sessions/signal)
+	SignalSessions(w http.ResponseWriter, r *http.Request, connectionID ConnectionId)
 	// Kill a specific session
 	// (DELETE /connections/{connectionID}/sessions/{pid})
 	KillSession(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, pid int)
+	// Cancel the running query of a session without closing it
+	// (POST /connections/{connectionID}/sessions/{pid}/cancel)
+	CancelSession(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, pid int)
+	// List server configuration parameters grouped by category
+	// (GET /connections/{connectionID}/settings)
+	ListSettings(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, params ListSettingsParams)
+	// Change parameters with ALTER SYSTEM and reload the configuration
+	// (PATCH /connections/{connectionID}/settings)
+	AlterSettings(w http.ResponseWriter, r *http.Request, connectionID ConnectionId)
+	// Dials the sessions of the connection directly again
+	// (DELETE /connections/{connectionID}/ssh-tunnel)
+	RemoveSSHTunnel(w http.ResponseWriter, r *http.Request, connectionID ConnectionId)
+	// Dials the sessions of the connection through an SSH bastion
+	// (PUT /connections/{connectionID}/ssh-tunnel)
+	SetSSHTunnel(w http.ResponseWriter, r *http.Request, connectionID ConnectionId)
+	// Reset pg_stat_statements counters
+	// (DELETE /connections/{connectionID}/statements)
+	ResetStatementStats(w http.ResponseWriter, r *http.Request, connectionID ConnectionId)
+	// List the most expensive normalized queries from pg_stat_statements
+	// (GET /connections/{connectionID}/statements)
+	ListTopStatements(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, params ListTopStatementsParams)
 	// List database users (roles)
 	// (GET /connections/{connectionID}/users)
 	ListUsers(w http.ResponseWriter, r *http.Request, connectionID ConnectionId)
 	// Create a new database user
 	// (POST /connections/{connectionID}/users)
 	CreateUser(w http.ResponseWriter, r *http.Request, connectionID ConnectionId)
+	// Drop a database user
+	// (DELETE /connections/{connectionID}/users/{username})
+	DropUser(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, username Username, params DropUserParams)
+	// Alter a database user (password, attributes, limits, expiry)
+	// (PATCH /connections/{connectionID}/users/{username})
+	AlterUser(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, username Username)
+	// Grant membership in a role to the user
+	// (POST /connections/{connectionID}/users/{username}/memberships)
+	GrantRole(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, username Username)
+	// Revoke the user's membership in a role
+	// (DELETE /connections/{connectionID}/users/{username}/memberships/{role})
+	RevokeRole(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, username Username, role string)
+	// Search the statements run through Mesa, most recent first
+	// (GET /history)
+	SearchHistory(w http.ResponseWriter, r *http.Request, params SearchHistoryParams)
+	// List jobs, most recent first
+	// (GET /jobs)
+	ListJobs(w http.ResponseWriter, r *http.Request, params ListJobsParams)
+	// Queue a command as a background job
+	// (POST /jobs)
+	SubmitJob(w http.ResponseWriter, r *http.Request)
+	// Get a job, its progress and log
+	// (GET /jobs/{jobID})
+	GetJob(w http.ResponseWriter, r *http.Request, jobID JobId)
+	// Cancel a queued or running job
+	// (POST /jobs/{jobID}/cancel)
+	CancelJob(w http.ResponseWriter, r *http.Request, jobID JobId)
+	// Stream the state of a job as server-sent events until it finishes
+	// (GET /jobs/{jobID}/events)
+	StreamJobEvents(w http.ResponseWriter, r *http.Request, jobID JobId)
+	// List saved queries ordered by name
+	// (GET /saved-queries)
+	ListSavedQueries(w http.ResponseWriter, r *http.Request, params ListSavedQueriesParams)
+	// Save a query
+	// (POST /saved-queries)
+	CreateSavedQuery(w http.ResponseWriter, r *http.Request)
+	// Delete a saved query
+	// (DELETE /saved-queries/{queryID})
+	DeleteSavedQuery(w http.ResponseWriter, r *http.Request, queryID SavedQueryId)
+	// Get a saved query
+	// (GET /saved-queries/{queryID})
+	GetSavedQuery(w http.ResponseWriter, r *http.Request, queryID SavedQueryId)
+	// Replace the definition of a saved query
+	// (PUT /saved-queries/{queryID})
+	UpdateSavedQuery(w http.ResponseWriter, r *http.Request, queryID SavedQueryId)
+	// Run a saved query on a connection and database
+	// (POST /saved-queries/{queryID}/execute)
+	ExecuteSavedQuery(w http.ResponseWriter, r *http.Request, queryID SavedQueryId)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// List the alert state of each rule on each connection
+// (GET /alerts)
+func (_ Unimplemented) ListAlerts(w http.ResponseWriter, r *http.Request, params ListAlertsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List alert rules
+// (GET /alerts/rules)
+func (_ Unimplemented) ListAlertRules(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create an alert rule
+// (POST /alerts/rules)
+func (_ Unimplemented) CreateAlertRule(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete an alert rule and its alert state
+// (DELETE /alerts/rules/{ruleID})
+func (_ Unimplemented) DeleteAlertRule(w http.ResponseWriter, r *http.Request, ruleID AlertRuleId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replace the definition of an alert rule
+// (PUT /alerts/rules/{ruleID})
+func (_ Unimplemented) UpdateAlertRule(w http.ResponseWriter, r *http.Request, ruleID AlertRuleId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Send a test notification to the rule target
+// (POST /alerts/rules/{ruleID}/test)
+func (_ Unimplemented) TestAlertRule(w http.ResponseWriter, r *http.Request, ruleID AlertRuleId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List Connections
 // (GET /connections)
-func (_ Unimplemented) ListConnections(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) ListConnections(w http.ResponseWriter, r *http.Request, params ListConnectionsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -360,6 +1976,30 @@ func (_ Unimplemented) FindConnection(w http.ResponseWriter, r *http.Request, co
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Updates the environment, tags, color, description, protection or read-only mode of a connection
+// (PATCH /connections/{connectionID})
+func (_ Unimplemented) UpdateConnection(w http.ResponseWriter, r *http.Request, connectionID ConnectionId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the finished backups of a connection, most recent first
+// (GET /connections/{connectionID}/backups)
+func (_ Unimplemented) ListBackups(w http.ResponseWriter, r *http.Request, connectionID ConnectionId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a backup archive
+// (DELETE /connections/{connectionID}/backups/{backupID})
+func (_ Unimplemented) DeleteBackup(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, backupID BackupId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Queue the restore of a backup into a new or existing database
+// (POST /connections/{connectionID}/backups/{backupID}/restore)
+func (_ Unimplemented) RestoreBackup(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, backupID BackupId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List databases from a connection
 // (GET /connections/{connectionID}/databases)
 func (_ Unimplemented) ListDatabases(w http.ResponseWriter, r *http.Request, connectionID ConnectionId) {
@@ -372,6 +2012,90 @@ func (_ Unimplemented) CreateDatabase(w http.ResponseWriter, r *http.Request, co
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Drop a database
+// (DELETE /connections/{connectionID}/databases/{databaseName})
+func (_ Unimplemented) DropDatabase(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, params DropDatabaseParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Rename a database
+// (PATCH /connections/{connectionID}/databases/{databaseName})
+func (_ Unimplemented) RenameDatabase(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Queue a logical backup of a database or some of its tables
+// (POST /connections/{connectionID}/databases/{databaseName}/backups)
+func (_ Unimplemented) CreateBackup(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Dump the DDL of every object in a schema
+// (GET /connections/{connectionID}/databases/{databaseName}/ddl)
+func (_ Unimplemented) GetSchemaDDL(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, params GetSchemaDDLParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Reconstruct the CREATE statement of a schema object
+// (GET /connections/{connectionID}/databases/{databaseName}/ddl/{objectKind}/{objectName})
+func (_ Unimplemented) GetObjectDDL(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, objectKind ObjectKind, objectName string, params GetObjectDDLParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Show the execution plan of a statement
+// (POST /connections/{connectionID}/databases/{databaseName}/explain)
+func (_ Unimplemented) ExplainQuery(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List functions and procedures of a schema
+// (GET /connections/{connectionID}/databases/{databaseName}/functions)
+func (_ Unimplemented) ListFunctions(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, params ListFunctionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Queue a VACUUM, ANALYZE, REINDEX or REFRESH MATERIALIZED VIEW job
+// (POST /connections/{connectionID}/databases/{databaseName}/maintenance)
+func (_ Unimplemented) RunMaintenance(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List materialized views of a schema
+// (GET /connections/{connectionID}/databases/{databaseName}/materialized-views)
+func (_ Unimplemented) ListMaterializedViews(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, params ListMaterializedViewsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Decode the ACLs of a database and its objects, plus default privileges
+// (GET /connections/{connectionID}/databases/{databaseName}/privileges)
+func (_ Unimplemented) ListPrivileges(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, params ListPrivilegesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Effective table privileges of a role
+// (GET /connections/{connectionID}/databases/{databaseName}/privileges/effective)
+func (_ Unimplemented) GetEffectivePrivileges(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, params GetEffectivePrivilegesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// GRANT privileges, or ALTER DEFAULT PRIVILEGES ... GRANT
+// (POST /connections/{connectionID}/databases/{databaseName}/privileges/grant)
+func (_ Unimplemented) GrantPrivileges(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// REVOKE privileges, or ALTER DEFAULT PRIVILEGES ... REVOKE
+// (POST /connections/{connectionID}/databases/{databaseName}/privileges/revoke)
+func (_ Unimplemented) RevokePrivileges(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List sequences of a schema
+// (GET /connections/{connectionID}/databases/{databaseName}/sequences)
+func (_ Unimplemented) ListSequences(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, params ListSequencesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List tables from a database
 // (GET /connections/{connectionID}/databases/{databaseName}/tables)
 func (_ Unimplemented) ListTables(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName) {
@@ -390,74 +2114,2084 @@ func (_ Unimplemented) ListColumns(w http.ResponseWriter, r *http.Request, conne
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// ListIndexes
-// (GET /connections/{connectionID}/databases/{databaseName}/tables/{tableName}/indexes)
-func (_ Unimplemented) ListIndexes(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, tableName TableName) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// Get usage, maintenance and cache statistics of a table
+// (GET /connections/{connectionID}/databases/{databaseName}/tables/{tableName}/health)
+func (_ Unimplemented) GetTableHealth(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, tableName TableName, params GetTableHealthParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ListIndexes
+// (GET /connections/{connectionID}/databases/{databaseName}/tables/{tableName}/indexes)
+func (_ Unimplemented) ListIndexes(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, tableName TableName) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// QueryTableRows
+// (GET /connections/{connectionID}/databases/{databaseName}/tables/{tableName}/rows)
+func (_ Unimplemented) QueryTableRows(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, tableName TableName, params QueryTableRowsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a row in a table
+// (PUT /connections/{connectionID}/databases/{databaseName}/tables/{tableName}/rows)
+func (_ Unimplemented) UpdateTableRow(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, tableName TableName) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List triggers of a table
+// (GET /connections/{connectionID}/databases/{databaseName}/tables/{tableName}/triggers)
+func (_ Unimplemented) ListTriggers(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, tableName TableName, params ListTriggersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List enum, domain and composite types of a schema
+// (GET /connections/{connectionID}/databases/{databaseName}/types)
+func (_ Unimplemented) ListTypes(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, params ListTypesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List views of a schema
+// (GET /connections/{connectionID}/databases/{databaseName}/views)
+func (_ Unimplemented) ListViews(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, params ListViewsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Show which sessions block which, rooted at the blocking sessions
+// (GET /connections/{connectionID}/locks)
+func (_ Unimplemented) GetBlockingTree(w http.ResponseWriter, r *http.Request, connectionID ConnectionId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Read the sampled metrics history of a connection
+// (GET /connections/{connectionID}/metrics)
+func (_ Unimplemented) QueryMetrics(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, params QueryMetricsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Server Health & Overview
+// (GET /connections/{connectionID}/overview)
+func (_ Unimplemented) GetConnectionOverview(w http.ResponseWriter, r *http.Request, connectionID ConnectionId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Checks the connection status
+// (GET /connections/{connectionID}/ping)
+func (_ Unimplemented) PingConnection(w http.ResponseWriter, r *http.Request, connectionID ConnectionId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Show recovery status, streaming replication clients and replication slots
+// (GET /connections/{connectionID}/replication)
+func (_ Unimplemented) GetReplication(w http.ResponseWriter, r *http.Request, connectionID ConnectionId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List sessions
+// (GET /connections/{connectionID}/sessions)
+func (_ Unimplemented) ListSessions(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, params ListSessionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Cancel or terminate every session matching a filter
+// (POST /connections/{connectionID}/sessions/signal)
+func (_ Unimplemented) SignalSessions(w http.ResponseWriter, r *http.Request, connectionID ConnectionId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Kill a specific session
+// (DELETE /connections/{connectionID}/sessions/{pid})
+func (_ Unimplemented) KillSession(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, pid int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Cancel the running query of a session without closing it
+// (POST /connections/{connectionID}/sessions/{pid}/cancel)
+func (_ Unimplemented) CancelSession(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, pid int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List server configuration parameters grouped by category
+// (GET /connections/{connectionID}/settings)
+func (_ Unimplemented) ListSettings(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, params ListSettingsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Change parameters with ALTER SYSTEM and reload the configuration
+// (PATCH /connections/{connectionID}/settings)
+func (_ Unimplemented) AlterSettings(w http.ResponseWriter, r *http.Request, connectionID ConnectionId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Dials the sessions of the connection directly again
+// (DELETE /connections/{connectionID}/ssh-tunnel)
+func (_ Unimplemented) RemoveSSHTunnel(w http.ResponseWriter, r *http.Request, connectionID ConnectionId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Dials the sessions of the connection through an SSH bastion
+// (PUT /connections/{connectionID}/ssh-tunnel)
+func (_ Unimplemented) SetSSHTunnel(w http.ResponseWriter, r *http.Request, connectionID ConnectionId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Reset pg_stat_statements counters
+// (DELETE /connections/{connectionID}/statements)
+func (_ Unimplemented) ResetStatementStats(w http.ResponseWriter, r *http.Request, connectionID ConnectionId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the most expensive normalized queries from pg_stat_statements
+// (GET /connections/{connectionID}/statements)
+func (_ Unimplemented) ListTopStatements(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, params ListTopStatementsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List database users (roles)
+// (GET /connections/{connectionID}/users)
+func (_ Unimplemented) ListUsers(w http.ResponseWriter, r *http.Request, connectionID ConnectionId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a new database user
+// (POST /connections/{connectionID}/users)
+func (_ Unimplemented) CreateUser(w http.ResponseWriter, r *http.Request, connectionID ConnectionId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Drop a database user
+// (DELETE /connections/{connectionID}/users/{username})
+func (_ Unimplemented) DropUser(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, username Username, params DropUserParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Alter a database user (password, attributes, limits, expiry)
+// (PATCH /connections/{connectionID}/users/{username})
+func (_ Unimplemented) AlterUser(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, username Username) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Grant membership in a role to the user
+// (POST /connections/{connectionID}/users/{username}/memberships)
+func (_ Unimplemented) GrantRole(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, username Username) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke the user's membership in a role
+// (DELETE /connections/{connectionID}/users/{username}/memberships/{role})
+func (_ Unimplemented) RevokeRole(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, username Username, role string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Search the statements run through Mesa, most recent first
+// (GET /history)
+func (_ Unimplemented) SearchHistory(w http.ResponseWriter, r *http.Request, params SearchHistoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List jobs, most recent first
+// (GET /jobs)
+func (_ Unimplemented) ListJobs(w http.ResponseWriter, r *http.Request, params ListJobsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Queue a command as a background job
+// (POST /jobs)
+func (_ Unimplemented) SubmitJob(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a job, its progress and log
+// (GET /jobs/{jobID})
+func (_ Unimplemented) GetJob(w http.ResponseWriter, r *http.Request, jobID JobId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Cancel a queued or running job
+// (POST /jobs/{jobID}/cancel)
+func (_ Unimplemented) CancelJob(w http.ResponseWriter, r *http.Request, jobID JobId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Stream the state of a job as server-sent events until it finishes
+// (GET /jobs/{jobID}/events)
+func (_ Unimplemented) StreamJobEvents(w http.ResponseWriter, r *http.Request, jobID JobId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List saved queries ordered by name
+// (GET /saved-queries)
+func (_ Unimplemented) ListSavedQueries(w http.ResponseWriter, r *http.Request, params ListSavedQueriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Save a query
+// (POST /saved-queries)
+func (_ Unimplemented) CreateSavedQuery(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a saved query
+// (DELETE /saved-queries/{queryID})
+func (_ Unimplemented) DeleteSavedQuery(w http.ResponseWriter, r *http.Request, queryID SavedQueryId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a saved query
+// (GET /saved-queries/{queryID})
+func (_ Unimplemented) GetSavedQuery(w http.ResponseWriter, r *http.Request, queryID SavedQueryId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replace the definition of a saved query
+// (PUT /saved-queries/{queryID})
+func (_ Unimplemented) UpdateSavedQuery(w http.ResponseWriter, r *http.Request, queryID SavedQueryId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Run a saved query on a connection and database
+// (POST /saved-queries/{queryID}/execute)
+func (_ Unimplemented) ExecuteSavedQuery(w http.ResponseWriter, r *http.Request, queryID SavedQueryId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// ListAlerts operation middleware
+func (siw *ServerInterfaceWrapper) ListAlerts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAlertsParams
+
+	// ------------- Optional query parameter "connection_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "connection_id", r.URL.Query(), &params.ConnectionId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connection_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAlerts(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListAlertRules operation middleware
+func (siw *ServerInterfaceWrapper) ListAlertRules(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAlertRules(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateAlertRule operation middleware
+func (siw *ServerInterfaceWrapper) CreateAlertRule(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateAlertRule(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAlertRule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAlertRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ruleID" -------------
+	var ruleID AlertRuleId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ruleID", chi.URLParam(r, "ruleID"), &ruleID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAlertRule(w, r, ruleID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateAlertRule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateAlertRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ruleID" -------------
+	var ruleID AlertRuleId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ruleID", chi.URLParam(r, "ruleID"), &ruleID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateAlertRule(w, r, ruleID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TestAlertRule operation middleware
+func (siw *ServerInterfaceWrapper) TestAlertRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ruleID" -------------
+	var ruleID AlertRuleId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ruleID", chi.URLParam(r, "ruleID"), &ruleID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TestAlertRule(w, r, ruleID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListConnections operation middleware
+func (siw *ServerInterfaceWrapper) ListConnections(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListConnectionsParams
+
+	// ------------- Optional query parameter "environment" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "environment", r.URL.Query(), &params.Environment)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "environment", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListConnections(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateConnection operation middleware
+func (siw *ServerInterfaceWrapper) CreateConnection(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateConnection(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FindConnection operation middleware
+func (siw *ServerInterfaceWrapper) FindConnection(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindConnection(w, r, connectionID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateConnection operation middleware
+func (siw *ServerInterfaceWrapper) UpdateConnection(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateConnection(w, r, connectionID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListBackups operation middleware
+func (siw *ServerInterfaceWrapper) ListBackups(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListBackups(w, r, connectionID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteBackup operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBackup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "backupID" -------------
+	var backupID BackupId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "backupID", chi.URLParam(r, "backupID"), &backupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "backupID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteBackup(w, r, connectionID, backupID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RestoreBackup operation middleware
+func (siw *ServerInterfaceWrapper) RestoreBackup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "backupID" -------------
+	var backupID BackupId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "backupID", chi.URLParam(r, "backupID"), &backupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "backupID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreBackup(w, r, connectionID, backupID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListDatabases operation middleware
+func (siw *ServerInterfaceWrapper) ListDatabases(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListDatabases(w, r, connectionID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateDatabase operation middleware
+func (siw *ServerInterfaceWrapper) CreateDatabase(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateDatabase(w, r, connectionID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DropDatabase operation middleware
+func (siw *ServerInterfaceWrapper) DropDatabase(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "databaseName" -------------
+	var databaseName DatabaseName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "databaseName", chi.URLParam(r, "databaseName"), &databaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "databaseName", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DropDatabaseParams
+
+	// ------------- Required query parameter "confirm" -------------
+
+	if paramValue := r.URL.Query().Get("confirm"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "confirm"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "confirm", r.URL.Query(), &params.Confirm)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "confirm", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "force", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DropDatabase(w, r, connectionID, databaseName, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RenameDatabase operation middleware
+func (siw *ServerInterfaceWrapper) RenameDatabase(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "databaseName" -------------
+	var databaseName DatabaseName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "databaseName", chi.URLParam(r, "databaseName"), &databaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "databaseName", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RenameDatabase(w, r, connectionID, databaseName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateBackup operation middleware
+func (siw *ServerInterfaceWrapper) CreateBackup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "databaseName" -------------
+	var databaseName DatabaseName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "databaseName", chi.URLParam(r, "databaseName"), &databaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "databaseName", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateBackup(w, r, connectionID, databaseName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSchemaDDL operation middleware
+func (siw *ServerInterfaceWrapper) GetSchemaDDL(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "databaseName" -------------
+	var databaseName DatabaseName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "databaseName", chi.URLParam(r, "databaseName"), &databaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "databaseName", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSchemaDDLParams
+
+	// ------------- Optional query parameter "schema" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "schema", r.URL.Query(), &params.Schema)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schema", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSchemaDDL(w, r, connectionID, databaseName, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetObjectDDL operation middleware
+func (siw *ServerInterfaceWrapper) GetObjectDDL(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "databaseName" -------------
+	var databaseName DatabaseName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "databaseName", chi.URLParam(r, "databaseName"), &databaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "databaseName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "objectKind" -------------
+	var objectKind ObjectKind
+
+	err = runtime.BindStyledParameterWithOptions("simple", "objectKind", chi.URLParam(r, "objectKind"), &objectKind, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "objectKind", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "objectName" -------------
+	var objectName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "objectName", chi.URLParam(r, "objectName"), &objectName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "objectName", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetObjectDDLParams
+
+	// ------------- Optional query parameter "schema" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "schema", r.URL.Query(), &params.Schema)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schema", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetObjectDDL(w, r, connectionID, databaseName, objectKind, objectName, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExplainQuery operation middleware
+func (siw *ServerInterfaceWrapper) ExplainQuery(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "databaseName" -------------
+	var databaseName DatabaseName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "databaseName", chi.URLParam(r, "databaseName"), &databaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "databaseName", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExplainQuery(w, r, connectionID, databaseName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListFunctions operation middleware
+func (siw *ServerInterfaceWrapper) ListFunctions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "databaseName" -------------
+	var databaseName DatabaseName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "databaseName", chi.URLParam(r, "databaseName"), &databaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "databaseName", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListFunctionsParams
+
+	// ------------- Optional query parameter "schema" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "schema", r.URL.Query(), &params.Schema)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schema", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListFunctions(w, r, connectionID, databaseName, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RunMaintenance operation middleware
+func (siw *ServerInterfaceWrapper) RunMaintenance(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "databaseName" -------------
+	var databaseName DatabaseName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "databaseName", chi.URLParam(r, "databaseName"), &databaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "databaseName", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RunMaintenance(w, r, connectionID, databaseName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListMaterializedViews operation middleware
+func (siw *ServerInterfaceWrapper) ListMaterializedViews(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "databaseName" -------------
+	var databaseName DatabaseName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "databaseName", chi.URLParam(r, "databaseName"), &databaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "databaseName", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMaterializedViewsParams
+
+	// ------------- Optional query parameter "schema" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "schema", r.URL.Query(), &params.Schema)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schema", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMaterializedViews(w, r, connectionID, databaseName, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListPrivileges operation middleware
+func (siw *ServerInterfaceWrapper) ListPrivileges(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "databaseName" -------------
+	var databaseName DatabaseName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "databaseName", chi.URLParam(r, "databaseName"), &databaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "databaseName", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPrivilegesParams
+
+	// ------------- Optional query parameter "object_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "object_type", r.URL.Query(), &params.ObjectType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "object_type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "schema" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "schema", r.URL.Query(), &params.Schema)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schema", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPrivileges(w, r, connectionID, databaseName, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEffectivePrivileges operation middleware
+func (siw *ServerInterfaceWrapper) GetEffectivePrivileges(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "databaseName" -------------
+	var databaseName DatabaseName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "databaseName", chi.URLParam(r, "databaseName"), &databaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "databaseName", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEffectivePrivilegesParams
+
+	// ------------- Required query parameter "role" -------------
+
+	if paramValue := r.URL.Query().Get("role"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "role"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "role", r.URL.Query(), &params.Role)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "schema" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "schema", r.URL.Query(), &params.Schema)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schema", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEffectivePrivileges(w, r, connectionID, databaseName, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GrantPrivileges operation middleware
+func (siw *ServerInterfaceWrapper) GrantPrivileges(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "databaseName" -------------
+	var databaseName DatabaseName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "databaseName", chi.URLParam(r, "databaseName"), &databaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "databaseName", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GrantPrivileges(w, r, connectionID, databaseName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokePrivileges operation middleware
+func (siw *ServerInterfaceWrapper) RevokePrivileges(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "databaseName" -------------
+	var databaseName DatabaseName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "databaseName", chi.URLParam(r, "databaseName"), &databaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "databaseName", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokePrivileges(w, r, connectionID, databaseName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListSequences operation middleware
+func (siw *ServerInterfaceWrapper) ListSequences(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "databaseName" -------------
+	var databaseName DatabaseName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "databaseName", chi.URLParam(r, "databaseName"), &databaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "databaseName", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSequencesParams
+
+	// ------------- Optional query parameter "schema" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "schema", r.URL.Query(), &params.Schema)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schema", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSequences(w, r, connectionID, databaseName, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTables operation middleware
+func (siw *ServerInterfaceWrapper) ListTables(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "databaseName" -------------
+	var databaseName DatabaseName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "databaseName", chi.URLParam(r, "databaseName"), &databaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "databaseName", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTables(w, r, connectionID, databaseName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTable operation middleware
+func (siw *ServerInterfaceWrapper) CreateTable(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "databaseName" -------------
+	var databaseName DatabaseName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "databaseName", chi.URLParam(r, "databaseName"), &databaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "databaseName", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTable(w, r, connectionID, databaseName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListColumns operation middleware
+func (siw *ServerInterfaceWrapper) ListColumns(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "databaseName" -------------
+	var databaseName DatabaseName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "databaseName", chi.URLParam(r, "databaseName"), &databaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "databaseName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "tableName" -------------
+	var tableName TableName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tableName", chi.URLParam(r, "tableName"), &tableName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tableName", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListColumns(w, r, connectionID, databaseName, tableName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTableHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetTableHealth(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "databaseName" -------------
+	var databaseName DatabaseName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "databaseName", chi.URLParam(r, "databaseName"), &databaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "databaseName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "tableName" -------------
+	var tableName TableName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tableName", chi.URLParam(r, "tableName"), &tableName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tableName", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTableHealthParams
+
+	// ------------- Optional query parameter "schema" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "schema", r.URL.Query(), &params.Schema)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schema", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTableHealth(w, r, connectionID, databaseName, tableName, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListIndexes operation middleware
+func (siw *ServerInterfaceWrapper) ListIndexes(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "databaseName" -------------
+	var databaseName DatabaseName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "databaseName", chi.URLParam(r, "databaseName"), &databaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "databaseName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "tableName" -------------
+	var tableName TableName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tableName", chi.URLParam(r, "tableName"), &tableName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tableName", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListIndexes(w, r, connectionID, databaseName, tableName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// QueryTableRows operation middleware
+func (siw *ServerInterfaceWrapper) QueryTableRows(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "databaseName" -------------
+	var databaseName DatabaseName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "databaseName", chi.URLParam(r, "databaseName"), &databaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "databaseName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "tableName" -------------
+	var tableName TableName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tableName", chi.URLParam(r, "tableName"), &tableName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tableName", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params QueryTableRowsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_by", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_by", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_order", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_order", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.QueryTableRows(w, r, connectionID, databaseName, tableName, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateTableRow operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTableRow(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "databaseName" -------------
+	var databaseName DatabaseName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "databaseName", chi.URLParam(r, "databaseName"), &databaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "databaseName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "tableName" -------------
+	var tableName TableName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tableName", chi.URLParam(r, "tableName"), &tableName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tableName", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateTableRow(w, r, connectionID, databaseName, tableName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTriggers operation middleware
+func (siw *ServerInterfaceWrapper) ListTriggers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "databaseName" -------------
+	var databaseName DatabaseName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "databaseName", chi.URLParam(r, "databaseName"), &databaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "databaseName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "tableName" -------------
+	var tableName TableName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tableName", chi.URLParam(r, "tableName"), &tableName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tableName", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTriggersParams
+
+	// ------------- Optional query parameter "schema" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "schema", r.URL.Query(), &params.Schema)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schema", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTriggers(w, r, connectionID, databaseName, tableName, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTypes operation middleware
+func (siw *ServerInterfaceWrapper) ListTypes(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "databaseName" -------------
+	var databaseName DatabaseName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "databaseName", chi.URLParam(r, "databaseName"), &databaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "databaseName", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTypesParams
+
+	// ------------- Optional query parameter "schema" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "schema", r.URL.Query(), &params.Schema)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schema", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTypes(w, r, connectionID, databaseName, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListViews operation middleware
+func (siw *ServerInterfaceWrapper) ListViews(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "databaseName" -------------
+	var databaseName DatabaseName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "databaseName", chi.URLParam(r, "databaseName"), &databaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "databaseName", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListViewsParams
+
+	// ------------- Optional query parameter "schema" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "schema", r.URL.Query(), &params.Schema)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schema", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListViews(w, r, connectionID, databaseName, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBlockingTree operation middleware
+func (siw *ServerInterfaceWrapper) GetBlockingTree(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBlockingTree(w, r, connectionID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// QueryMetrics operation middleware
+func (siw *ServerInterfaceWrapper) QueryMetrics(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params QueryMetricsParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "resolution" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resolution", r.URL.Query(), &params.Resolution)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resolution", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.QueryMetrics(w, r, connectionID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetConnectionOverview operation middleware
+func (siw *ServerInterfaceWrapper) GetConnectionOverview(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetConnectionOverview(w, r, connectionID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PingConnection operation middleware
+func (siw *ServerInterfaceWrapper) PingConnection(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PingConnection(w, r, connectionID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReplication operation middleware
+func (siw *ServerInterfaceWrapper) GetReplication(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReplication(w, r, connectionID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListSessions operation middleware
+func (siw *ServerInterfaceWrapper) ListSessions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSessionsParams
+
+	// ------------- Optional query parameter "user" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user", r.URL.Query(), &params.User)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "database" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "database", r.URL.Query(), &params.Database)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "database", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", r.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "min_duration_seconds" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_duration_seconds", r.URL.Query(), &params.MinDurationSeconds)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "min_duration_seconds", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSessions(w, r, connectionID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SignalSessions operation middleware
+func (siw *ServerInterfaceWrapper) SignalSessions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SignalSessions(w, r, connectionID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// KillSession operation middleware
+func (siw *ServerInterfaceWrapper) KillSession(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "pid" -------------
+	var pid int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pid", chi.URLParam(r, "pid"), &pid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.KillSession(w, r, connectionID, pid)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CancelSession operation middleware
+func (siw *ServerInterfaceWrapper) CancelSession(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "pid" -------------
+	var pid int
 
-// QueryTableRows
-// (GET /connections/{connectionID}/databases/{databaseName}/tables/{tableName}/rows)
-func (_ Unimplemented) QueryTableRows(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, tableName TableName, params QueryTableRowsParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "pid", chi.URLParam(r, "pid"), &pid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pid", Err: err})
+		return
+	}
 
-// Update a row in a table
-// (PUT /connections/{connectionID}/databases/{databaseName}/tables/{tableName}/rows)
-func (_ Unimplemented) UpdateTableRow(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, databaseName DatabaseName, tableName TableName) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelSession(w, r, connectionID, pid)
+	}))
 
-// Get Server Health & Overview
-// (GET /connections/{connectionID}/overview)
-func (_ Unimplemented) GetConnectionOverview(w http.ResponseWriter, r *http.Request, connectionID ConnectionId) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-// Checks the connection status
-// (GET /connections/{connectionID}/ping)
-func (_ Unimplemented) PingConnection(w http.ResponseWriter, r *http.Request, connectionID ConnectionId) {
-	w.WriteHeader(http.StatusNotImplemented)
+	handler.ServeHTTP(w, r)
 }
 
-// List active sessions
-// (GET /connections/{connectionID}/sessions)
-func (_ Unimplemented) ListSessions(w http.ResponseWriter, r *http.Request, connectionID ConnectionId) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// ListSettings operation middleware
+func (siw *ServerInterfaceWrapper) ListSettings(w http.ResponseWriter, r *http.Request) {
 
-// Kill a specific session
-// (DELETE /connections/{connectionID}/sessions/{pid})
-func (_ Unimplemented) KillSession(w http.ResponseWriter, r *http.Request, connectionID ConnectionId, pid int) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	var err error
 
-// List database users (roles)
-// (GET /connections/{connectionID}/users)
-func (_ Unimplemented) ListUsers(w http.ResponseWriter, r *http.Request, connectionID ConnectionId) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
 
-// Create a new database user
-// (POST /connections/{connectionID}/users)
-func (_ Unimplemented) CreateUser(w http.ResponseWriter, r *http.Request, connectionID ConnectionId) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSettingsParams
 
-type MiddlewareFunc func(http.Handler) http.Handler
+	// ------------- Optional query parameter "search" -------------
 
-// ListConnections operation middleware
-func (siw *ServerInterfaceWrapper) ListConnections(w http.ResponseWriter, r *http.Request) {
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListConnections(w, r)
+		siw.Handler.ListSettings(w, r, connectionID, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -467,11 +4201,22 @@ func (siw *ServerInterfaceWrapper) ListConnections(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// CreateConnection operation middleware
-func (siw *ServerInterfaceWrapper) CreateConnection(w http.ResponseWriter, r *http.Request) {
+// AlterSettings operation middleware
+func (siw *ServerInterfaceWrapper) AlterSettings(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateConnection(w, r)
+		siw.Handler.AlterSettings(w, r, connectionID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -481,8 +4226,8 @@ func (siw *ServerInterfaceWrapper) CreateConnection(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// FindConnection operation middleware
-func (siw *ServerInterfaceWrapper) FindConnection(w http.ResponseWriter, r *http.Request) {
+// RemoveSSHTunnel operation middleware
+func (siw *ServerInterfaceWrapper) RemoveSSHTunnel(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -496,7 +4241,7 @@ func (siw *ServerInterfaceWrapper) FindConnection(w http.ResponseWriter, r *http
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.FindConnection(w, r, connectionID)
+		siw.Handler.RemoveSSHTunnel(w, r, connectionID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -506,8 +4251,8 @@ func (siw *ServerInterfaceWrapper) FindConnection(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// ListDatabases operation middleware
-func (siw *ServerInterfaceWrapper) ListDatabases(w http.ResponseWriter, r *http.Request) {
+// SetSSHTunnel operation middleware
+func (siw *ServerInterfaceWrapper) SetSSHTunnel(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -521,7 +4266,7 @@ func (siw *ServerInterfaceWrapper) ListDatabases(w http.ResponseWriter, r *http.
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListDatabases(w, r, connectionID)
+		siw.Handler.SetSSHTunnel(w, r, connectionID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -531,8 +4276,8 @@ func (siw *ServerInterfaceWrapper) ListDatabases(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// CreateDatabase operation middleware
-func (siw *ServerInterfaceWrapper) CreateDatabase(w http.ResponseWriter, r *http.Request) {
+// ResetStatementStats operation middleware
+func (siw *ServerInterfaceWrapper) ResetStatementStats(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -546,7 +4291,7 @@ func (siw *ServerInterfaceWrapper) CreateDatabase(w http.ResponseWriter, r *http
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateDatabase(w, r, connectionID)
+		siw.Handler.ResetStatementStats(w, r, connectionID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -556,8 +4301,8 @@ func (siw *ServerInterfaceWrapper) CreateDatabase(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// ListTables operation middleware
-func (siw *ServerInterfaceWrapper) ListTables(w http.ResponseWriter, r *http.Request) {
+// ListTopStatements operation middleware
+func (siw *ServerInterfaceWrapper) ListTopStatements(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -570,17 +4315,27 @@ func (siw *ServerInterfaceWrapper) ListTables(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	// ------------- Path parameter "databaseName" -------------
-	var databaseName DatabaseName
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTopStatementsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "databaseName", chi.URLParam(r, "databaseName"), &databaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "order_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "databaseName", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTables(w, r, connectionID, databaseName)
+		siw.Handler.ListTopStatements(w, r, connectionID, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -590,8 +4345,8 @@ func (siw *ServerInterfaceWrapper) ListTables(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// CreateTable operation middleware
-func (siw *ServerInterfaceWrapper) CreateTable(w http.ResponseWriter, r *http.Request) {
+// ListUsers operation middleware
+func (siw *ServerInterfaceWrapper) ListUsers(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -604,17 +4359,33 @@ func (siw *ServerInterfaceWrapper) CreateTable(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	// ------------- Path parameter "databaseName" -------------
-	var databaseName DatabaseName
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListUsers(w, r, connectionID)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "databaseName", chi.URLParam(r, "databaseName"), &databaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateUser operation middleware
+func (siw *ServerInterfaceWrapper) CreateUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "databaseName", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateTable(w, r, connectionID, databaseName)
+		siw.Handler.CreateUser(w, r, connectionID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -624,8 +4395,8 @@ func (siw *ServerInterfaceWrapper) CreateTable(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// ListColumns operation middleware
-func (siw *ServerInterfaceWrapper) ListColumns(w http.ResponseWriter, r *http.Request) {
+// DropUser operation middleware
+func (siw *ServerInterfaceWrapper) DropUser(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -638,26 +4409,36 @@ func (siw *ServerInterfaceWrapper) ListColumns(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	// ------------- Path parameter "databaseName" -------------
-	var databaseName DatabaseName
+	// ------------- Path parameter "username" -------------
+	var username Username
 
-	err = runtime.BindStyledParameterWithOptions("simple", "databaseName", chi.URLParam(r, "databaseName"), &databaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "username", chi.URLParam(r, "username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "databaseName", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "tableName" -------------
-	var tableName TableName
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DropUserParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "tableName", chi.URLParam(r, "tableName"), &tableName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "reassign_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reassign_to", r.URL.Query(), &params.ReassignTo)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tableName", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reassign_to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "drop_owned" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "drop_owned", r.URL.Query(), &params.DropOwned)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "drop_owned", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListColumns(w, r, connectionID, databaseName, tableName)
+		siw.Handler.DropUser(w, r, connectionID, username, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -667,8 +4448,8 @@ func (siw *ServerInterfaceWrapper) ListColumns(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// ListIndexes operation middleware
-func (siw *ServerInterfaceWrapper) ListIndexes(w http.ResponseWriter, r *http.Request) {
+// AlterUser operation middleware
+func (siw *ServerInterfaceWrapper) AlterUser(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -681,26 +4462,51 @@ func (siw *ServerInterfaceWrapper) ListIndexes(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	// ------------- Path parameter "databaseName" -------------
-	var databaseName DatabaseName
+	// ------------- Path parameter "username" -------------
+	var username Username
 
-	err = runtime.BindStyledParameterWithOptions("simple", "databaseName", chi.URLParam(r, "databaseName"), &databaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "username", chi.URLParam(r, "username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "databaseName", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "tableName" -------------
-	var tableName TableName
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AlterUser(w, r, connectionID, username)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "tableName", chi.URLParam(r, "tableName"), &tableName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GrantRole operation middleware
+func (siw *ServerInterfaceWrapper) GrantRole(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "connectionID" -------------
+	var connectionID ConnectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tableName", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "username" -------------
+	var username Username
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", chi.URLParam(r, "username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListIndexes(w, r, connectionID, databaseName, tableName)
+		siw.Handler.GrantRole(w, r, connectionID, username)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -710,8 +4516,8 @@ func (siw *ServerInterfaceWrapper) ListIndexes(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// QueryTableRows operation middleware
-func (siw *ServerInterfaceWrapper) QueryTableRows(w http.ResponseWriter, r *http.Request) {
+// RevokeRole operation middleware
+func (siw *ServerInterfaceWrapper) RevokeRole(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -724,27 +4530,99 @@ func (siw *ServerInterfaceWrapper) QueryTableRows(w http.ResponseWriter, r *http
 		return
 	}
 
-	// ------------- Path parameter "databaseName" -------------
-	var databaseName DatabaseName
+	// ------------- Path parameter "username" -------------
+	var username Username
 
-	err = runtime.BindStyledParameterWithOptions("simple", "databaseName", chi.URLParam(r, "databaseName"), &databaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "username", chi.URLParam(r, "username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "databaseName", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "role" -------------
+	var role string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "role", chi.URLParam(r, "role"), &role, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeRole(w, r, connectionID, username, role)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SearchHistory operation middleware
+func (siw *ServerInterfaceWrapper) SearchHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchHistoryParams
+
+	// ------------- Optional query parameter "connection_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "connection_id", r.URL.Query(), &params.ConnectionId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connection_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "database" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "database", r.URL.Query(), &params.Database)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "database", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "source" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "source", r.URL.Query(), &params.Source)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "source", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", r.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "tableName" -------------
-	var tableName TableName
+	// ------------- Optional query parameter "failed" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "tableName", chi.URLParam(r, "tableName"), &tableName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "failed", r.URL.Query(), &params.Failed)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tableName", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "failed", Err: err})
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params QueryTableRowsParams
-
 	// ------------- Optional query parameter "limit" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
@@ -753,32 +4631,51 @@ func (siw *ServerInterfaceWrapper) QueryTableRows(w http.ResponseWriter, r *http
 		return
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SearchHistory(w, r, params)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListJobs operation middleware
+func (siw *ServerInterfaceWrapper) ListJobs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListJobsParams
+
+	// ------------- Optional query parameter "connection_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "connection_id", r.URL.Query(), &params.ConnectionId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connection_id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort_by" -------------
+	// ------------- Optional query parameter "status" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_by", r.URL.Query(), &params.SortBy)
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_by", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort_order" -------------
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_order", r.URL.Query(), &params.SortOrder)
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_order", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.QueryTableRows(w, r, connectionID, databaseName, tableName, params)
+		siw.Handler.ListJobs(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -788,40 +4685,36 @@ func (siw *ServerInterfaceWrapper) QueryTableRows(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateTableRow operation middleware
-func (siw *ServerInterfaceWrapper) UpdateTableRow(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// SubmitJob operation middleware
+func (siw *ServerInterfaceWrapper) SubmitJob(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Path parameter "connectionID" -------------
-	var connectionID ConnectionId
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SubmitJob(w, r)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Path parameter "databaseName" -------------
-	var databaseName DatabaseName
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "databaseName", chi.URLParam(r, "databaseName"), &databaseName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "databaseName", Err: err})
-		return
-	}
+// GetJob operation middleware
+func (siw *ServerInterfaceWrapper) GetJob(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Path parameter "tableName" -------------
-	var tableName TableName
+	var err error
 
-	err = runtime.BindStyledParameterWithOptions("simple", "tableName", chi.URLParam(r, "tableName"), &tableName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Path parameter "jobID" -------------
+	var jobID JobId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "jobID", chi.URLParam(r, "jobID"), &jobID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tableName", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobID", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateTableRow(w, r, connectionID, databaseName, tableName)
+		siw.Handler.GetJob(w, r, jobID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -831,22 +4724,22 @@ func (siw *ServerInterfaceWrapper) UpdateTableRow(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetConnectionOverview operation middleware
-func (siw *ServerInterfaceWrapper) GetConnectionOverview(w http.ResponseWriter, r *http.Request) {
+// CancelJob operation middleware
+func (siw *ServerInterfaceWrapper) CancelJob(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "connectionID" -------------
-	var connectionID ConnectionId
+	// ------------- Path parameter "jobID" -------------
+	var jobID JobId
 
-	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "jobID", chi.URLParam(r, "jobID"), &jobID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobID", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetConnectionOverview(w, r, connectionID)
+		siw.Handler.CancelJob(w, r, jobID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -856,22 +4749,22 @@ func (siw *ServerInterfaceWrapper) GetConnectionOverview(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// PingConnection operation middleware
-func (siw *ServerInterfaceWrapper) PingConnection(w http.ResponseWriter, r *http.Request) {
+// StreamJobEvents operation middleware
+func (siw *ServerInterfaceWrapper) StreamJobEvents(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "connectionID" -------------
-	var connectionID ConnectionId
+	// ------------- Path parameter "jobID" -------------
+	var jobID JobId
 
-	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "jobID", chi.URLParam(r, "jobID"), &jobID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobID", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PingConnection(w, r, connectionID)
+		siw.Handler.StreamJobEvents(w, r, jobID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -881,22 +4774,40 @@ func (siw *ServerInterfaceWrapper) PingConnection(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// ListSessions operation middleware
-func (siw *ServerInterfaceWrapper) ListSessions(w http.ResponseWriter, r *http.Request) {
+// ListSavedQueries operation middleware
+func (siw *ServerInterfaceWrapper) ListSavedQueries(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "connectionID" -------------
-	var connectionID ConnectionId
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSavedQueriesParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "connection_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "connection_id", r.URL.Query(), &params.ConnectionId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connection_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListSessions(w, r, connectionID)
+		siw.Handler.ListSavedQueries(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -906,31 +4817,61 @@ func (siw *ServerInterfaceWrapper) ListSessions(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// KillSession operation middleware
-func (siw *ServerInterfaceWrapper) KillSession(w http.ResponseWriter, r *http.Request) {
+// CreateSavedQuery operation middleware
+func (siw *ServerInterfaceWrapper) CreateSavedQuery(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSavedQuery(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteSavedQuery operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSavedQuery(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "connectionID" -------------
-	var connectionID ConnectionId
+	// ------------- Path parameter "queryID" -------------
+	var queryID SavedQueryId
 
-	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "queryID", chi.URLParam(r, "queryID"), &queryID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "queryID", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "pid" -------------
-	var pid int
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSavedQuery(w, r, queryID)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "pid", chi.URLParam(r, "pid"), &pid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSavedQuery operation middleware
+func (siw *ServerInterfaceWrapper) GetSavedQuery(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "queryID" -------------
+	var queryID SavedQueryId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "queryID", chi.URLParam(r, "queryID"), &queryID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pid", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "queryID", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.KillSession(w, r, connectionID, pid)
+		siw.Handler.GetSavedQuery(w, r, queryID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -940,22 +4881,22 @@ func (siw *ServerInterfaceWrapper) KillSession(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// ListUsers operation middleware
-func (siw *ServerInterfaceWrapper) ListUsers(w http.ResponseWriter, r *http.Request) {
+// UpdateSavedQuery operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSavedQuery(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "connectionID" -------------
-	var connectionID ConnectionId
+	// ------------- Path parameter "queryID" -------------
+	var queryID SavedQueryId
 
-	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "queryID", chi.URLParam(r, "queryID"), &queryID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "queryID", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListUsers(w, r, connectionID)
+		siw.Handler.UpdateSavedQuery(w, r, queryID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -965,22 +4906,22 @@ func (siw *ServerInterfaceWrapper) ListUsers(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// CreateUser operation middleware
-func (siw *ServerInterfaceWrapper) CreateUser(w http.ResponseWriter, r *http.Request) {
+// ExecuteSavedQuery operation middleware
+func (siw *ServerInterfaceWrapper) ExecuteSavedQuery(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "connectionID" -------------
-	var connectionID ConnectionId
+	// ------------- Path parameter "queryID" -------------
+	var queryID SavedQueryId
 
-	err = runtime.BindStyledParameterWithOptions("simple", "connectionID", chi.URLParam(r, "connectionID"), &connectionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "queryID", chi.URLParam(r, "queryID"), &queryID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connectionID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "queryID", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateUser(w, r, connectionID)
+		siw.Handler.ExecuteSavedQuery(w, r, queryID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1103,6 +5044,24 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/alerts", wrapper.ListAlerts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/alerts/rules", wrapper.ListAlertRules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/alerts/rules", wrapper.CreateAlertRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/alerts/rules/{ruleID}", wrapper.DeleteAlertRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/alerts/rules/{ruleID}", wrapper.UpdateAlertRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/alerts/rules/{ruleID}/test", wrapper.TestAlertRule)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/connections", wrapper.ListConnections)
 	})
@@ -1112,12 +5071,66 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/connections/{connectionID}", wrapper.FindConnection)
 	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/connections/{connectionID}", wrapper.UpdateConnection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/connections/{connectionID}/backups", wrapper.ListBackups)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/connections/{connectionID}/backups/{backupID}", wrapper.DeleteBackup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/connections/{connectionID}/backups/{backupID}/restore", wrapper.RestoreBackup)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/connections/{connectionID}/databases", wrapper.ListDatabases)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/connections/{connectionID}/databases", wrapper.CreateDatabase)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/connections/{connectionID}/databases/{databaseName}", wrapper.DropDatabase)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/connections/{connectionID}/databases/{databaseName}", wrapper.RenameDatabase)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/connections/{connectionID}/databases/{databaseName}/backups", wrapper.CreateBackup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/connections/{connectionID}/databases/{databaseName}/ddl", wrapper.GetSchemaDDL)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/connections/{connectionID}/databases/{databaseName}/ddl/{objectKind}/{objectName}", wrapper.GetObjectDDL)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/connections/{connectionID}/databases/{databaseName}/explain", wrapper.ExplainQuery)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/connections/{connectionID}/databases/{databaseName}/functions", wrapper.ListFunctions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/connections/{connectionID}/databases/{databaseName}/maintenance", wrapper.RunMaintenance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/connections/{connectionID}/databases/{databaseName}/materialized-views", wrapper.ListMaterializedViews)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/connections/{connectionID}/databases/{databaseName}/privileges", wrapper.ListPrivileges)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/connections/{connectionID}/databases/{databaseName}/privileges/effective", wrapper.GetEffectivePrivileges)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/connections/{connectionID}/databases/{databaseName}/privileges/grant", wrapper.GrantPrivileges)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/connections/{connectionID}/databases/{databaseName}/privileges/revoke", wrapper.RevokePrivileges)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/connections/{connectionID}/databases/{databaseName}/sequences", wrapper.ListSequences)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/connections/{connectionID}/databases/{databaseName}/tables", wrapper.ListTables)
 	})
@@ -1127,6 +5140,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/connections/{connectionID}/databases/{databaseName}/tables/{tableName}/columns", wrapper.ListColumns)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/connections/{connectionID}/databases/{databaseName}/tables/{tableName}/health", wrapper.GetTableHealth)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/connections/{connectionID}/databases/{databaseName}/tables/{tableName}/indexes", wrapper.ListIndexes)
 	})
@@ -1136,24 +5152,114 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/connections/{connectionID}/databases/{databaseName}/tables/{tableName}/rows", wrapper.UpdateTableRow)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/connections/{connectionID}/databases/{databaseName}/tables/{tableName}/triggers", wrapper.ListTriggers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/connections/{connectionID}/databases/{databaseName}/types", wrapper.ListTypes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/connections/{connectionID}/databases/{databaseName}/views", wrapper.ListViews)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/connections/{connectionID}/locks", wrapper.GetBlockingTree)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/connections/{connectionID}/metrics", wrapper.QueryMetrics)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/connections/{connectionID}/overview", wrapper.GetConnectionOverview)
 	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/connections/{connectionID}/ping", wrapper.PingConnection)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/connections/{connectionID}/replication", wrapper.GetReplication)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/connections/{connectionID}/sessions", wrapper.ListSessions)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/connections/{connectionID}/sessions/signal", wrapper.SignalSessions)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/connections/{connectionID}/sessions/{pid}", wrapper.KillSession)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/connections/{connectionID}/sessions/{pid}/cancel", wrapper.CancelSession)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/connections/{connectionID}/settings", wrapper.ListSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/connections/{connectionID}/settings", wrapper.AlterSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/connections/{connectionID}/ssh-tunnel", wrapper.RemoveSSHTunnel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/connections/{connectionID}/ssh-tunnel", wrapper.SetSSHTunnel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/connections/{connectionID}/statements", wrapper.ResetStatementStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/connections/{connectionID}/statements", wrapper.ListTopStatements)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/connections/{connectionID}/users", wrapper.ListUsers)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/connections/{connectionID}/users", wrapper.CreateUser)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/connections/{connectionID}/users/{username}", wrapper.DropUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/connections/{connectionID}/users/{username}", wrapper.AlterUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/connections/{connectionID}/users/{username}/memberships", wrapper.GrantRole)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/connections/{connectionID}/users/{username}/memberships/{role}", wrapper.RevokeRole)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/history", wrapper.SearchHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/jobs", wrapper.ListJobs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/jobs", wrapper.SubmitJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/jobs/{jobID}", wrapper.GetJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/jobs/{jobID}/cancel", wrapper.CancelJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/jobs/{jobID}/events", wrapper.StreamJobEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/saved-queries", wrapper.ListSavedQueries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/saved-queries", wrapper.CreateSavedQuery)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/saved-queries/{queryID}", wrapper.DeleteSavedQuery)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/saved-queries/{queryID}", wrapper.GetSavedQuery)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/saved-queries/{queryID}", wrapper.UpdateSavedQuery)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/saved-queries/{queryID}/execute", wrapper.ExecuteSavedQuery)
+	})
 
 	return r
 }
@@ -4,13 +4,23 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
+	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/felipemalacarne/mesa/internal/application/commands"
+	"github.com/felipemalacarne/mesa/internal/application/jobs"
 	"github.com/felipemalacarne/mesa/internal/application/queries"
+	"github.com/felipemalacarne/mesa/internal/domain/alert"
+	"github.com/felipemalacarne/mesa/internal/domain/backup"
 	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/history"
+	"github.com/felipemalacarne/mesa/internal/domain/job"
+	"github.com/felipemalacarne/mesa/internal/domain/metric"
+	"github.com/felipemalacarne/mesa/internal/domain/savedquery"
 	"github.com/felipemalacarne/mesa/internal/transport/rest/contract"
 	"github.com/felipemalacarne/mesa/web"
 	"github.com/google/uuid"
@@ -24,7 +34,7 @@ var (
 
 func (s *Server) healthCheck(w http.ResponseWriter, r *http.Request) {
 	if _, err := w.Write([]byte(`{"status": "ok"}`)); err != nil {
-		log.Printf("healthCheck write response: %v", err)
+		slog.WarnContext(r.Context(), "healthCheck write response", "error", err)
 	}
 }
 
@@ -38,24 +48,33 @@ func (s *Server) webHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	if err := file.Close(); err != nil {
-		log.Printf("webHandler close file %q: %v", r.URL.Path, err)
+		slog.WarnContext(r.Context(), "webHandler close file", "path", r.URL.Path, "error", err)
 	}
 	fileServer.ServeHTTP(w, r)
 }
 
-func (s *Server) ListConnections(w http.ResponseWriter, r *http.Request) {
-	var query queries.ListConnections
+func (s *Server) ListConnections(w http.ResponseWriter, r *http.Request, params contract.ListConnectionsParams) {
+	query := queries.ListConnections{
+		Tag:    ptrToString(params.Tag),
+		Search: ptrToString(params.Search),
+	}
+	if params.Environment != nil {
+		query.Environment = string(*params.Environment)
+	}
 
 	conns, err := s.app.Queries.ListConnections.Handle(r.Context(), query)
 	if err != nil {
+		if errors.Is(err, connection.ErrInvalidEnvironment) {
+			s.respondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
 	resp := make([]connectionResponse, len(conns))
 	for i, c := range conns {
-		resp[i] = newConnectionResponse(c)
-		resp[i].Status = "unknown"
+		resp[i] = newConnectionResponse(c.Connection, c.Health)
 	}
 
 	s.respondJSON(w, http.StatusOK, resp)
@@ -66,12 +85,12 @@ func (s *Server) FindConnection(w http.ResponseWriter, r *http.Request, connecti
 
 	conn, err := s.app.Queries.FindConnection.Handle(r.Context(), queries.FindConnection{ConnectionID: id})
 	if err != nil {
-		log.Printf("ERROR: getConnection find connection %q: %v", connectionID, err)
+		slog.ErrorContext(r.Context(), "getConnection find connection", "error", err)
 		http.Error(w, ErrConnectionNotFound, http.StatusNotFound)
 		return
 	}
 
-	s.respondJSON(w, http.StatusOK, newConnectionResponse(conn))
+	s.respondJSON(w, http.StatusOK, newConnectionResponse(conn.Connection, conn.Health))
 }
 
 func (s *Server) CreateConnection(w http.ResponseWriter, r *http.Request) {
@@ -83,21 +102,132 @@ func (s *Server) CreateConnection(w http.ResponseWriter, r *http.Request) {
 	}
 
 	cmd := commands.CreateConnection{
-		Name:     body.Name,
-		Driver:   string(body.Driver),
-		Host:     body.Host,
-		Port:     body.Port,
-		Username: body.Username,
-		Password: body.Password,
+		Name:        body.Name,
+		Driver:      string(body.Driver),
+		Host:        body.Host,
+		Port:        body.Port,
+		Username:    body.Username,
+		Password:    body.Password,
+		Color:       ptrToString(body.Color),
+		Description: ptrToString(body.Description),
+		ReadOnly:    ptrToBool(body.ReadOnly),
+	}
+	if body.Environment != nil {
+		cmd.Environment = string(*body.Environment)
+	}
+	if body.Tags != nil {
+		cmd.Tags = *body.Tags
+	}
+	if body.Protection != nil {
+		protection := string(*body.Protection)
+		cmd.Protection = &protection
+	}
+	if body.SshTunnel != nil {
+		cmd.Tunnel = mapSSHTunnel(*body.SshTunnel)
 	}
 
 	conn, err := s.app.Commands.CreateConnection.Handle(r.Context(), cmd)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.respondConnectionError(w, r, "createConnection", err)
+		return
+	}
+
+	s.respondJSON(w, http.StatusCreated, newConnectionResponse(conn, nil))
+}
+
+func (s *Server) UpdateConnection(w http.ResponseWriter, r *http.Request, connectionID contract.ConnectionId) {
+	var body contract.UpdateConnectionRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		s.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := commands.UpdateConnectionCmd{
+		ConnectionID: uuid.UUID(connectionID),
+		Tags:         body.Tags,
+		Color:        body.Color,
+		Description:  body.Description,
+		ReadOnly:     body.ReadOnly,
+	}
+	if body.Environment != nil {
+		environment := string(*body.Environment)
+		cmd.Environment = &environment
+	}
+	if body.Protection != nil {
+		protection := string(*body.Protection)
+		cmd.Protection = &protection
+	}
+
+	if _, err := s.app.Commands.UpdateConnection.Handle(r.Context(), cmd); err != nil {
+		s.respondConnectionError(w, r, "updateConnection", err)
+		return
+	}
+
+	// Read back through the query to include the last health check.
+	view, err := s.app.Queries.FindConnection.Handle(r.Context(), queries.FindConnection{ConnectionID: cmd.ConnectionID})
+	if err != nil {
+		s.respondConnectionError(w, r, "updateConnection", err)
+		return
+	}
+
+	s.respondJSON(w, http.StatusOK, newConnectionResponse(view.Connection, view.Health))
+}
+
+func (s *Server) SetSSHTunnel(w http.ResponseWriter, r *http.Request, connectionID contract.ConnectionId) {
+	var body contract.SSHTunnelRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		s.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.setSSHTunnel(w, r, "setSSHTunnel", commands.SetSSHTunnelCmd{
+		ConnectionID: uuid.UUID(connectionID),
+		Tunnel:       mapSSHTunnel(body),
+	})
+}
+
+func (s *Server) RemoveSSHTunnel(w http.ResponseWriter, r *http.Request, connectionID contract.ConnectionId) {
+	s.setSSHTunnel(w, r, "removeSSHTunnel", commands.SetSSHTunnelCmd{ConnectionID: uuid.UUID(connectionID)})
+}
+
+func (s *Server) setSSHTunnel(w http.ResponseWriter, r *http.Request, operation string, cmd commands.SetSSHTunnelCmd) {
+	if _, err := s.app.Commands.SetSSHTunnel.Handle(r.Context(), cmd); err != nil {
+		s.respondConnectionError(w, r, operation, err)
+		return
+	}
+
+	view, err := s.app.Queries.FindConnection.Handle(r.Context(), queries.FindConnection{ConnectionID: cmd.ConnectionID})
+	if err != nil {
+		s.respondConnectionError(w, r, operation, err)
 		return
 	}
 
-	s.respondJSON(w, http.StatusCreated, newConnectionResponse(conn))
+	s.respondJSON(w, http.StatusOK, newConnectionResponse(view.Connection, view.Health))
+}
+
+// respondConnectionError maps the errors of the commands that create or change a connection.
+func (s *Server) respondConnectionError(w http.ResponseWriter, r *http.Request, operation string, err error) {
+	if s.respondGuardError(w, err) {
+		return
+	}
+	switch {
+	case errors.Is(err, commands.ErrConnectionNotFound),
+		errors.Is(err, queries.ErrConnectionNotFound):
+		s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
+	case errors.Is(err, connection.ErrInvalidDriver),
+		errors.Is(err, connection.ErrInvalidPort),
+		errors.Is(err, connection.ErrInvalidEnvironment),
+		errors.Is(err, connection.ErrInvalidProtection),
+		errors.Is(err, connection.ErrInvalidColor),
+		errors.Is(err, connection.ErrInvalidTunnelAuth),
+		errors.Is(err, connection.ErrIncompleteTunnel),
+		errors.Is(err, connection.ErrMissingKnownHosts),
+		errors.Is(err, commands.ErrInvalidInput):
+		s.respondError(w, http.StatusBadRequest, err.Error())
+	default:
+		slog.WarnContext(r.Context(), operation+" failed", "error", err)
+		s.respondError(w, http.StatusInternalServerError, ErrInternalServerError)
+	}
 }
 
 func (s *Server) ListDatabases(w http.ResponseWriter, r *http.Request, connectionID contract.ConnectionId) {
@@ -106,12 +236,12 @@ func (s *Server) ListDatabases(w http.ResponseWriter, r *http.Request, connectio
 	databases, err := s.app.Queries.ListDatabases.Handle(r.Context(), queries.ListDatabases{ConnectionID: id})
 	if err != nil {
 		if errors.Is(err, queries.ErrConnectionNotFound) {
-			log.Printf("ERROR: listDatabases connection not found %q", connectionID)
+			slog.ErrorContext(r.Context(), "listDatabases connection not found")
 			http.Error(w, ErrConnectionNotFound, http.StatusNotFound)
 			return
 		}
 
-		log.Printf("WARN: listDatabases get databases %q: %v", connectionID, err)
+		slog.WarnContext(r.Context(), "listDatabases failed", "error", err)
 		s.respondError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
@@ -127,7 +257,7 @@ func (s *Server) ListDatabases(w http.ResponseWriter, r *http.Request, connectio
 func (s *Server) ListTables(w http.ResponseWriter, r *http.Request, connectionID contract.ConnectionId, databaseName contract.DatabaseName) {
 	dbName, err := url.PathUnescape(string(databaseName))
 	if err != nil || dbName == "" {
-		log.Printf("ERROR: listTables invalid database name %q: %v", databaseName, err)
+		slog.ErrorContext(r.Context(), "listTables invalid database name", "database", databaseName, "error", err)
 		http.Error(w, "invalid database name", http.StatusBadRequest)
 		return
 	}
@@ -143,11 +273,11 @@ func (s *Server) ListTables(w http.ResponseWriter, r *http.Request, connectionID
 	)
 	if err != nil {
 		if errors.Is(err, queries.ErrConnectionNotFound) {
-			log.Printf("ERROR: listTables connection not found %q", connectionID)
+			slog.ErrorContext(r.Context(), "listTables connection not found")
 			http.Error(w, ErrConnectionNotFound, http.StatusNotFound)
 			return
 		}
-		log.Printf("WARN: listTables get tables %q/%q: %v", connectionID, dbName, err)
+		slog.WarnContext(r.Context(), "listTables failed", "database", dbName, "error", err)
 		s.respondError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
@@ -176,25 +306,62 @@ func (s *Server) GetConnectionOverview(w http.ResponseWriter, r *http.Request, c
 	s.respondJSON(w, http.StatusOK, newOverviewResponse(health, latencyMs))
 }
 
-func (s *Server) ListSessions(w http.ResponseWriter, r *http.Request, connectionID contract.ConnectionId) {
+func (s *Server) ListSessions(w http.ResponseWriter, r *http.Request, connectionID contract.ConnectionId, params contract.ListSessionsParams) {
 	id := uuid.UUID(connectionID)
 
-	sessions, err := s.app.Queries.ListSessions.Handle(r.Context(), id)
+	filter, err := mapSessionFilter(contract.SessionFilter{
+		User:               params.User,
+		Database:           params.Database,
+		States:             params.State,
+		MinDurationSeconds: params.MinDurationSeconds,
+	})
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	limit := 50
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if limit < 1 || limit > 500 {
+		s.respondError(w, http.StatusBadRequest, "limit must be between 1 and 500")
+		return
+	}
+
+	offset := 0
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+	if offset < 0 {
+		s.respondError(w, http.StatusBadRequest, "offset must not be negative")
+		return
+	}
+
+	query := queries.ListSessions{
+		ConnectionID: id,
+		Filter:       filter,
+		Limit:        limit,
+		Offset:       offset,
+	}
+
+	page, err := s.app.Queries.ListSessions.Handle(r.Context(), query)
 	if err != nil {
 		if errors.Is(err, queries.ErrConnectionNotFound) {
 			http.Error(w, ErrConnectionNotFound, http.StatusNotFound)
 			return
 		}
-		log.Printf("WARN: listSessions connection %s: %v", id, err)
+		slog.WarnContext(r.Context(), "listSessions failed", "error", err)
 		http.Error(w, "failed to reach remote database", http.StatusBadGateway)
 		return
 	}
 
-	resp := make([]sessionResponse, len(sessions))
-	for i, sess := range sessions {
+	resp := make([]sessionResponse, len(page.Sessions))
+	for i, sess := range page.Sessions {
 		resp[i] = newSessionResponse(sess)
 	}
 
+	w.Header().Set("X-Total-Count", strconv.Itoa(page.Total))
 	s.respondJSON(w, http.StatusOK, resp)
 }
 
@@ -208,7 +375,7 @@ func (s *Server) ListUsers(w http.ResponseWriter, r *http.Request, connectionID
 			return
 		}
 
-		log.Printf("WARN: listUsers connection %s: %v", id, err)
+		slog.WarnContext(r.Context(), "listUsers failed", "error", err)
 		http.Error(w, ErrInternalServerError, http.StatusInternalServerError)
 		return
 	}
@@ -234,20 +401,89 @@ func (s *Server) CreateDatabase(w http.ResponseWriter, r *http.Request, connecti
 		ConnectionID: id,
 		Name:         body.Name,
 		Owner:        body.Owner,
+		Template:     body.Template,
+		Encoding:     ptrToString(body.Encoding),
+		Locale:       ptrToString(body.Locale),
+		LcCollate:    ptrToString(body.LcCollate),
+		LcCtype:      ptrToString(body.LcCtype),
+		Tablespace:   body.Tablespace,
+		ConnLimit:    body.ConnLimit,
 	}
 
 	if err := s.app.Commands.CreateDatabase.Handle(r.Context(), cmd); err != nil {
-		if errors.Is(err, commands.ErrConnectionNotFound) {
-			http.Error(w, ErrConnectionNotFound, http.StatusNotFound)
-			return
-		}
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.respondDatabaseError(w, r, "createDatabase", err)
 		return
 	}
 
 	w.WriteHeader(http.StatusCreated)
 }
 
+func (s *Server) RenameDatabase(w http.ResponseWriter, r *http.Request, connectionID contract.ConnectionId, databaseName contract.DatabaseName) {
+	var body contract.RenameDatabaseRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		s.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := commands.RenameDatabaseCmd{
+		ConnectionID: uuid.UUID(connectionID),
+		Name:         databaseName,
+		NewName:      body.Name,
+	}
+
+	if err := s.app.Commands.RenameDatabase.Handle(r.Context(), cmd); err != nil {
+		s.respondDatabaseError(w, r, "renameDatabase", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) DropDatabase(w http.ResponseWriter, r *http.Request, connectionID contract.ConnectionId, databaseName contract.DatabaseName, params contract.DropDatabaseParams) {
+	cmd := commands.DropDatabaseCmd{
+		ConnectionID: uuid.UUID(connectionID),
+		Name:         databaseName,
+		Confirmation: params.Confirm,
+		Force:        ptrToBool(params.Force),
+	}
+
+	if err := s.app.Commands.DropDatabase.Handle(r.Context(), cmd); err != nil {
+		s.respondDatabaseError(w, r, "dropDatabase", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// respondDatabaseError maps the errors shared by the database lifecycle commands.
+func (s *Server) respondDatabaseError(w http.ResponseWriter, r *http.Request, operation string, err error) {
+	if s.respondGuardError(w, err) {
+		return
+	}
+	switch {
+	case errors.Is(err, commands.ErrConnectionNotFound):
+		s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
+	case errors.Is(err, connection.ErrResourceNotFound):
+		s.respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, connection.ErrInvalidIdentifier),
+		errors.Is(err, connection.ErrInvalidEncoding),
+		errors.Is(err, connection.ErrInvalidLocale),
+		errors.Is(err, connection.ErrConflictingLocale),
+		errors.Is(err, connection.ErrInvalidConnLimit),
+		errors.Is(err, connection.ErrInvalidConfiguration),
+		errors.Is(err, commands.ErrConfirmationMismatch):
+		s.respondError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, connection.ErrPermissionDenied):
+		s.respondError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, connection.ErrDatabaseInUse),
+		errors.Is(err, connection.ErrDatabaseExists):
+		s.respondError(w, http.StatusConflict, err.Error())
+	default:
+		slog.WarnContext(r.Context(), operation+" failed", "error", err)
+		s.respondError(w, http.StatusInternalServerError, ErrInternalServerError)
+	}
+}
+
 func (s *Server) CreateTable(w http.ResponseWriter, r *http.Request, connectionID contract.ConnectionId, databaseName contract.DatabaseName) {
 	id := uuid.UUID(connectionID)
 
@@ -270,6 +506,9 @@ func (s *Server) CreateTable(w http.ResponseWriter, r *http.Request, connectionI
 			http.Error(w, ErrConnectionNotFound, http.StatusNotFound)
 			return
 		}
+		if s.respondGuardError(w, err) {
+			return
+		}
 		s.respondError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
@@ -286,18 +525,17 @@ func (s *Server) CreateUser(w http.ResponseWriter, r *http.Request, connectionID
 		return
 	}
 
-	var isSuperUser bool
-	if body.IsSuperuser != nil {
-		isSuperUser = *body.IsSuperuser
-	}
-
 	cmd := commands.CreateUserCmd{
-		ConnectionID: id,
-		Username:     body.Username,
-		Password:     body.Password,
-		IsSuperUser:  isSuperUser,
-		CanLogin:     body.CanLogin,
-		ConnLimit:    body.ConnLimit,
+		ConnectionID:  id,
+		Username:      body.Username,
+		Password:      body.Password,
+		IsSuperUser:   ptrToBool(body.IsSuperuser),
+		CanLogin:      body.CanLogin,
+		CanCreateDB:   ptrToBool(body.CanCreateDb),
+		CanCreateRole: ptrToBool(body.CanCreateRole),
+		Replication:   ptrToBool(body.Replication),
+		ConnLimit:     body.ConnLimit,
+		ValidUntil:    body.ValidUntil,
 	}
 
 	if err := s.app.Commands.CreateUser.Handle(r.Context(), cmd); err != nil {
@@ -305,6 +543,9 @@ func (s *Server) CreateUser(w http.ResponseWriter, r *http.Request, connectionID
 			http.Error(w, ErrConnectionNotFound, http.StatusNotFound)
 			return
 		}
+		if s.respondGuardError(w, err) {
+			return
+		}
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -312,184 +553,467 @@ func (s *Server) CreateUser(w http.ResponseWriter, r *http.Request, connectionID
 	w.WriteHeader(http.StatusCreated)
 }
 
-func (s *Server) KillSession(w http.ResponseWriter, r *http.Request, connectionID contract.ConnectionId, pid int) {
-	id := uuid.UUID(connectionID)
+func (s *Server) AlterUser(w http.ResponseWriter, r *http.Request, connectionID contract.ConnectionId, username contract.Username) {
+	var body contract.AlterUserRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		s.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
 
-	cmd := commands.KillSessionCmd{
-		ConnectionID: id,
-		PID:          pid,
+	cmd := commands.AlterUserCmd{
+		ConnectionID:    uuid.UUID(connectionID),
+		Username:        username,
+		Password:        body.Password,
+		CanLogin:        body.CanLogin,
+		IsSuperUser:     body.IsSuperuser,
+		CanCreateDB:     body.CanCreateDb,
+		CanCreateRole:   body.CanCreateRole,
+		Replication:     body.Replication,
+		ConnLimit:       body.ConnLimit,
+		ValidUntil:      body.ValidUntil,
+		ClearValidUntil: ptrToBool(body.ClearValidUntil),
 	}
 
-	if err := s.app.Commands.KillSession.Handle(r.Context(), cmd); err != nil {
-		if errors.Is(err, commands.ErrConnectionNotFound) {
-			http.Error(w, ErrConnectionNotFound, http.StatusNotFound)
-			return
-		}
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+	if err := s.app.Commands.AlterUser.Handle(r.Context(), cmd); err != nil {
+		s.respondRoleError(w, r, "alterUser", err)
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
 
-func (s *Server) PingConnection(w http.ResponseWriter, r *http.Request, connectionID contract.ConnectionId) {
-	id := uuid.UUID(connectionID)
+func (s *Server) DropUser(w http.ResponseWriter, r *http.Request, connectionID contract.ConnectionId, username contract.Username, params contract.DropUserParams) {
+	cmd := commands.DropUserCmd{
+		ConnectionID: uuid.UUID(connectionID),
+		Username:     username,
+		ReassignTo:   params.ReassignTo,
+		DropOwned:    ptrToBool(params.DropOwned),
+	}
 
-	err := s.app.Queries.PingConnection.Handle(r.Context(), queries.PingConnection{ConnectionID: id})
-	if err != nil {
-		log.Printf("WARN: pingConnection %s: %v", id, err)
-		s.respondError(w, http.StatusBadGateway, err.Error())
+	if err := s.app.Commands.DropUser.Handle(r.Context(), cmd); err != nil {
+		s.respondRoleError(w, r, "dropUser", err)
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
 
-func (s *Server) QueryTableRows(
-	w http.ResponseWriter,
-	r *http.Request,
-	connectionID contract.ConnectionId,
-	databaseName contract.DatabaseName,
-	tableName contract.TableName,
-	params contract.QueryTableRowsParams,
-) {
-	dbName, err := connection.NewIdentifier(databaseName)
-	if err != nil {
-		s.respondError(w, http.StatusBadRequest, "invalid database name")
+func (s *Server) GrantRole(w http.ResponseWriter, r *http.Request, connectionID contract.ConnectionId, username contract.Username) {
+	var body contract.GrantRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		s.respondError(w, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	tblName, err := connection.NewIdentifier(tableName)
-	if err != nil {
-		s.respondError(w, http.StatusBadRequest, "invalid table name")
-		return
+	cmd := commands.GrantRoleCmd{
+		ConnectionID: uuid.UUID(connectionID),
+		Role:         body.Role,
+		Member:       username,
+		WithAdmin:    ptrToBool(body.WithAdmin),
 	}
 
-	limit := 50
-	if params.Limit != nil {
-		limit = *params.Limit
+	if err := s.app.Commands.GrantRole.Handle(r.Context(), cmd); err != nil {
+		s.respondRoleError(w, r, "grantRole", err)
+		return
 	}
 
-	offset := 0
-	if params.Offset != nil {
-		offset = *params.Offset
-	}
+	w.WriteHeader(http.StatusNoContent)
+}
 
-	sortOrder := "asc"
-	if params.SortOrder != nil {
-		sortOrder = string(*params.SortOrder)
+func (s *Server) RevokeRole(w http.ResponseWriter, r *http.Request, connectionID contract.ConnectionId, username contract.Username, role string) {
+	cmd := commands.RevokeRoleCmd{
+		ConnectionID: uuid.UUID(connectionID),
+		Role:         role,
+		Member:       username,
 	}
 
-	var sortBy *connection.Identifier
-	if params.SortBy != nil && *params.SortBy != "" {
-		ident, err := connection.NewIdentifier(*params.SortBy)
-		if err != nil {
-			s.respondError(w, http.StatusBadRequest, "invalid sort_by column name")
-			return
-		}
-		sortBy = &ident
+	if err := s.app.Commands.RevokeRole.Handle(r.Context(), cmd); err != nil {
+		s.respondRoleError(w, r, "revokeRole", err)
+		return
 	}
 
-	query := queries.QueryTableRows{
-		ConnectionID: uuid.UUID(connectionID),
-		DatabaseName: dbName,
-		TableName:    tblName,
-		Limit:        limit,
-		Offset:       offset,
-		SortBy:       sortBy,
-		SortOrder:    sortOrder,
-	}
+	w.WriteHeader(http.StatusNoContent)
+}
 
-	result, err := s.app.Queries.QueryTableRows.Handle(r.Context(), query)
-	if err != nil {
-		if errors.Is(err, queries.ErrConnectionNotFound) {
-			s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
-			return
-		}
-		s.respondError(w, http.StatusInternalServerError, err.Error())
+// respondRoleError maps the errors shared by the role management commands.
+func (s *Server) respondRoleError(w http.ResponseWriter, r *http.Request, operation string, err error) {
+	if s.respondGuardError(w, err) {
 		return
 	}
-
-	s.respondJSON(w, http.StatusOK, newTableRowsResponse(result))
+	switch {
+	case errors.Is(err, commands.ErrConnectionNotFound):
+		s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
+	case errors.Is(err, connection.ErrResourceNotFound):
+		s.respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, connection.ErrInvalidIdentifier),
+		errors.Is(err, connection.ErrNoRoleChanges),
+		errors.Is(err, connection.ErrInvalidConnLimit),
+		errors.Is(err, connection.ErrConflictingExpiry),
+		errors.Is(err, connection.ErrSelfMembership),
+		errors.Is(err, connection.ErrReassignToSameRole),
+		errors.Is(err, connection.ErrInvalidConfiguration),
+		errors.Is(err, commands.ErrProtectedRole):
+		s.respondError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, connection.ErrPermissionDenied):
+		s.respondError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, connection.ErrRoleHasDependents):
+		s.respondError(w, http.StatusConflict, err.Error())
+	default:
+		slog.WarnContext(r.Context(), operation+" failed", "error", err)
+		s.respondError(w, http.StatusInternalServerError, ErrInternalServerError)
+	}
 }
 
-func (s *Server) ListColumns(
+func (s *Server) ListPrivileges(
 	w http.ResponseWriter,
 	r *http.Request,
 	connectionID contract.ConnectionId,
 	databaseName contract.DatabaseName,
-	tableName contract.TableName,
+	params contract.ListPrivilegesParams,
 ) {
 	dbName, err := connection.NewIdentifier(databaseName)
 	if err != nil {
-		http.Error(w, "invalid database name", http.StatusBadRequest)
+		s.respondError(w, http.StatusBadRequest, "invalid database name")
 		return
 	}
 
-	tblName, err := connection.NewIdentifier(tableName)
-	if err != nil {
-		http.Error(w, "invalid table name", http.StatusBadRequest)
+	var filter connection.PrivilegeFilter
+	if params.ObjectType != nil {
+		if filter.ObjectType, err = connection.ParsePrivilegeObjectType(string(*params.ObjectType)); err != nil {
+			s.respondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+	if filter.Schema, err = optionalIdentifier(params.Schema); err != nil {
+		s.respondError(w, http.StatusBadRequest, "invalid schema name")
 		return
 	}
 
-	query := queries.ListColumns{
+	query := queries.ListPrivileges{
 		ConnectionID: uuid.UUID(connectionID),
 		DatabaseName: dbName,
-		TableName:    tblName,
+		Filter:       filter,
 	}
 
-	cols, err := s.app.Queries.ListColumns.Handle(r.Context(), query)
+	privileges, err := s.app.Queries.ListPrivileges.Handle(r.Context(), query)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-	}
-
-	resp := make([]contract.Column, len(cols))
-	for i, c := range cols {
-		resp[i] = newColumnResponse(c)
+		if errors.Is(err, queries.ErrConnectionNotFound) {
+			s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
+			return
+		}
+		slog.WarnContext(r.Context(), "listPrivileges failed", "database", databaseName, "error", err)
+		s.respondError(w, http.StatusInternalServerError, ErrInternalServerError)
+		return
 	}
 
-	s.respondJSON(w, http.StatusOK, resp)
+	s.respondJSON(w, http.StatusOK, newDatabasePrivilegesResponse(privileges))
 }
 
-func (s *Server) ListIndexes(
+func (s *Server) GetEffectivePrivileges(
 	w http.ResponseWriter,
 	r *http.Request,
 	connectionID contract.ConnectionId,
 	databaseName contract.DatabaseName,
-	tableName contract.TableName,
+	params contract.GetEffectivePrivilegesParams,
 ) {
 	dbName, err := connection.NewIdentifier(databaseName)
 	if err != nil {
-		http.Error(w, "invalid database name", http.StatusBadRequest)
+		s.respondError(w, http.StatusBadRequest, "invalid database name")
 		return
 	}
 
-	tblName, err := connection.NewIdentifier(tableName)
+	role, err := connection.NewIdentifier(params.Role)
 	if err != nil {
-		http.Error(w, "invalid table name", http.StatusBadRequest)
+		s.respondError(w, http.StatusBadRequest, "invalid role name")
 		return
 	}
 
-	query := queries.ListIndexes{
+	schema, err := optionalIdentifier(params.Schema)
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, "invalid schema name")
+		return
+	}
+
+	query := queries.GetEffectivePrivileges{
 		ConnectionID: uuid.UUID(connectionID),
 		DatabaseName: dbName,
-		TableName:    tblName,
+		Role:         role,
+		Schema:       schema,
 	}
 
-	indexes, err := s.app.Queries.ListIndexes.Handle(r.Context(), query)
+	matrix, err := s.app.Queries.GetEffectivePrivileges.Handle(r.Context(), query)
 	if err != nil {
-		if errors.Is(err, queries.ErrConnectionNotFound) {
+		switch {
+		case errors.Is(err, queries.ErrConnectionNotFound):
 			s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
-			return
+		case errors.Is(err, connection.ErrResourceNotFound):
+			s.respondError(w, http.StatusNotFound, err.Error())
+		default:
+			slog.WarnContext(r.Context(), "getEffectivePrivileges failed", "database", databaseName, "error", err)
+			s.respondError(w, http.StatusInternalServerError, ErrInternalServerError)
 		}
-		s.respondError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	resp := make([]contract.Index, len(indexes))
-	for i, idx := range indexes {
-		resp[i] = contract.Index{
-			Name:    idx.Name,
+	s.respondJSON(w, http.StatusOK, newEffectivePrivilegesResponse(matrix))
+}
+
+func (s *Server) GrantPrivileges(w http.ResponseWriter, r *http.Request, connectionID contract.ConnectionId, databaseName contract.DatabaseName) {
+	dbName, change, ok := s.decodePrivilegeChange(w, r, databaseName)
+	if !ok {
+		return
+	}
+
+	cmd := commands.GrantPrivilegesCmd{
+		ConnectionID: uuid.UUID(connectionID),
+		DatabaseName: dbName,
+		Change:       change,
+	}
+
+	if err := s.app.Commands.GrantPrivileges.Handle(r.Context(), cmd); err != nil {
+		s.respondPrivilegeError(w, r, "grantPrivileges", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) RevokePrivileges(w http.ResponseWriter, r *http.Request, connectionID contract.ConnectionId, databaseName contract.DatabaseName) {
+	dbName, change, ok := s.decodePrivilegeChange(w, r, databaseName)
+	if !ok {
+		return
+	}
+
+	cmd := commands.RevokePrivilegesCmd{
+		ConnectionID: uuid.UUID(connectionID),
+		DatabaseName: dbName,
+		Change:       change,
+	}
+
+	if err := s.app.Commands.RevokePrivileges.Handle(r.Context(), cmd); err != nil {
+		s.respondPrivilegeError(w, r, "revokePrivileges", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// decodePrivilegeChange reads the body shared by GrantPrivileges and RevokePrivileges and
+// answers 400 itself when it is invalid.
+func (s *Server) decodePrivilegeChange(w http.ResponseWriter, r *http.Request, databaseName string) (connection.Identifier, connection.PrivilegeChange, bool) {
+	dbName, err := connection.NewIdentifier(databaseName)
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, "invalid database name")
+		return dbName, connection.PrivilegeChange{}, false
+	}
+
+	var body contract.PrivilegeChangeRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		s.respondError(w, http.StatusBadRequest, err.Error())
+		return dbName, connection.PrivilegeChange{}, false
+	}
+
+	change, err := mapPrivilegeChange(body)
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, err.Error())
+		return dbName, change, false
+	}
+	return dbName, change, true
+}
+
+func (s *Server) respondPrivilegeError(w http.ResponseWriter, r *http.Request, operation string, err error) {
+	switch {
+	case errors.Is(err, connection.ErrInvalidObjectType),
+		errors.Is(err, connection.ErrInvalidPrivilege),
+		errors.Is(err, connection.ErrIncompletePrivilegeTarget),
+		errors.Is(err, connection.ErrInvalidConfiguration):
+		s.respondError(w, http.StatusBadRequest, err.Error())
+	default:
+		s.respondRoleError(w, r, operation, err)
+	}
+}
+
+func (s *Server) KillSession(w http.ResponseWriter, r *http.Request, connectionID contract.ConnectionId, pid int) {
+	id := uuid.UUID(connectionID)
+
+	cmd := commands.KillSessionCmd{
+		ConnectionID: id,
+		PID:          pid,
+	}
+
+	if err := s.app.Commands.KillSession.Handle(r.Context(), cmd); err != nil {
+		if errors.Is(err, commands.ErrConnectionNotFound) {
+			http.Error(w, ErrConnectionNotFound, http.StatusNotFound)
+			return
+		}
+		if s.respondGuardError(w, err) {
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) PingConnection(w http.ResponseWriter, r *http.Request, connectionID contract.ConnectionId) {
+	id := uuid.UUID(connectionID)
+
+	err := s.app.Queries.PingConnection.Handle(r.Context(), queries.PingConnection{ConnectionID: id})
+	if err != nil {
+		slog.WarnContext(r.Context(), "pingConnection failed", "error", err)
+		s.respondError(w, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) QueryTableRows(
+	w http.ResponseWriter,
+	r *http.Request,
+	connectionID contract.ConnectionId,
+	databaseName contract.DatabaseName,
+	tableName contract.TableName,
+	params contract.QueryTableRowsParams,
+) {
+	dbName, err := connection.NewIdentifier(databaseName)
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, "invalid database name")
+		return
+	}
+
+	tblName, err := connection.NewIdentifier(tableName)
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, "invalid table name")
+		return
+	}
+
+	limit := 50
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	offset := 0
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	sortOrder := "asc"
+	if params.SortOrder != nil {
+		sortOrder = string(*params.SortOrder)
+	}
+
+	var sortBy *connection.Identifier
+	if params.SortBy != nil && *params.SortBy != "" {
+		ident, err := connection.NewIdentifier(*params.SortBy)
+		if err != nil {
+			s.respondError(w, http.StatusBadRequest, "invalid sort_by column name")
+			return
+		}
+		sortBy = &ident
+	}
+
+	query := queries.QueryTableRows{
+		ConnectionID: uuid.UUID(connectionID),
+		DatabaseName: dbName,
+		TableName:    tblName,
+		Limit:        limit,
+		Offset:       offset,
+		SortBy:       sortBy,
+		SortOrder:    sortOrder,
+	}
+
+	result, err := s.app.Queries.QueryTableRows.Handle(r.Context(), query)
+	if err != nil {
+		if errors.Is(err, queries.ErrConnectionNotFound) {
+			s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
+			return
+		}
+		s.respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	s.respondJSON(w, http.StatusOK, newTableRowsResponse(result))
+}
+
+func (s *Server) ListColumns(
+	w http.ResponseWriter,
+	r *http.Request,
+	connectionID contract.ConnectionId,
+	databaseName contract.DatabaseName,
+	tableName contract.TableName,
+) {
+	dbName, err := connection.NewIdentifier(databaseName)
+	if err != nil {
+		http.Error(w, "invalid database name", http.StatusBadRequest)
+		return
+	}
+
+	tblName, err := connection.NewIdentifier(tableName)
+	if err != nil {
+		http.Error(w, "invalid table name", http.StatusBadRequest)
+		return
+	}
+
+	query := queries.ListColumns{
+		ConnectionID: uuid.UUID(connectionID),
+		DatabaseName: dbName,
+		TableName:    tblName,
+	}
+
+	cols, err := s.app.Queries.ListColumns.Handle(r.Context(), query)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+
+	resp := make([]contract.Column, len(cols))
+	for i, c := range cols {
+		resp[i] = newColumnResponse(c)
+	}
+
+	s.respondJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) ListIndexes(
+	w http.ResponseWriter,
+	r *http.Request,
+	connectionID contract.ConnectionId,
+	databaseName contract.DatabaseName,
+	tableName contract.TableName,
+) {
+	dbName, err := connection.NewIdentifier(databaseName)
+	if err != nil {
+		http.Error(w, "invalid database name", http.StatusBadRequest)
+		return
+	}
+
+	tblName, err := connection.NewIdentifier(tableName)
+	if err != nil {
+		http.Error(w, "invalid table name", http.StatusBadRequest)
+		return
+	}
+
+	query := queries.ListIndexes{
+		ConnectionID: uuid.UUID(connectionID),
+		DatabaseName: dbName,
+		TableName:    tblName,
+	}
+
+	indexes, err := s.app.Queries.ListIndexes.Handle(r.Context(), query)
+	if err != nil {
+		if errors.Is(err, queries.ErrConnectionNotFound) {
+			s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
+			return
+		}
+		s.respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := make([]contract.Index, len(indexes))
+	for i, idx := range indexes {
+		resp[i] = contract.Index{
+			Name:    idx.Name,
 			Columns: idx.Columns,
 			Method:  string(idx.Method),
 			Unique:  idx.Unique,
@@ -563,6 +1087,9 @@ func (s *Server) UpdateTableRow(
 			s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
 			return
 		}
+		if s.respondGuardError(w, err) {
+			return
+		}
 		if errors.Is(err, connection.ErrResourceNotFound) {
 			s.respondError(w, http.StatusNotFound, "row not found")
 			return
@@ -571,10 +1098,1412 @@ func (s *Server) UpdateTableRow(
 			s.respondError(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		log.Printf("WARN: updateTableRow %s/%s/%s: %v", connectionID, databaseName, tableName, err)
+		slog.WarnContext(r.Context(), "updateTableRow failed", "database", databaseName, "table", tableName, "error", err)
 		s.respondError(w, http.StatusInternalServerError, ErrInternalServerError)
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+func (s *Server) GetSchemaDDL(
+	w http.ResponseWriter,
+	r *http.Request,
+	connectionID contract.ConnectionId,
+	databaseName contract.DatabaseName,
+	params contract.GetSchemaDDLParams,
+) {
+	dbName, err := connection.NewIdentifier(databaseName)
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, "invalid database name")
+		return
+	}
+
+	schema, err := schemaFromParam(params.Schema)
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, "invalid schema name")
+		return
+	}
+
+	query := queries.GetSchemaDDL{
+		ConnectionID: uuid.UUID(connectionID),
+		DatabaseName: dbName,
+		Schema:       schema,
+	}
+
+	ddl, err := s.app.Queries.GetSchemaDDL.Handle(r.Context(), query)
+	if err != nil {
+		if errors.Is(err, queries.ErrConnectionNotFound) {
+			s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
+			return
+		}
+		if errors.Is(err, connection.ErrResourceNotFound) {
+			s.respondError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		slog.WarnContext(r.Context(), "getSchemaDDL failed", "database", databaseName, "schema", schema, "error", err)
+		s.respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	s.respondJSON(w, http.StatusOK, contract.SchemaDDL{
+		Schema: ddl.Schema,
+		Ddl:    ddl.Definition,
+	})
+}
+
+func (s *Server) GetObjectDDL(
+	w http.ResponseWriter,
+	r *http.Request,
+	connectionID contract.ConnectionId,
+	databaseName contract.DatabaseName,
+	objectKind contract.ObjectKind,
+	objectName string,
+	params contract.GetObjectDDLParams,
+) {
+	dbName, err := connection.NewIdentifier(databaseName)
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, "invalid database name")
+		return
+	}
+
+	kind, err := connection.NewObjectKind(string(objectKind))
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	name, err := connection.NewIdentifier(objectName)
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, "invalid object name")
+		return
+	}
+
+	schema, err := schemaFromParam(params.Schema)
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, "invalid schema name")
+		return
+	}
+
+	query := queries.GetObjectDDL{
+		ConnectionID: uuid.UUID(connectionID),
+		DatabaseName: dbName,
+		Schema:       schema,
+		Kind:         kind,
+		Name:         name,
+	}
+
+	ddl, err := s.app.Queries.GetObjectDDL.Handle(r.Context(), query)
+	if err != nil {
+		if errors.Is(err, queries.ErrConnectionNotFound) {
+			s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
+			return
+		}
+		if errors.Is(err, connection.ErrResourceNotFound) {
+			s.respondError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		slog.WarnContext(r.Context(), "getObjectDDL failed", "database", databaseName, "object_kind", objectKind, "object", objectName, "error", err)
+		s.respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	s.respondJSON(w, http.StatusOK, contract.ObjectDDL{
+		Kind:   contract.ObjectKind(ddl.Kind),
+		Schema: ddl.Schema,
+		Name:   ddl.Name,
+		Ddl:    ddl.Definition,
+	})
+}
+
+func (s *Server) ListViews(
+	w http.ResponseWriter,
+	r *http.Request,
+	connectionID contract.ConnectionId,
+	databaseName contract.DatabaseName,
+	params contract.ListViewsParams,
+) {
+	dbName, err := connection.NewIdentifier(databaseName)
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, "invalid database name")
+		return
+	}
+
+	schema, err := schemaFromParam(params.Schema)
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, "invalid schema name")
+		return
+	}
+
+	query := queries.ListViews{
+		ConnectionID: uuid.UUID(connectionID),
+		DatabaseName: dbName,
+		Schema:       schema,
+	}
+
+	views, err := s.app.Queries.ListViews.Handle(r.Context(), query)
+	if err != nil {
+		if errors.Is(err, queries.ErrConnectionNotFound) {
+			s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
+			return
+		}
+		slog.WarnContext(r.Context(), "listViews failed", "database", databaseName, "schema", schema, "error", err)
+		s.respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := make([]contract.View, len(views))
+	for i, item := range views {
+		resp[i] = newViewResponse(item)
+	}
+
+	s.respondJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) ListMaterializedViews(
+	w http.ResponseWriter,
+	r *http.Request,
+	connectionID contract.ConnectionId,
+	databaseName contract.DatabaseName,
+	params contract.ListMaterializedViewsParams,
+) {
+	dbName, err := connection.NewIdentifier(databaseName)
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, "invalid database name")
+		return
+	}
+
+	schema, err := schemaFromParam(params.Schema)
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, "invalid schema name")
+		return
+	}
+
+	query := queries.ListMaterializedViews{
+		ConnectionID: uuid.UUID(connectionID),
+		DatabaseName: dbName,
+		Schema:       schema,
+	}
+
+	views, err := s.app.Queries.ListMaterializedViews.Handle(r.Context(), query)
+	if err != nil {
+		if errors.Is(err, queries.ErrConnectionNotFound) {
+			s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
+			return
+		}
+		slog.WarnContext(r.Context(), "listMaterializedViews failed", "database", databaseName, "schema", schema, "error", err)
+		s.respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := make([]contract.MaterializedView, len(views))
+	for i, item := range views {
+		resp[i] = newMaterializedViewResponse(item)
+	}
+
+	s.respondJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) ListFunctions(
+	w http.ResponseWriter,
+	r *http.Request,
+	connectionID contract.ConnectionId,
+	databaseName contract.DatabaseName,
+	params contract.ListFunctionsParams,
+) {
+	dbName, err := connection.NewIdentifier(databaseName)
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, "invalid database name")
+		return
+	}
+
+	schema, err := schemaFromParam(params.Schema)
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, "invalid schema name")
+		return
+	}
+
+	query := queries.ListFunctions{
+		ConnectionID: uuid.UUID(connectionID),
+		DatabaseName: dbName,
+		Schema:       schema,
+	}
+
+	functions, err := s.app.Queries.ListFunctions.Handle(r.Context(), query)
+	if err != nil {
+		if errors.Is(err, queries.ErrConnectionNotFound) {
+			s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
+			return
+		}
+		slog.WarnContext(r.Context(), "listFunctions failed", "database", databaseName, "schema", schema, "error", err)
+		s.respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := make([]contract.Function, len(functions))
+	for i, item := range functions {
+		resp[i] = newFunctionResponse(item)
+	}
+
+	s.respondJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) ListSequences(
+	w http.ResponseWriter,
+	r *http.Request,
+	connectionID contract.ConnectionId,
+	databaseName contract.DatabaseName,
+	params contract.ListSequencesParams,
+) {
+	dbName, err := connection.NewIdentifier(databaseName)
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, "invalid database name")
+		return
+	}
+
+	schema, err := schemaFromParam(params.Schema)
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, "invalid schema name")
+		return
+	}
+
+	query := queries.ListSequences{
+		ConnectionID: uuid.UUID(connectionID),
+		DatabaseName: dbName,
+		Schema:       schema,
+	}
+
+	sequences, err := s.app.Queries.ListSequences.Handle(r.Context(), query)
+	if err != nil {
+		if errors.Is(err, queries.ErrConnectionNotFound) {
+			s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
+			return
+		}
+		slog.WarnContext(r.Context(), "listSequences failed", "database", databaseName, "schema", schema, "error", err)
+		s.respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := make([]contract.Sequence, len(sequences))
+	for i, item := range sequences {
+		resp[i] = newSequenceResponse(item)
+	}
+
+	s.respondJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) ListTypes(
+	w http.ResponseWriter,
+	r *http.Request,
+	connectionID contract.ConnectionId,
+	databaseName contract.DatabaseName,
+	params contract.ListTypesParams,
+) {
+	dbName, err := connection.NewIdentifier(databaseName)
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, "invalid database name")
+		return
+	}
+
+	schema, err := schemaFromParam(params.Schema)
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, "invalid schema name")
+		return
+	}
+
+	query := queries.ListTypes{
+		ConnectionID: uuid.UUID(connectionID),
+		DatabaseName: dbName,
+		Schema:       schema,
+	}
+
+	types, err := s.app.Queries.ListTypes.Handle(r.Context(), query)
+	if err != nil {
+		if errors.Is(err, queries.ErrConnectionNotFound) {
+			s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
+			return
+		}
+		slog.WarnContext(r.Context(), "listTypes failed", "database", databaseName, "schema", schema, "error", err)
+		s.respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := make([]contract.UserType, len(types))
+	for i, item := range types {
+		resp[i] = newUserTypeResponse(item)
+	}
+
+	s.respondJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) ListTriggers(
+	w http.ResponseWriter,
+	r *http.Request,
+	connectionID contract.ConnectionId,
+	databaseName contract.DatabaseName,
+	tableName contract.TableName,
+	params contract.ListTriggersParams,
+) {
+	dbName, err := connection.NewIdentifier(databaseName)
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, "invalid database name")
+		return
+	}
+
+	tblName, err := connection.NewIdentifier(tableName)
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, "invalid table name")
+		return
+	}
+
+	schema, err := schemaFromParam(params.Schema)
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, "invalid schema name")
+		return
+	}
+
+	query := queries.ListTriggers{
+		ConnectionID: uuid.UUID(connectionID),
+		DatabaseName: dbName,
+		Schema:       schema,
+		TableName:    tblName,
+	}
+
+	triggers, err := s.app.Queries.ListTriggers.Handle(r.Context(), query)
+	if err != nil {
+		if errors.Is(err, queries.ErrConnectionNotFound) {
+			s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
+			return
+		}
+		slog.WarnContext(r.Context(), "listTriggers failed", "database", databaseName, "schema", schema, "table", tableName, "error", err)
+		s.respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := make([]contract.Trigger, len(triggers))
+	for i, item := range triggers {
+		resp[i] = newTriggerResponse(item)
+	}
+
+	s.respondJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) GetTableHealth(
+	w http.ResponseWriter,
+	r *http.Request,
+	connectionID contract.ConnectionId,
+	databaseName contract.DatabaseName,
+	tableName contract.TableName,
+	params contract.GetTableHealthParams,
+) {
+	dbName, err := connection.NewIdentifier(databaseName)
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, "invalid database name")
+		return
+	}
+
+	tblName, err := connection.NewIdentifier(tableName)
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, "invalid table name")
+		return
+	}
+
+	schema, err := schemaFromParam(params.Schema)
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, "invalid schema name")
+		return
+	}
+
+	query := queries.GetTableHealth{
+		ConnectionID: uuid.UUID(connectionID),
+		DatabaseName: dbName,
+		Schema:       schema,
+		TableName:    tblName,
+	}
+
+	health, err := s.app.Queries.GetTableHealth.Handle(r.Context(), query)
+	if err != nil {
+		if errors.Is(err, queries.ErrConnectionNotFound) {
+			s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
+			return
+		}
+		if errors.Is(err, connection.ErrResourceNotFound) {
+			s.respondError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		slog.WarnContext(r.Context(), "getTableHealth failed", "database", databaseName, "schema", schema, "table", tableName, "error", err)
+		s.respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	s.respondJSON(w, http.StatusOK, newTableHealthResponse(health))
+}
+
+func (s *Server) RunMaintenance(
+	w http.ResponseWriter,
+	r *http.Request,
+	connectionID contract.ConnectionId,
+	databaseName contract.DatabaseName,
+) {
+	var body contract.MaintenanceRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		s.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	schema := "public"
+	if body.Schema != nil {
+		schema = *body.Schema
+	}
+
+	cmd := commands.RunMaintenanceCmd{
+		ConnectionID: uuid.UUID(connectionID),
+		Database:     databaseName,
+		Kind:         string(body.Kind),
+		Schema:       schema,
+		Target:       body.Target,
+		Full:         ptrToBool(body.Full),
+		Analyze:      ptrToBool(body.Analyze),
+		Verbose:      ptrToBool(body.Verbose),
+		Concurrently: ptrToBool(body.Concurrently),
+	}
+
+	j, err := s.app.Commands.RunMaintenance.Handle(r.Context(), cmd)
+	if err != nil {
+		s.respondJobError(w, r, "runMaintenance", err)
+		return
+	}
+
+	s.respondJSON(w, http.StatusAccepted, newJobResponse(*j))
+}
+
+func (s *Server) CreateBackup(
+	w http.ResponseWriter,
+	r *http.Request,
+	connectionID contract.ConnectionId,
+	databaseName contract.DatabaseName,
+) {
+	var body contract.CreateBackupRequest
+	if err := decodeOptionalBody(r, &body); err != nil {
+		s.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := commands.CreateBackupCmd{
+		ConnectionID: uuid.UUID(connectionID),
+		Database:     databaseName,
+	}
+	if body.Tables != nil {
+		cmd.Tables = *body.Tables
+	}
+
+	j, err := s.app.Commands.CreateBackup.Handle(r.Context(), cmd)
+	if err != nil {
+		s.respondJobError(w, r, "createBackup", err)
+		return
+	}
+
+	s.respondJSON(w, http.StatusAccepted, newJobResponse(*j))
+}
+
+func (s *Server) ListBackups(w http.ResponseWriter, r *http.Request, connectionID contract.ConnectionId) {
+	backups, err := s.app.Queries.ListBackups.Handle(r.Context(), uuid.UUID(connectionID))
+	if err != nil {
+		s.respondBackupError(w, r, "listBackups", err)
+		return
+	}
+
+	resp := make([]contract.Backup, len(backups))
+	for i, b := range backups {
+		resp[i] = newBackupResponse(b)
+	}
+
+	s.respondJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) RestoreBackup(
+	w http.ResponseWriter,
+	r *http.Request,
+	connectionID contract.ConnectionId,
+	backupID contract.BackupId,
+) {
+	var body contract.RestoreBackupRequest
+	if err := decodeOptionalBody(r, &body); err != nil {
+		s.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := commands.RestoreBackupCmd{
+		ConnectionID: uuid.UUID(connectionID),
+		BackupID:     uuid.UUID(backupID),
+		Database:     body.Database,
+		Create:       ptrToBool(body.Create),
+		Clean:        ptrToBool(body.Clean),
+	}
+
+	j, err := s.app.Commands.RestoreBackup.Handle(r.Context(), cmd)
+	if err != nil {
+		s.respondJobError(w, r, "restoreBackup", err)
+		return
+	}
+
+	s.respondJSON(w, http.StatusAccepted, newJobResponse(*j))
+}
+
+func (s *Server) DeleteBackup(
+	w http.ResponseWriter,
+	r *http.Request,
+	connectionID contract.ConnectionId,
+	backupID contract.BackupId,
+) {
+	if err := s.app.Commands.DeleteBackup.Handle(r.Context(), uuid.UUID(connectionID), uuid.UUID(backupID)); err != nil {
+		s.respondBackupError(w, r, "deleteBackup", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// respondBackupError maps the errors of the backup endpoints to HTTP responses.
+func (s *Server) respondBackupError(w http.ResponseWriter, r *http.Request, operation string, err error) {
+	switch {
+	case errors.Is(err, commands.ErrConnectionNotFound),
+		errors.Is(err, queries.ErrConnectionNotFound):
+		s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
+	case errors.Is(err, backup.ErrBackupNotFound):
+		s.respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, connection.ErrInvalidIdentifier),
+		errors.Is(err, connection.ErrInvalidTableRef):
+		s.respondError(w, http.StatusBadRequest, err.Error())
+	default:
+		slog.WarnContext(r.Context(), operation+" failed", "error", err)
+		s.respondError(w, http.StatusInternalServerError, ErrInternalServerError)
+	}
+}
+
+func (s *Server) ListJobs(w http.ResponseWriter, r *http.Request, params contract.ListJobsParams) {
+	var filter job.Filter
+	if params.ConnectionId != nil {
+		id := uuid.UUID(*params.ConnectionId)
+		filter.ConnectionID = &id
+	}
+	if params.Status != nil {
+		status, err := job.NewStatus(string(*params.Status))
+		if err != nil {
+			s.respondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		filter.Status = &status
+	}
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > 1000 {
+			s.respondError(w, http.StatusBadRequest, "limit must be between 1 and 1000")
+			return
+		}
+		filter.Limit = *params.Limit
+	}
+
+	jobs, err := s.app.Queries.ListJobs.Handle(r.Context(), filter)
+	if err != nil {
+		s.respondJobError(w, r, "listJobs", err)
+		return
+	}
+
+	resp := make([]contract.Job, len(jobs))
+	for i, j := range jobs {
+		resp[i] = newJobResponse(j)
+	}
+
+	s.respondJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) SubmitJob(w http.ResponseWriter, r *http.Request) {
+	var body contract.SubmitJobRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		s.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	input, err := json.Marshal(body.Input)
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	j, err := s.app.Commands.SubmitJob.Handle(r.Context(), commands.SubmitJobCmd{
+		Command: string(body.Command),
+		Input:   input,
+	})
+	if err != nil {
+		s.respondJobError(w, r, "submitJob", err)
+		return
+	}
+
+	s.respondJSON(w, http.StatusAccepted, newJobResponse(*j))
+}
+
+func (s *Server) GetJob(w http.ResponseWriter, r *http.Request, jobID contract.JobId) {
+	j, err := s.app.Queries.GetJob.Handle(r.Context(), uuid.UUID(jobID))
+	if err != nil {
+		s.respondJobError(w, r, "getJob", err)
+		return
+	}
+
+	s.respondJSON(w, http.StatusOK, newJobResponse(*j))
+}
+
+// StreamJobEvents sends the job as a server-sent event on every change until it finishes.
+func (s *Server) StreamJobEvents(w http.ResponseWriter, r *http.Request, jobID contract.JobId) {
+	// Answer a missing job with a regular JSON error, before the stream starts.
+	if _, err := s.app.Queries.GetJob.Handle(r.Context(), uuid.UUID(jobID)); err != nil {
+		s.respondJobError(w, r, "streamJobEvents", err)
+		return
+	}
+
+	// The stream outlives the WriteTimeout of the server.
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		slog.WarnContext(r.Context(), "streamJobEvents: clearing write deadline", "error", err)
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	err := s.app.Queries.WatchJob.Handle(r.Context(), uuid.UUID(jobID), func(j job.Job) error {
+		data, err := json.Marshal(newJobResponse(j))
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "event: job\ndata: %s\n\n", data); err != nil {
+			return err
+		}
+		return rc.Flush()
+	})
+	if err != nil && r.Context().Err() == nil {
+		slog.WarnContext(r.Context(), "streamJobEvents failed", "job_id", jobID, "error", err)
+	}
+}
+
+func (s *Server) CancelJob(w http.ResponseWriter, r *http.Request, jobID contract.JobId) {
+	j, err := s.app.Commands.CancelJob.Handle(r.Context(), uuid.UUID(jobID))
+	if err != nil {
+		s.respondJobError(w, r, "cancelJob", err)
+		return
+	}
+
+	s.respondJSON(w, http.StatusAccepted, newJobResponse(*j))
+}
+
+// respondJobError maps the errors of the job endpoints, and of the commands submitted as jobs,
+// to HTTP responses.
+func (s *Server) respondJobError(w http.ResponseWriter, r *http.Request, operation string, err error) {
+	if s.respondGuardError(w, err) {
+		return
+	}
+	switch {
+	case errors.Is(err, commands.ErrConnectionNotFound),
+		errors.Is(err, queries.ErrConnectionNotFound):
+		s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
+	case errors.Is(err, job.ErrJobNotFound),
+		errors.Is(err, backup.ErrBackupNotFound):
+		s.respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, job.ErrJobFinished):
+		s.respondError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, jobs.ErrQueueFull):
+		s.respondError(w, http.StatusServiceUnavailable, err.Error())
+	case errors.Is(err, commands.ErrInvalidInput),
+		errors.Is(err, connection.ErrInvalidIdentifier),
+		errors.Is(err, connection.ErrInvalidTableRef),
+		errors.Is(err, connection.ErrInvalidMaintenanceKind),
+		errors.Is(err, connection.ErrMaintenanceTarget),
+		errors.Is(err, connection.ErrMaintenanceOption):
+		s.respondError(w, http.StatusBadRequest, err.Error())
+	default:
+		slog.WarnContext(r.Context(), operation+" failed", "error", err)
+		s.respondError(w, http.StatusInternalServerError, ErrInternalServerError)
+	}
+}
+
+// decodeOptionalBody decodes the JSON body of requests where the body may be omitted.
+func decodeOptionalBody(r *http.Request, v any) error {
+	err := json.NewDecoder(r.Body).Decode(v)
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	return err
+}
+
+func (s *Server) ExplainQuery(
+	w http.ResponseWriter,
+	r *http.Request,
+	connectionID contract.ConnectionId,
+	databaseName contract.DatabaseName,
+) {
+	var body contract.ExplainRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		s.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	dbName, err := connection.NewIdentifier(databaseName)
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, "invalid database name")
+		return
+	}
+
+	buffers, verbose := true, true
+	if body.Buffers != nil {
+		buffers = *body.Buffers
+	}
+	if body.Verbose != nil {
+		verbose = *body.Verbose
+	}
+
+	query := queries.ExplainQuery{
+		ConnectionID: uuid.UUID(connectionID),
+		DatabaseName: dbName,
+		Statement:    body.Query,
+		Options: connection.ExplainOptions{
+			Analyze: ptrToBool(body.Analyze),
+			Buffers: buffers,
+			Verbose: verbose,
+		},
+	}
+
+	result, err := s.app.Queries.ExplainQuery.Handle(r.Context(), query)
+	if err != nil {
+		if errors.Is(err, queries.ErrConnectionNotFound) {
+			s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
+			return
+		}
+		if s.respondGuardError(w, err) {
+			return
+		}
+		if errors.Is(err, connection.ErrEmptyStatement) || errors.Is(err, connection.ErrQueryFailed) {
+			s.respondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		slog.WarnContext(r.Context(), "explainQuery failed", "database", databaseName, "error", err)
+		s.respondError(w, http.StatusInternalServerError, ErrInternalServerError)
+		return
+	}
+
+	s.respondJSON(w, http.StatusOK, newExplainResponse(result))
+}
+
+func (s *Server) ListTopStatements(w http.ResponseWriter, r *http.Request, connectionID contract.ConnectionId, params contract.ListTopStatementsParams) {
+	orderBy := connection.StatementOrderTotalTime
+	if params.OrderBy != nil {
+		order, err := connection.NewStatementOrder(string(*params.OrderBy))
+		if err != nil {
+			s.respondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		orderBy = order
+	}
+
+	limit := 20
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if limit < 1 || limit > 100 {
+		s.respondError(w, http.StatusBadRequest, "limit must be between 1 and 100")
+		return
+	}
+
+	query := queries.ListTopStatements{
+		ConnectionID: uuid.UUID(connectionID),
+		OrderBy:      orderBy,
+		Limit:        limit,
+	}
+
+	statements, err := s.app.Queries.ListTopStatements.Handle(r.Context(), query)
+	if err != nil {
+		if errors.Is(err, queries.ErrConnectionNotFound) {
+			s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
+			return
+		}
+		if errors.Is(err, connection.ErrExtensionNotInstalled) || errors.Is(err, connection.ErrUnsupportedServerVersion) {
+			reason := err.Error()
+			s.respondJSON(w, http.StatusOK, contract.TopStatementsResponse{
+				Available:  false,
+				Reason:     &reason,
+				Statements: []contract.StatementStats{},
+			})
+			return
+		}
+		slog.WarnContext(r.Context(), "listTopStatements failed", "error", err)
+		s.respondError(w, http.StatusInternalServerError, ErrInternalServerError)
+		return
+	}
+
+	resp := contract.TopStatementsResponse{
+		Available:  true,
+		Statements: make([]contract.StatementStats, len(statements)),
+	}
+	for i, item := range statements {
+		resp.Statements[i] = newStatementStatsResponse(item)
+	}
+
+	s.respondJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) ResetStatementStats(w http.ResponseWriter, r *http.Request, connectionID contract.ConnectionId) {
+	cmd := commands.ResetStatementStatsCmd{ConnectionID: uuid.UUID(connectionID)}
+
+	if err := s.app.Commands.ResetStatementStats.Handle(r.Context(), cmd); err != nil {
+		if errors.Is(err, commands.ErrConnectionNotFound) {
+			s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
+			return
+		}
+		if s.respondGuardError(w, err) {
+			return
+		}
+		if errors.Is(err, connection.ErrExtensionNotInstalled) {
+			s.respondError(w, http.StatusConflict, err.Error())
+			return
+		}
+		slog.WarnContext(r.Context(), "resetStatementStats failed", "error", err)
+		s.respondError(w, http.StatusInternalServerError, ErrInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) QueryMetrics(w http.ResponseWriter, r *http.Request, connectionID contract.ConnectionId, params contract.QueryMetricsParams) {
+	query := queries.QueryMetrics{
+		ConnectionID: uuid.UUID(connectionID),
+		To:           time.Now(),
+	}
+	if params.To != nil {
+		query.To = *params.To
+	}
+	query.From = query.To.Add(-time.Hour)
+	if params.From != nil {
+		query.From = *params.From
+	}
+	if params.Resolution != nil {
+		resolution, err := metric.NewResolution(string(*params.Resolution))
+		if err != nil {
+			s.respondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		query.Resolution = &resolution
+	}
+
+	series, err := s.app.Queries.QueryMetrics.Handle(r.Context(), query)
+	if err != nil {
+		switch {
+		case errors.Is(err, queries.ErrConnectionNotFound):
+			s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
+		case errors.Is(err, queries.ErrInvalidTimeRange):
+			s.respondError(w, http.StatusBadRequest, err.Error())
+		default:
+			slog.WarnContext(r.Context(), "queryMetrics failed", "error", err)
+			s.respondError(w, http.StatusInternalServerError, ErrInternalServerError)
+		}
+		return
+	}
+
+	resp := contract.MetricSeries{
+		Resolution: contract.MetricResolution(series.Resolution),
+		Samples:    make([]contract.MetricSample, len(series.Samples)),
+	}
+	for i, sample := range series.Samples {
+		resp.Samples[i] = newMetricSampleResponse(sample)
+	}
+
+	s.respondJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) GetReplication(w http.ResponseWriter, r *http.Request, connectionID contract.ConnectionId) {
+	overview, err := s.app.Queries.GetReplication.Handle(r.Context(), uuid.UUID(connectionID))
+	if err != nil {
+		if errors.Is(err, queries.ErrConnectionNotFound) {
+			s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
+			return
+		}
+		slog.WarnContext(r.Context(), "getReplication failed", "error", err)
+		s.respondError(w, http.StatusInternalServerError, ErrInternalServerError)
+		return
+	}
+
+	s.respondJSON(w, http.StatusOK, newReplicationResponse(*overview))
+}
+
+func (s *Server) ListSettings(w http.ResponseWriter, r *http.Request, connectionID contract.ConnectionId, params contract.ListSettingsParams) {
+	query := queries.ListSettings{ConnectionID: uuid.UUID(connectionID)}
+	if params.Search != nil {
+		query.Search = *params.Search
+	}
+
+	groups, err := s.app.Queries.ListSettings.Handle(r.Context(), query)
+	if err != nil {
+		if errors.Is(err, queries.ErrConnectionNotFound) {
+			s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
+			return
+		}
+		slog.WarnContext(r.Context(), "listSettings failed", "error", err)
+		s.respondError(w, http.StatusInternalServerError, ErrInternalServerError)
+		return
+	}
+
+	resp := make([]contract.SettingCategory, len(groups))
+	for i, group := range groups {
+		resp[i] = contract.SettingCategory{
+			Category: group.Category,
+			Settings: make([]contract.Setting, len(group.Settings)),
+		}
+		for j, setting := range group.Settings {
+			resp[i].Settings[j] = newSettingResponse(setting)
+		}
+	}
+
+	s.respondJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) AlterSettings(w http.ResponseWriter, r *http.Request, connectionID contract.ConnectionId) {
+	var body contract.AlterSettingsRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		s.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	changes, err := mapSettingChanges(body.Changes)
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	applied, err := s.app.Commands.AlterSystem.Handle(r.Context(), commands.AlterSystemCmd{
+		ConnectionID: uuid.UUID(connectionID),
+		Changes:      changes,
+	})
+	if err != nil {
+		if s.respondGuardError(w, err) {
+			return
+		}
+		switch {
+		case errors.Is(err, commands.ErrConnectionNotFound):
+			s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
+		case errors.Is(err, connection.ErrInvalidSettingName),
+			errors.Is(err, connection.ErrNoSettingChanges),
+			errors.Is(err, connection.ErrQueryFailed):
+			// Unknown parameters and invalid values are reported by the server itself.
+			s.respondError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, connection.ErrPermissionDenied):
+			s.respondError(w, http.StatusForbidden, err.Error())
+		default:
+			slog.WarnContext(r.Context(), "alterSettings failed", "error", err)
+			s.respondError(w, http.StatusInternalServerError, ErrInternalServerError)
+		}
+		return
+	}
+
+	resp := contract.AlterSettingsResponse{
+		Settings: make([]contract.Setting, len(applied)),
+	}
+	for i, setting := range applied {
+		resp.Settings[i] = newSettingResponse(setting.Setting)
+		resp.RestartRequired = resp.RestartRequired || setting.RestartRequired
+	}
+
+	s.respondJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) GetBlockingTree(w http.ResponseWriter, r *http.Request, connectionID contract.ConnectionId) {
+	tree, err := s.app.Queries.GetBlockingTree.Handle(r.Context(), uuid.UUID(connectionID))
+	if err != nil {
+		if errors.Is(err, queries.ErrConnectionNotFound) {
+			s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
+			return
+		}
+		if errors.Is(err, connection.ErrUnsupportedServerVersion) {
+			s.respondError(w, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+		slog.WarnContext(r.Context(), "getBlockingTree failed", "error", err)
+		s.respondError(w, http.StatusInternalServerError, ErrInternalServerError)
+		return
+	}
+
+	resp := contract.BlockingTree{
+		Roots:        make([]contract.BlockingNode, len(tree.Roots)),
+		WaitingCount: tree.WaitingCount,
+	}
+	for i, root := range tree.Roots {
+		resp.Roots[i] = newBlockingNodeResponse(root)
+	}
+
+	s.respondJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) CancelSession(w http.ResponseWriter, r *http.Request, connectionID contract.ConnectionId, pid int) {
+	cmd := commands.CancelSessionCmd{
+		ConnectionID: uuid.UUID(connectionID),
+		PID:          pid,
+	}
+
+	if err := s.app.Commands.CancelSession.Handle(r.Context(), cmd); err != nil {
+		if errors.Is(err, commands.ErrConnectionNotFound) {
+			s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
+			return
+		}
+		if s.respondGuardError(w, err) {
+			return
+		}
+		if errors.Is(err, connection.ErrResourceNotFound) {
+			s.respondError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		slog.WarnContext(r.Context(), "cancelSession failed", "pid", pid, "error", err)
+		s.respondError(w, http.StatusInternalServerError, ErrInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) SignalSessions(w http.ResponseWriter, r *http.Request, connectionID contract.ConnectionId) {
+	var body contract.SignalSessionsRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		s.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	signal, err := connection.NewSessionSignal(string(body.Action))
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	filter, err := mapSessionFilter(body.Filter)
+	if err != nil {
+		s.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := commands.SignalSessionsCmd{
+		ConnectionID: uuid.UUID(connectionID),
+		Signal:       signal,
+		Filter:       filter,
+		DryRun:       ptrToBool(body.DryRun),
+	}
+
+	result, err := s.app.Commands.SignalSessions.Handle(r.Context(), cmd)
+	if err != nil {
+		if errors.Is(err, commands.ErrConnectionNotFound) {
+			s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
+			return
+		}
+		if s.respondGuardError(w, err) {
+			return
+		}
+		if errors.Is(err, commands.ErrInvalidInput) {
+			s.respondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		slog.WarnContext(r.Context(), "signalSessions failed", "error", err)
+		s.respondError(w, http.StatusInternalServerError, ErrInternalServerError)
+		return
+	}
+
+	s.respondJSON(w, http.StatusOK, contract.SignalSessionsResponse{
+		DryRun:    cmd.DryRun,
+		Matched:   result.Matched,
+		Signalled: result.Signalled,
+		Failed:    result.Failed,
+	})
+}
+
+func (s *Server) ListAlertRules(w http.ResponseWriter, r *http.Request) {
+	rules, err := s.app.Queries.ListAlertRules.Handle(r.Context())
+	if err != nil {
+		slog.WarnContext(r.Context(), "listAlertRules failed", "error", err)
+		s.respondError(w, http.StatusInternalServerError, ErrInternalServerError)
+		return
+	}
+
+	resp := make([]contract.AlertRule, len(rules))
+	for i, rule := range rules {
+		resp[i] = newAlertRuleResponse(*rule)
+	}
+
+	s.respondJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) CreateAlertRule(w http.ResponseWriter, r *http.Request) {
+	s.saveAlertRule(w, r, nil, http.StatusCreated)
+}
+
+func (s *Server) UpdateAlertRule(w http.ResponseWriter, r *http.Request, ruleID contract.AlertRuleId) {
+	id := uuid.UUID(ruleID)
+	s.saveAlertRule(w, r, &id, http.StatusOK)
+}
+
+func (s *Server) saveAlertRule(w http.ResponseWriter, r *http.Request, ruleID *uuid.UUID, status int) {
+	var body contract.AlertRuleRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		s.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	rule, err := s.app.Commands.SaveAlertRule.Handle(r.Context(), mapAlertRuleRequest(ruleID, body))
+	if err != nil {
+		switch {
+		case errors.Is(err, commands.ErrAlertRuleNotFound):
+			s.respondError(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, commands.ErrConnectionNotFound):
+			s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
+		case errors.Is(err, alert.ErrEmptyRuleName),
+			errors.Is(err, alert.ErrInvalidCondition),
+			errors.Is(err, alert.ErrInvalidChannel),
+			errors.Is(err, alert.ErrInvalidThreshold),
+			errors.Is(err, alert.ErrInvalidTarget):
+			s.respondError(w, http.StatusBadRequest, err.Error())
+		default:
+			slog.WarnContext(r.Context(), "saveAlertRule failed", "error", err)
+			s.respondError(w, http.StatusInternalServerError, ErrInternalServerError)
+		}
+		return
+	}
+
+	s.respondJSON(w, status, newAlertRuleResponse(*rule))
+}
+
+func (s *Server) DeleteAlertRule(w http.ResponseWriter, r *http.Request, ruleID contract.AlertRuleId) {
+	if err := s.app.Commands.DeleteAlertRule.Handle(r.Context(), uuid.UUID(ruleID)); err != nil {
+		if errors.Is(err, commands.ErrAlertRuleNotFound) {
+			s.respondError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		slog.WarnContext(r.Context(), "deleteAlertRule failed", "rule_id", ruleID, "error", err)
+		s.respondError(w, http.StatusInternalServerError, ErrInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) TestAlertRule(w http.ResponseWriter, r *http.Request, ruleID contract.AlertRuleId) {
+	if err := s.app.Commands.TestAlertRule.Handle(r.Context(), uuid.UUID(ruleID)); err != nil {
+		switch {
+		case errors.Is(err, commands.ErrAlertRuleNotFound):
+			s.respondError(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, commands.ErrNotificationFailed):
+			s.respondError(w, http.StatusBadGateway, err.Error())
+		default:
+			slog.WarnContext(r.Context(), "testAlertRule failed", "rule_id", ruleID, "error", err)
+			s.respondError(w, http.StatusInternalServerError, ErrInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) ListAlerts(w http.ResponseWriter, r *http.Request, params contract.ListAlertsParams) {
+	query := queries.ListAlerts{}
+	if params.ConnectionId != nil {
+		id := uuid.UUID(*params.ConnectionId)
+		query.ConnectionID = &id
+	}
+	if params.Status != nil {
+		for _, status := range *params.Status {
+			query.Statuses = append(query.Statuses, alert.Status(status))
+		}
+	}
+
+	alerts, err := s.app.Queries.ListAlerts.Handle(r.Context(), query)
+	if err != nil {
+		slog.WarnContext(r.Context(), "listAlerts failed", "error", err)
+		s.respondError(w, http.StatusInternalServerError, ErrInternalServerError)
+		return
+	}
+
+	resp := make([]contract.Alert, len(alerts))
+	for i, a := range alerts {
+		resp[i] = newAlertResponse(a)
+	}
+
+	s.respondJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) ListSavedQueries(w http.ResponseWriter, r *http.Request, params contract.ListSavedQueriesParams) {
+	query := queries.ListSavedQueries{
+		Tag:    ptrToString(params.Tag),
+		Search: ptrToString(params.Search),
+	}
+	if params.ConnectionId != nil {
+		id := uuid.UUID(*params.ConnectionId)
+		query.ConnectionID = &id
+	}
+
+	saved, err := s.app.Queries.ListSavedQueries.Handle(r.Context(), query)
+	if err != nil {
+		s.respondSavedQueryError(w, r, "listSavedQueries", err)
+		return
+	}
+
+	resp := make([]contract.SavedQuery, len(saved))
+	for i, q := range saved {
+		resp[i] = newSavedQueryResponse(*q)
+	}
+
+	s.respondJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) CreateSavedQuery(w http.ResponseWriter, r *http.Request) {
+	s.saveSavedQuery(w, r, nil, http.StatusCreated)
+}
+
+func (s *Server) UpdateSavedQuery(w http.ResponseWriter, r *http.Request, queryID contract.SavedQueryId) {
+	id := uuid.UUID(queryID)
+	s.saveSavedQuery(w, r, &id, http.StatusOK)
+}
+
+func (s *Server) saveSavedQuery(w http.ResponseWriter, r *http.Request, queryID *uuid.UUID, status int) {
+	var body contract.SavedQueryRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		s.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	q, err := s.app.Commands.SaveSavedQuery.Handle(r.Context(), mapSavedQueryRequest(queryID, body))
+	if err != nil {
+		s.respondSavedQueryError(w, r, "saveSavedQuery", err)
+		return
+	}
+
+	s.respondJSON(w, status, newSavedQueryResponse(*q))
+}
+
+func (s *Server) GetSavedQuery(w http.ResponseWriter, r *http.Request, queryID contract.SavedQueryId) {
+	q, err := s.app.Queries.FindSavedQuery.Handle(r.Context(), uuid.UUID(queryID))
+	if err != nil {
+		s.respondSavedQueryError(w, r, "getSavedQuery", err)
+		return
+	}
+
+	s.respondJSON(w, http.StatusOK, newSavedQueryResponse(*q))
+}
+
+func (s *Server) DeleteSavedQuery(w http.ResponseWriter, r *http.Request, queryID contract.SavedQueryId) {
+	if err := s.app.Commands.DeleteSavedQuery.Handle(r.Context(), uuid.UUID(queryID)); err != nil {
+		s.respondSavedQueryError(w, r, "deleteSavedQuery", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) ExecuteSavedQuery(w http.ResponseWriter, r *http.Request, queryID contract.SavedQueryId) {
+	var body contract.ExecuteSavedQueryRequest
+	// Numbers stay json.Number so that integers above 2^53 and numerics keep their digits.
+	dec := json.NewDecoder(r.Body)
+	dec.UseNumber()
+	if err := dec.Decode(&body); err != nil {
+		s.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := commands.ExecuteSavedQueryCmd{
+		QueryID:      uuid.UUID(queryID),
+		ConnectionID: uuid.UUID(body.ConnectionId),
+		Database:     body.Database,
+	}
+	if body.Params != nil {
+		cmd.Params = *body.Params
+	}
+	if body.MaxRows != nil {
+		cmd.MaxRows = *body.MaxRows
+	}
+
+	result, err := s.app.Commands.ExecuteSavedQuery.Handle(r.Context(), cmd)
+	if err != nil {
+		s.respondSavedQueryError(w, r, "executeSavedQuery", err)
+		return
+	}
+
+	s.respondJSON(w, http.StatusOK, newQueryResultResponse(result))
+}
+
+// respondSavedQueryError maps the errors of the saved query endpoints to HTTP responses.
+func (s *Server) respondSavedQueryError(w http.ResponseWriter, r *http.Request, operation string, err error) {
+	if s.respondGuardError(w, err) {
+		return
+	}
+	switch {
+	case errors.Is(err, commands.ErrConnectionNotFound),
+		errors.Is(err, queries.ErrConnectionNotFound):
+		s.respondError(w, http.StatusNotFound, ErrConnectionNotFound)
+	case errors.Is(err, savedquery.ErrSavedQueryNotFound):
+		s.respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, commands.ErrInvalidInput),
+		errors.Is(err, connection.ErrInvalidDriver),
+		errors.Is(err, connection.ErrInvalidIdentifier),
+		errors.Is(err, connection.ErrQueryFailed),
+		errors.Is(err, savedquery.ErrEmptyName),
+		errors.Is(err, savedquery.ErrEmptySQL),
+		errors.Is(err, savedquery.ErrScopeConflict),
+		errors.Is(err, savedquery.ErrOutOfScope),
+		errors.Is(err, savedquery.ErrInvalidParamType),
+		errors.Is(err, savedquery.ErrInvalidParamName),
+		errors.Is(err, savedquery.ErrDuplicateParam),
+		errors.Is(err, savedquery.ErrUndeclaredParam),
+		errors.Is(err, savedquery.ErrUnusedParam),
+		errors.Is(err, savedquery.ErrPositionalParam),
+		errors.Is(err, savedquery.ErrMissingParam),
+		errors.Is(err, savedquery.ErrUnknownParam),
+		errors.Is(err, savedquery.ErrInvalidParamValue):
+		s.respondError(w, http.StatusBadRequest, err.Error())
+	default:
+		slog.WarnContext(r.Context(), operation+" failed", "error", err)
+		s.respondError(w, http.StatusInternalServerError, ErrInternalServerError)
+	}
+}
+
+func (s *Server) SearchHistory(w http.ResponseWriter, r *http.Request, params contract.SearchHistoryParams) {
+	filter := history.Filter{
+		Database:   ptrToString(params.Database),
+		Search:     ptrToString(params.Search),
+		Since:      params.Since,
+		Until:      params.Until,
+		FailedOnly: ptrToBool(params.Failed),
+	}
+	if params.ConnectionId != nil {
+		id := uuid.UUID(*params.ConnectionId)
+		filter.ConnectionID = &id
+	}
+	if params.Source != nil {
+		source, err := history.NewSource(string(*params.Source))
+		if err != nil {
+			s.respondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		filter.Source = &source
+	}
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > 1000 {
+			s.respondError(w, http.StatusBadRequest, "limit must be between 1 and 1000")
+			return
+		}
+		filter.Limit = *params.Limit
+	}
+
+	entries, err := s.app.Queries.SearchHistory.Handle(r.Context(), filter)
+	if err != nil {
+		slog.WarnContext(r.Context(), "searchHistory failed", "error", err)
+		s.respondError(w, http.StatusInternalServerError, ErrInternalServerError)
+		return
+	}
+
+	resp := make([]contract.HistoryEntry, len(entries))
+	for i, e := range entries {
+		resp[i] = newHistoryEntryResponse(e)
+	}
+
+	s.respondJSON(w, http.StatusOK, resp)
+}
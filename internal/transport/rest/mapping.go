@@ -1,8 +1,15 @@
 package rest
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/felipemalacarne/mesa/internal/application/commands"
+	"github.com/felipemalacarne/mesa/internal/domain/connection"
 	"github.com/felipemalacarne/mesa/internal/transport/rest/contract"
+	"github.com/google/uuid"
 )
 
 func mapTableColumns(cols []contract.CreateTableColumn) []commands.TableColumn {
@@ -41,9 +48,194 @@ func mapTableIndexes(idxs *[]contract.CreateTableIndex) []commands.TableIndex {
 	return result
 }
 
+// mapSSHTunnel maps the tunnel of a request; the command picks the authentication method.
+func mapSSHTunnel(t contract.SSHTunnelRequest) *commands.SSHTunnelInput {
+	input := &commands.SSHTunnelInput{
+		Host:       t.Host,
+		Username:   t.Username,
+		Password:   ptrToString(t.Password),
+		PrivateKey: ptrToString(t.PrivateKey),
+		Passphrase: ptrToString(t.Passphrase),
+		KnownHosts: t.KnownHosts,
+	}
+	if t.Port != nil {
+		input.Port = *t.Port
+	}
+	return input
+}
+
 func ptrToBool(b *bool) bool {
 	if b == nil {
 		return false
 	}
 	return *b
 }
+
+func ptrToString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// schemaFromParam resolves the optional schema query parameter, defaulting to "public".
+func schemaFromParam(schema *contract.SchemaName) (connection.Identifier, error) {
+	if schema == nil || *schema == "" {
+		return connection.NewIdentifier("public")
+	}
+	return connection.NewIdentifier(*schema)
+}
+
+// mapSessionFilter validates the session filter shared by the listing and the bulk actions.
+func mapSessionFilter(f contract.SessionFilter) (connection.SessionFilter, error) {
+	filter := connection.SessionFilter{}
+	if f.User != nil {
+		filter.User = *f.User
+	}
+	if f.Database != nil {
+		filter.Database = *f.Database
+	}
+	if f.States != nil {
+		for _, raw := range *f.States {
+			state, err := connection.NewSessionState(string(raw))
+			if err != nil {
+				return filter, err
+			}
+			filter.States = append(filter.States, state)
+		}
+	}
+	if f.MinDurationSeconds != nil {
+		if *f.MinDurationSeconds < 0 {
+			return filter, errors.New("min_duration_seconds must not be negative")
+		}
+		filter.MinDuration = time.Duration(*f.MinDurationSeconds) * time.Second
+	}
+	if f.Pids != nil {
+		filter.PIDs = *f.Pids
+	}
+	return filter, nil
+}
+
+// mapAlertRuleRequest builds the save command; ruleID nil creates a new rule.
+func mapAlertRuleRequest(ruleID *uuid.UUID, body contract.AlertRuleRequest) commands.SaveAlertRuleCmd {
+	cmd := commands.SaveAlertRuleCmd{
+		RuleID:            ruleID,
+		Name:              body.Name,
+		Condition:         string(body.Condition),
+		ConsecutiveChecks: 1,
+		Channel:           string(body.Channel),
+		Target:            body.Target,
+		Enabled:           true,
+	}
+	if body.ConnectionId != nil {
+		id := uuid.UUID(*body.ConnectionId)
+		cmd.ConnectionID = &id
+	}
+	if body.Threshold != nil {
+		cmd.Threshold = *body.Threshold
+	}
+	if body.ConsecutiveChecks != nil {
+		cmd.ConsecutiveChecks = *body.ConsecutiveChecks
+	}
+	if body.Enabled != nil {
+		cmd.Enabled = *body.Enabled
+	}
+	return cmd
+}
+
+// mapSavedQueryRequest builds the save command; queryID nil creates a new saved query.
+func mapSavedQueryRequest(queryID *uuid.UUID, body contract.SavedQueryRequest) commands.SaveSavedQueryCmd {
+	cmd := commands.SaveSavedQueryCmd{
+		QueryID:     queryID,
+		Name:        body.Name,
+		Description: ptrToString(body.Description),
+		SQL:         body.Sql,
+		Driver:      body.Driver,
+	}
+	if body.ConnectionId != nil {
+		id := uuid.UUID(*body.ConnectionId)
+		cmd.ConnectionID = &id
+	}
+	if body.Tags != nil {
+		cmd.Tags = *body.Tags
+	}
+	if body.Params != nil {
+		for _, p := range *body.Params {
+			cmd.Params = append(cmd.Params, commands.SavedQueryParam{
+				Name:        p.Name,
+				Type:        string(p.Type),
+				Description: ptrToString(p.Description),
+				Default:     p.Default,
+			})
+		}
+	}
+	return cmd
+}
+
+// mapSettingChanges turns the request into ALTER SYSTEM changes; a nil Value means RESET.
+func mapSettingChanges(changes []contract.SettingChange) ([]commands.SettingChangeInput, error) {
+	result := make([]commands.SettingChangeInput, len(changes))
+	for i, change := range changes {
+		result[i] = commands.SettingChangeInput{Name: change.Name}
+		if ptrToBool(change.Reset) {
+			continue
+		}
+		if change.Value == nil {
+			return nil, fmt.Errorf("%w: %s: value is required unless reset is true", commands.ErrInvalidInput, change.Name)
+		}
+		result[i].Value = change.Value
+	}
+	return result, nil
+}
+
+// optionalIdentifier validates an optional name; empty strings count as absent.
+func optionalIdentifier(value *string) (*connection.Identifier, error) {
+	if value == nil || *value == "" {
+		return nil, nil
+	}
+	id, err := connection.NewIdentifier(*value)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// mapPrivilegeChange validates the names of a GRANT/REVOKE request. "PUBLIC" (any case) as
+// grantee means every role.
+func mapPrivilegeChange(body contract.PrivilegeChangeRequest) (connection.PrivilegeChange, error) {
+	objectType, err := connection.ParsePrivilegeObjectType(string(body.ObjectType))
+	if err != nil {
+		return connection.PrivilegeChange{}, err
+	}
+
+	change := connection.PrivilegeChange{
+		ObjectType:  objectType,
+		Arguments:   body.Arguments,
+		AllInSchema: ptrToBool(body.AllInSchema),
+		GrantOption: ptrToBool(body.GrantOption),
+		Cascade:     ptrToBool(body.Cascade),
+		Default:     ptrToBool(body.Default),
+	}
+
+	if change.Schema, err = optionalIdentifier(body.Schema); err != nil {
+		return change, fmt.Errorf("schema: %w", err)
+	}
+	if change.Object, err = optionalIdentifier(body.Name); err != nil {
+		return change, fmt.Errorf("name: %w", err)
+	}
+	if change.ForRole, err = optionalIdentifier(body.ForRole); err != nil {
+		return change, fmt.Errorf("for_role: %w", err)
+	}
+	if body.Grantee != nil && !strings.EqualFold(*body.Grantee, "public") {
+		if change.Grantee, err = optionalIdentifier(body.Grantee); err != nil {
+			return change, fmt.Errorf("grantee: %w", err)
+		}
+	}
+
+	if body.Privileges != nil {
+		for _, p := range *body.Privileges {
+			change.Privileges = append(change.Privileges, connection.Privilege(strings.ToUpper(strings.TrimSpace(p))))
+		}
+	}
+	return change, nil
+}
@@ -1,23 +1,120 @@
 package rest
 
 import (
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/felipemalacarne/mesa/internal/application/protection"
+	"github.com/felipemalacarne/mesa/internal/application/tracing"
+	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func (s *Server) RegisterMiddlewares() {
-	s.router.Use(middleware.Logger)
-	s.router.Use(middleware.Recoverer)
+	s.router.Use(middleware.RequestID)
 	s.router.Use(middleware.RealIP)
+	s.router.Use(s.instrument)
+	s.router.Use(middleware.Recoverer)
 	//cors
 	s.router.Use(cors.Handler(cors.Options{
 		// AllowedOrigins:   []string{"https://foo.com"}, // Use this to allow specific origin hosts
 		AllowedOrigins: []string{"https://*", "http://*"},
 		// AllowOriginFunc:  func(r *http.Request, origin string) bool { return true },
-		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
-		ExposedHeaders:   []string{"Link"},
+		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "X-Request-Id", "X-Confirm-Connection", "Traceparent", "Tracestate"},
+		ExposedHeaders:   []string{"Link", "X-Request-Id"},
 		AllowCredentials: false,
 		MaxAge:           300, // Maximum value not ignored by any of major browsers
 	}))
 }
+
+// instrument opens the server span of each request, logs it once finished and records its
+// latency. The span and the metric are labelled with the route pattern, known only after
+// routing, to keep their cardinality bounded.
+func (s *Server) instrument(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		ctx, span := tracing.Tracer().Start(ctx, r.Method,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.request.method", r.Method),
+				attribute.String("url.path", r.URL.Path),
+			),
+		)
+		defer span.End()
+		requestID := middleware.GetReqID(ctx)
+		ctx = tracing.WithRequestID(ctx, requestID)
+		w.Header().Set(middleware.RequestIDHeader, requestID)
+
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		next.ServeHTTP(ww, r.WithContext(ctx))
+
+		route := chi.RouteContext(r.Context()).RoutePattern()
+		if route == "" {
+			route = "unmatched"
+		}
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+		elapsed := time.Since(start)
+
+		span.SetName(r.Method + " " + route)
+		span.SetAttributes(
+			attribute.String("http.route", route),
+			attribute.Int("http.response.status_code", status),
+		)
+		level := slog.LevelInfo
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+			level = slog.LevelError
+		}
+
+		s.metrics.ObserveHTTP(r.Method, route, status, elapsed)
+		slog.Log(ctx, level, "request served",
+			"method", r.Method,
+			"path", r.URL.Path,
+			"route", route,
+			"status", status,
+			"bytes", ww.BytesWritten(),
+			"duration", elapsed,
+			"remote_addr", r.RemoteAddr,
+		)
+	})
+}
+
+// tagConnection adds the connectionID path parameter, when present, to the request logs and
+// span. It runs per operation, after the router has resolved the path parameters.
+func (s *Server) tagConnection(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if id, err := uuid.Parse(chi.URLParam(r, "connectionID")); err == nil {
+			r = r.WithContext(tracing.WithConnectionID(r.Context(), id))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// confirmationHeader carries the name of the protected connection a mutating request runs on.
+const confirmationHeader = "X-Confirm-Connection"
+
+// confirmConnection passes the confirmation header on to the commands, which decide whether
+// the connection requires it.
+func (s *Server) confirmConnection(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if confirmation := strings.TrimSpace(r.Header.Get(confirmationHeader)); confirmation != "" {
+			r = r.WithContext(protection.WithConfirmation(r.Context(), confirmation))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
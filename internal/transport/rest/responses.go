@@ -5,41 +5,103 @@ import (
 	"strings"
 	"time"
 
+	"github.com/felipemalacarne/mesa/internal/application/queries"
+	"github.com/felipemalacarne/mesa/internal/domain/alert"
+	"github.com/felipemalacarne/mesa/internal/domain/backup"
 	"github.com/felipemalacarne/mesa/internal/domain/connection"
+	"github.com/felipemalacarne/mesa/internal/domain/history"
+	"github.com/felipemalacarne/mesa/internal/domain/job"
+	"github.com/felipemalacarne/mesa/internal/domain/metric"
+	"github.com/felipemalacarne/mesa/internal/domain/savedquery"
 	"github.com/felipemalacarne/mesa/internal/transport/rest/contract"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 type connectionResponse struct {
-	ID        string `json:"id"`
-	Name      string `json:"name"`
-	Driver    string `json:"driver"`
-	Host      string `json:"host"`
-	Port      int    `json:"port"`
-	Username  string `json:"username"`
-	UpdatedAt string `json:"updated_at"`
-	CreatedAt string `json:"created_at"`
-	Status    string `json:"status"`
-	StatusErr string `json:"status_error,omitempty"`
+	ID              string             `json:"id"`
+	Name            string             `json:"name"`
+	Driver          string             `json:"driver"`
+	Host            string             `json:"host"`
+	Port            int                `json:"port"`
+	Username        string             `json:"username"`
+	Environment     string             `json:"environment"`
+	Tags            []string           `json:"tags"`
+	Color           string             `json:"color,omitempty"`
+	Description     string             `json:"description,omitempty"`
+	Protection      string             `json:"protection"`
+	ReadOnly        bool               `json:"read_only"`
+	SSHTunnel       *sshTunnelResponse `json:"ssh_tunnel,omitempty"`
+	UpdatedAt       string             `json:"updated_at"`
+	CreatedAt       string             `json:"created_at"`
+	Status          string             `json:"status"`
+	StatusErr       string             `json:"status_error,omitempty"`
+	StatusCheckedAt *string            `json:"status_checked_at,omitempty"`
+	LastSuccessAt   *string            `json:"last_success_at,omitempty"`
+	LatencyMs       *int64             `json:"latency_ms,omitempty"`
+}
+
+// sshTunnelResponse describes the bastion of a connection, without its secrets.
+type sshTunnelResponse struct {
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	Username string `json:"username"`
+	Auth     string `json:"auth"`
 }
 
-func newConnectionResponse(c *connection.Connection) connectionResponse {
-	return connectionResponse{
-		ID:        c.ID.String(),
-		Name:      c.Name,
-		Driver:    c.Driver.String(),
-		Host:      c.Host,
-		Port:      c.Port,
-		Username:  c.Username,
-		UpdatedAt: c.UpdatedAt.Format(time.RFC3339),
-		CreatedAt: c.CreatedAt.Format(time.RFC3339),
+// newConnectionResponse maps a connection and its last health check, nil when never checked.
+func newConnectionResponse(c *connection.Connection, health *connection.HealthCheck) connectionResponse {
+	resp := connectionResponse{
+		ID:          c.ID.String(),
+		Name:        c.Name,
+		Driver:      c.Driver.String(),
+		Host:        c.Host,
+		Port:        c.Port,
+		Username:    c.Username,
+		Environment: string(c.Environment),
+		Tags:        c.Tags,
+		Color:       c.Color,
+		Description: c.Description,
+		Protection:  string(c.Protection),
+		ReadOnly:    c.ReadOnly,
+		UpdatedAt:   c.UpdatedAt.Format(time.RFC3339),
+		CreatedAt:   c.CreatedAt.Format(time.RFC3339),
+		Status:      string(connection.HealthUnknown),
+	}
+	if t := c.Tunnel; t != nil {
+		resp.SSHTunnel = &sshTunnelResponse{Host: t.Host, Port: t.Port, Username: t.Username, Auth: string(t.Auth)}
+	}
+	if health == nil {
+		return resp
 	}
+
+	checkedAt := health.CheckedAt.Format(time.RFC3339)
+	latency := health.Latency.Milliseconds()
+	resp.Status = string(health.Status)
+	resp.StatusErr = health.Error
+	resp.StatusCheckedAt = &checkedAt
+	resp.LatencyMs = &latency
+	if health.LastSuccessAt != nil {
+		lastSuccess := health.LastSuccessAt.Format(time.RFC3339)
+		resp.LastSuccessAt = &lastSuccess
+	}
+	return resp
 }
 
 type databaseResponse struct {
-	Name          string `json:"name"`
-	Owner         string `json:"owner"`
-	Encoding      string `json:"encoding"`
-	SizeFormatted string `json:"size_formatted"`
+	Name          string     `json:"name"`
+	Owner         string     `json:"owner"`
+	Encoding      string     `json:"encoding"`
+	Collation     string     `json:"collation"`
+	Ctype         string     `json:"ctype"`
+	Tablespace    string     `json:"tablespace"`
+	ConnLimit     int        `json:"conn_limit"`
+	Size          int64      `json:"size"`
+	SizeFormatted string     `json:"size_formatted"`
+	TableCount    *int       `json:"table_count,omitempty"`
+	Connections   int        `json:"connections"`
+	Commits       int64      `json:"commits"`
+	Rollbacks     int64      `json:"rollbacks"`
+	LastActivity  *time.Time `json:"last_activity,omitempty"`
 }
 
 func newDatabaseResponse(d connection.Database) databaseResponse {
@@ -47,7 +109,17 @@ func newDatabaseResponse(d connection.Database) databaseResponse {
 		Name:          d.Name,
 		Owner:         d.Owner,
 		Encoding:      d.Encoding,
+		Collation:     d.Collation,
+		Ctype:         d.Ctype,
+		Tablespace:    d.Tablespace,
+		ConnLimit:     d.ConnLimit,
+		Size:          d.Size,
 		SizeFormatted: formatBytes(d.Size),
+		TableCount:    d.TableCount,
+		Connections:   d.Connections,
+		Commits:       d.Commits,
+		Rollbacks:     d.Rollbacks,
+		LastActivity:  d.LastActivity,
 	}
 }
 
@@ -92,40 +164,69 @@ func newOverviewResponse(h *connection.ServerHealth, latencyMs int64) overviewRe
 }
 
 type sessionResponse struct {
-	PID      int    `json:"pid"`
-	User     string `json:"user"`
-	Database string `json:"database"`
-	State    string `json:"state"`
-	Query    string `json:"query"`
-	Duration string `json:"duration"`
-	IsSlow   bool   `json:"is_slow"`
+	PID             int    `json:"pid"`
+	User            string `json:"user"`
+	Database        string `json:"database"`
+	State           string `json:"state"`
+	Query           string `json:"query"`
+	Duration        string `json:"duration"`
+	IsSlow          bool   `json:"is_slow"`
+	ClientAddr      string `json:"client_addr,omitempty"`
+	ApplicationName string `json:"application_name,omitempty"`
+	WaitEventType   string `json:"wait_event_type,omitempty"`
+	WaitEvent       string `json:"wait_event,omitempty"`
+	BackendType     string `json:"backend_type,omitempty"`
 }
 
 func newSessionResponse(s connection.Session) sessionResponse {
 	return sessionResponse{
-		PID:      s.PID,
-		User:     s.User,
-		Database: s.Database,
-		State:    s.State,
-		Query:    s.Query,
-		Duration: formatClock(s.Duration),
-		IsSlow:   s.Duration > time.Minute,
+		PID:             s.PID,
+		User:            s.User,
+		Database:        s.Database,
+		State:           s.State,
+		Query:           s.Query,
+		Duration:        formatClock(s.Duration),
+		IsSlow:          s.Duration > time.Minute,
+		ClientAddr:      s.ClientAddr,
+		ApplicationName: s.ApplicationName,
+		WaitEventType:   s.WaitEventType,
+		WaitEvent:       s.WaitEvent,
+		BackendType:     s.BackendType,
 	}
 }
 
 type dbUserResponse struct {
-	Name        string `json:"name"`
-	IsSuperUser bool   `json:"is_superuser"`
-	CanLogin    bool   `json:"can_login"`
-	ConnLimit   int    `json:"conn_limit"`
+	Name          string                   `json:"name"`
+	IsSuperUser   bool                     `json:"is_superuser"`
+	CanLogin      bool                     `json:"can_login"`
+	CanCreateDB   bool                     `json:"can_create_db"`
+	CanCreateRole bool                     `json:"can_create_role"`
+	Replication   bool                     `json:"replication"`
+	ConnLimit     int                      `json:"conn_limit"`
+	ValidUntil    *time.Time               `json:"valid_until,omitempty"`
+	MemberOf      []roleMembershipResponse `json:"member_of"`
+}
+
+type roleMembershipResponse struct {
+	Role        string `json:"role"`
+	AdminOption bool   `json:"admin_option"`
 }
 
 func newDBUserResponse(u connection.DBUser) dbUserResponse {
+	memberOf := make([]roleMembershipResponse, len(u.MemberOf))
+	for i, m := range u.MemberOf {
+		memberOf[i] = roleMembershipResponse{Role: m.Role, AdminOption: m.AdminOption}
+	}
 	return dbUserResponse{
-		Name:        u.Name.String(),
-		IsSuperUser: u.IsSuperUser,
-		CanLogin:    u.CanLogin,
-		ConnLimit:   u.ConnLimit,
+		Name:          u.Name.String(),
+		IsSuperUser:   u.IsSuperUser,
+		CanLogin:      u.CanLogin,
+		CanCreateDB:   u.CanCreateDB,
+		CanCreateRole: u.CanCreateRole,
+		Replication:   u.Replication,
+		ConnLimit:     u.ConnLimit,
+		ValidUntil:    u.ValidUntil,
+		MemberOf:      memberOf,
 	}
 }
 
@@ -137,9 +238,9 @@ func newColumnResponse(c connection.Column) contract.Column {
 	}
 	return contract.Column{
 		Name:         c.Name.String(),
-		Type:     c.Type.Format(),
-		Nullable: c.Nullable,
-		Primary:  c.Primary,
+		Type:         c.Type.Format(),
+		Nullable:     c.Nullable,
+		Primary:      c.Primary,
 		DefaultValue: defaultValue,
 	}
 }
@@ -212,3 +313,582 @@ func formatClock(d time.Duration) string {
 
 	return fmt.Sprintf("%02d:%02d:%02d", hours, minutes, seconds)
 }
+
+func newViewResponse(v connection.View) contract.View {
+	return contract.View{
+		Schema:  v.Schema,
+		Name:    v.Name,
+		Owner:   v.Owner,
+		Comment: optionalString(v.Comment),
+	}
+}
+
+func newMaterializedViewResponse(v connection.MaterializedView) contract.MaterializedView {
+	return contract.MaterializedView{
+		Schema:             v.Schema,
+		Name:               v.Name,
+		Owner:              v.Owner,
+		Populated:          v.Populated,
+		Size:               v.Size,
+		DataFileModifiedAt: v.DataFileModifiedAt,
+		Comment:            optionalString(v.Comment),
+	}
+}
+
+func newFunctionResponse(f connection.Function) contract.Function {
+	return contract.Function{
+		Schema:          f.Schema,
+		Name:            f.Name,
+		Kind:            contract.FunctionKind(f.Kind),
+		Signature:       f.Signature(),
+		Arguments:       f.Arguments,
+		ReturnType:      f.ReturnType,
+		Language:        f.Language,
+		Volatility:      contract.FunctionVolatility(f.Volatility),
+		Owner:           f.Owner,
+		SecurityDefiner: f.SecurityDefiner,
+	}
+}
+
+func newTriggerResponse(t connection.Trigger) contract.Trigger {
+	events := t.Events
+	if events == nil {
+		events = []string{}
+	}
+	return contract.Trigger{
+		Name:       t.Name,
+		Table:      t.Table,
+		Timing:     contract.TriggerTiming(t.Timing),
+		Events:     events,
+		Level:      contract.TriggerLevel(t.Level),
+		Function:   t.Function,
+		Enabled:    t.Enabled,
+		Definition: t.Definition,
+	}
+}
+
+func newSequenceResponse(s connection.Sequence) contract.Sequence {
+	return contract.Sequence{
+		Schema:       s.Schema,
+		Name:         s.Name,
+		DataType:     s.DataType,
+		Start:        s.Start,
+		Increment:    s.Increment,
+		Min:          s.Min,
+		Max:          s.Max,
+		Cache:        s.Cache,
+		Cycle:        s.Cycle,
+		CurrentValue: s.CurrentValue,
+		OwnedBy:      optionalString(s.OwnedBy),
+	}
+}
+
+func newUserTypeResponse(t connection.UserType) contract.UserType {
+	resp := contract.UserType{
+		Schema:  t.Schema,
+		Name:    t.Name,
+		Kind:    contract.UserTypeKind(t.Kind),
+		Comment: optionalString(t.Comment),
+	}
+
+	switch t.Kind {
+	case connection.TypeKindEnum:
+		labels := t.Labels
+		resp.Labels = &labels
+	case connection.TypeKindDomain:
+		notNull := t.NotNull
+		constraints := t.Constraints
+		resp.BaseType = &t.BaseType
+		resp.NotNull = &notNull
+		resp.Default = optionalString(t.Default)
+		resp.Constraints = &constraints
+	case connection.TypeKindComposite:
+		attributes := make([]contract.TypeAttribute, len(t.Attributes))
+		for i, attr := range t.Attributes {
+			attributes[i] = contract.TypeAttribute{Name: attr.Name, Type: attr.Type}
+		}
+		resp.Attributes = &attributes
+	}
+
+	return resp
+}
+
+func newTableHealthResponse(h *connection.TableHealth) contract.TableHealth {
+	return contract.TableHealth{
+		Schema:             h.Schema,
+		Name:               h.Name,
+		LiveTuples:         h.LiveTuples,
+		DeadTuples:         h.DeadTuples,
+		DeadTupleRatio:     h.DeadTupleRatio(),
+		ModsSinceAnalyze:   h.ModsSinceAnalyze,
+		SeqScans:           h.SeqScans,
+		SeqTuplesRead:      h.SeqTuplesRead,
+		IndexScans:         h.IndexScans,
+		IndexTuplesFetched: h.IndexTuplesFetched,
+		IndexScanRatio:     h.IndexScanRatio(),
+		Inserts:            h.Inserts,
+		Updates:            h.Updates,
+		HotUpdates:         h.HotUpdates,
+		Deletes:            h.Deletes,
+		LastVacuum:         h.LastVacuum,
+		LastAutovacuum:     h.LastAutovacuum,
+		LastAnalyze:        h.LastAnalyze,
+		LastAutoanalyze:    h.LastAutoanalyze,
+		VacuumCount:        h.VacuumCount,
+		AutovacuumCount:    h.AutovacuumCount,
+		AnalyzeCount:       h.AnalyzeCount,
+		AutoanalyzeCount:   h.AutoanalyzeCount,
+		HeapSize:           h.HeapSize,
+		ToastSize:          h.ToastSize,
+		IndexSize:          h.IndexSize,
+		TotalSize:          h.TotalSize,
+		EstimatedBloat:     h.EstimatedBloat(),
+		CacheHitRatio:      h.CacheHitRatio(),
+	}
+}
+
+func newExplainResponse(r *queries.ExplainResult) contract.ExplainResponse {
+	resp := contract.ExplainResponse{
+		Analyzed:            r.Plan.Analyzed,
+		PlanningTimeMs:      r.Plan.PlanningTime,
+		Plan:                newPlanNodeResponse(r.Plan.Root, r.Plan.Analyzed),
+		SlowestNodeIds:      make([]int, len(r.Slowest)),
+		MisestimatedNodeIds: make([]int, len(r.Misestimates)),
+		Raw:                 r.Plan.Raw,
+	}
+	if r.Plan.Analyzed {
+		resp.ExecutionTimeMs = &r.Plan.ExecutionTime
+	}
+	for i, node := range r.Slowest {
+		resp.SlowestNodeIds[i] = node.ID
+	}
+	for i, node := range r.Misestimates {
+		resp.MisestimatedNodeIds[i] = node.ID
+	}
+
+	return resp
+}
+
+func newPlanNodeResponse(n connection.PlanNode, analyzed bool) contract.PlanNode {
+	resp := contract.PlanNode{
+		Id:                 n.ID,
+		NodeType:           n.NodeType,
+		ParentRelationship: optionalString(n.ParentRelationship),
+		RelationName:       optionalString(n.RelationName),
+		Schema:             optionalString(n.Schema),
+		Alias:              optionalString(n.Alias),
+		IndexName:          optionalString(n.IndexName),
+		JoinType:           optionalString(n.JoinType),
+		Strategy:           optionalString(n.Strategy),
+		StartupCost:        n.StartupCost,
+		TotalCost:          n.TotalCost,
+		PlanRows:           n.PlanRows,
+		PlanWidth:          n.PlanWidth,
+		Filter:             optionalString(n.Filter),
+		IndexCond:          optionalString(n.IndexCond),
+		JoinFilter:         optionalString(n.JoinFilter),
+		HashCond:           optionalString(n.HashCond),
+		Buffers: contract.PlanBuffers{
+			SharedHit:     n.SharedHitBlocks,
+			SharedRead:    n.SharedReadBlocks,
+			SharedDirtied: n.SharedDirtiedBlocks,
+			SharedWritten: n.SharedWrittenBlocks,
+			TempRead:      n.TempReadBlocks,
+			TempWritten:   n.TempWrittenBlocks,
+		},
+		Children: make([]contract.PlanNode, len(n.Children)),
+	}
+	if len(n.Output) > 0 {
+		resp.Output = &n.Output
+	}
+	if analyzed {
+		exclusive := n.ExclusiveTime()
+		factor := n.EstimateFactor()
+		resp.ActualStartupTimeMs = &n.ActualStartupTime
+		resp.ActualTotalTimeMs = &n.ActualTotalTime
+		resp.ActualRows = &n.ActualRows
+		resp.ActualLoops = &n.ActualLoops
+		resp.ExclusiveTimeMs = &exclusive
+		resp.EstimateFactor = &factor
+		resp.RowsRemovedByFilter = &n.RowsRemovedByFilter
+	}
+	for i, child := range n.Children {
+		resp.Children[i] = newPlanNodeResponse(child, analyzed)
+	}
+
+	return resp
+}
+
+func newStatementStatsResponse(st connection.StatementStats) contract.StatementStats {
+	return contract.StatementStats{
+		QueryId:         st.QueryID,
+		Query:           st.Query,
+		User:            st.User,
+		Database:        st.Database,
+		Calls:           st.Calls,
+		TotalTimeMs:     st.TotalTime,
+		MeanTimeMs:      st.MeanTime,
+		MinTimeMs:       st.MinTime,
+		MaxTimeMs:       st.MaxTime,
+		StddevTimeMs:    st.StddevTime,
+		Rows:            st.Rows,
+		SharedBlksHit:   st.SharedBlksHit,
+		SharedBlksRead:  st.SharedBlksRead,
+		TempBlksWritten: st.TempBlksWritten,
+		CacheHitRatio:   st.CacheHitRatio(),
+	}
+}
+
+func newBlockingNodeResponse(n connection.BlockingNode) contract.BlockingNode {
+	resp := contract.BlockingNode{
+		Pid:                        n.PID,
+		User:                       n.User,
+		Database:                   n.Database,
+		State:                      n.State,
+		Query:                      n.Query,
+		ApplicationName:            n.ApplicationName,
+		WaitEventType:              optionalString(n.WaitEventType),
+		WaitEvent:                  optionalString(n.WaitEvent),
+		LockType:                   optionalString(n.LockType),
+		LockMode:                   optionalString(n.LockMode),
+		Relation:                   optionalString(n.Relation),
+		WaitDurationSeconds:        n.WaitDuration.Seconds(),
+		TransactionDurationSeconds: n.TransactionDuration.Seconds(),
+		BlockedBy:                  make([]int, len(n.BlockedBy)),
+		Blocked:                    make([]contract.BlockingNode, len(n.Blocked)),
+	}
+	copy(resp.BlockedBy, n.BlockedBy)
+	for i, child := range n.Blocked {
+		resp.Blocked[i] = newBlockingNodeResponse(child)
+	}
+
+	return resp
+}
+
+func newJobResponse(j job.Job) contract.Job {
+	log := j.Log
+	if log == nil {
+		log = []string{}
+	}
+
+	return contract.Job{
+		Id:           j.ID,
+		ConnectionId: j.ConnectionID,
+		Kind:         contract.JobKind(j.Kind),
+		Database:     j.Database,
+		Target:       j.Target,
+		Status:       contract.JobStatus(j.Status),
+		Progress:     j.Progress,
+		Phase:        j.Phase,
+		Log:          log,
+		Error:        optionalString(j.Error),
+		Result:       optionalString(j.Result),
+		CreatedAt:    j.CreatedAt,
+		StartedAt:    j.StartedAt,
+		FinishedAt:   j.FinishedAt,
+	}
+}
+
+func newBackupResponse(b backup.Backup) contract.Backup {
+	tables := b.Tables
+	if tables == nil {
+		tables = []string{}
+	}
+
+	return contract.Backup{
+		Id:        b.ID,
+		Database:  b.Database,
+		Tables:    tables,
+		Size:      b.Size,
+		Checksum:  b.Checksum,
+		CreatedAt: b.CreatedAt,
+	}
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func newMetricSampleResponse(s metric.Sample) contract.MetricSample {
+	sizes := s.DatabaseSizes
+	if sizes == nil {
+		sizes = map[string]int64{}
+	}
+	return contract.MetricSample{
+		Time:           s.Time,
+		TotalSessions:  s.TotalSessions,
+		ActiveSessions: s.ActiveSessions,
+		MaxConnections: s.MaxConnections,
+		Tps:            s.TPS,
+		CacheHitRatio:  s.CacheHitRatio,
+		Deadlocks:      s.Deadlocks,
+		TempBytes:      s.TempBytes,
+		DatabaseSizes:  sizes,
+	}
+}
+
+func newAlertRuleResponse(r alert.Rule) contract.AlertRule {
+	resp := contract.AlertRule{
+		Id:                openapi_types.UUID(r.ID),
+		Name:              r.Name,
+		Condition:         contract.AlertCondition(r.Condition),
+		Threshold:         r.Threshold,
+		ConsecutiveChecks: r.ConsecutiveChecks,
+		Channel:           contract.AlertChannel(r.Channel),
+		Target:            r.Target,
+		Enabled:           r.Enabled,
+		CreatedAt:         r.CreatedAt,
+		UpdatedAt:         r.UpdatedAt,
+	}
+	if r.ConnectionID != nil {
+		id := openapi_types.UUID(*r.ConnectionID)
+		resp.ConnectionId = &id
+	}
+	return resp
+}
+
+func newAlertResponse(a queries.AlertView) contract.Alert {
+	return contract.Alert{
+		RuleId:              openapi_types.UUID(a.RuleID),
+		RuleName:            a.Rule.Name,
+		ConnectionId:        openapi_types.UUID(a.ConnectionID),
+		Condition:           contract.AlertCondition(a.Rule.Condition),
+		Status:              contract.AlertStatus(a.Status),
+		ConsecutiveBreaches: a.ConsecutiveBreaches,
+		Value:               a.Value,
+		Message:             a.Message,
+		FiredAt:             a.FiredAt,
+		ResolvedAt:          a.ResolvedAt,
+		EvaluatedAt:         a.EvaluatedAt,
+	}
+}
+
+func newSavedQueryResponse(q savedquery.Query) contract.SavedQuery {
+	resp := contract.SavedQuery{
+		Id:          openapi_types.UUID(q.ID),
+		Name:        q.Name,
+		Description: q.Description,
+		Sql:         q.SQL,
+		Tags:        q.Tags,
+		Params:      make([]contract.SavedQueryParam, len(q.Params)),
+		CreatedAt:   q.CreatedAt,
+		UpdatedAt:   q.UpdatedAt,
+	}
+	if q.ConnectionID != nil {
+		id := openapi_types.UUID(*q.ConnectionID)
+		resp.ConnectionId = &id
+	}
+	if q.Driver != nil {
+		driver := string(*q.Driver)
+		resp.Driver = &driver
+	}
+	for i, p := range q.Params {
+		resp.Params[i] = contract.SavedQueryParam{
+			Name:        p.Name,
+			Type:        contract.SavedQueryParamType(p.Type),
+			Description: optionalString(p.Description),
+			Default:     p.Default,
+		}
+	}
+	return resp
+}
+
+func newQueryResultResponse(r *connection.QueryResult) contract.QueryResult {
+	resp := contract.QueryResult{
+		Columns:      r.Columns,
+		Rows:         r.Rows,
+		RowsAffected: r.RowsAffected,
+		Truncated:    r.Truncated,
+		DurationMs:   float64(r.Duration.Microseconds()) / 1000,
+	}
+	if resp.Columns == nil {
+		resp.Columns = []string{}
+	}
+	if resp.Rows == nil {
+		resp.Rows = [][]any{}
+	}
+	return resp
+}
+
+func newHistoryEntryResponse(e history.Entry) contract.HistoryEntry {
+	return contract.HistoryEntry{
+		Id:           openapi_types.UUID(e.ID),
+		ConnectionId: openapi_types.UUID(e.ConnectionID),
+		Database:     e.Database,
+		Source:       contract.HistorySource(e.Source),
+		Statement:    e.Statement,
+		DurationMs:   float64(e.Duration.Microseconds()) / 1000,
+		RowsReturned: e.RowsReturned,
+		RowsAffected: e.RowsAffected,
+		Error:        optionalString(e.Error),
+		ExecutedAt:   e.ExecutedAt,
+	}
+}
+
+func newReplicationResponse(o queries.ReplicationOverview) contract.Replication {
+	resp := contract.Replication{
+		Status: contract.ReplicaStatus{
+			InRecovery:         o.Status.InRecovery,
+			ReplayPaused:       o.Status.ReplayPaused,
+			ReceiveLsn:         optionalString(o.Status.ReceiveLSN),
+			ReplayLsn:          optionalString(o.Status.ReplayLSN),
+			ReplayLagBytes:     o.Status.ReplayLagBytes,
+			LastReplayAt:       o.Status.LastReplayAt,
+			ReplayDelaySeconds: optionalSeconds(o.Status.ReplayDelay),
+		},
+		Clients:                   make([]contract.ReplicationClient, len(o.Clients)),
+		Slots:                     make([]contract.ReplicationSlot, len(o.Slots)),
+		SlotRetentionWarningBytes: o.SlotRetentionWarning,
+	}
+
+	for i, c := range o.Clients {
+		resp.Clients[i] = contract.ReplicationClient{
+			Pid:              c.PID,
+			User:             c.User,
+			ApplicationName:  c.ApplicationName,
+			ClientAddr:       c.ClientAddr,
+			State:            c.State,
+			SyncState:        c.SyncState,
+			SentLsn:          optionalString(c.SentLSN),
+			WriteLsn:         optionalString(c.WriteLSN),
+			FlushLsn:         optionalString(c.FlushLSN),
+			ReplayLsn:        optionalString(c.ReplayLSN),
+			WriteLagSeconds:  optionalSeconds(c.WriteLag),
+			FlushLagSeconds:  optionalSeconds(c.FlushLag),
+			ReplayLagSeconds: optionalSeconds(c.ReplayLag),
+			ReplayLagBytes:   c.ReplayLagBytes,
+			BackendStart:     c.BackendStart,
+		}
+	}
+
+	for i, slot := range o.Slots {
+		resp.Slots[i] = contract.ReplicationSlot{
+			Name:              slot.Name,
+			Plugin:            optionalString(slot.Plugin),
+			SlotType:          slot.SlotType,
+			Database:          optionalString(slot.Database),
+			Active:            slot.Active,
+			ActivePid:         slot.ActivePID,
+			RestartLsn:        optionalString(slot.RestartLSN),
+			ConfirmedFlushLsn: optionalString(slot.ConfirmedFlushLSN),
+			RetainedWalBytes:  slot.RetainedWALBytes,
+			WalStatus:         optionalString(slot.WALStatus),
+			AtRisk:            slot.AtRisk(o.SlotRetentionWarning),
+		}
+	}
+
+	return resp
+}
+
+func optionalSeconds(d *time.Duration) *float64 {
+	if d == nil {
+		return nil
+	}
+	seconds := d.Seconds()
+	return &seconds
+}
+
+func newSettingResponse(st connection.Setting) contract.Setting {
+	enumValues := st.EnumValues
+	if enumValues == nil {
+		enumValues = []string{}
+	}
+	return contract.Setting{
+		Name:            st.Name,
+		Value:           st.Value,
+		Unit:            optionalString(st.Unit),
+		Category:        st.Category,
+		Description:     st.Description,
+		Context:         st.Context,
+		Vartype:         contract.SettingVartype(st.VarType),
+		Source:          st.Source,
+		BootValue:       st.BootValue,
+		ResetValue:      st.ResetValue,
+		MinValue:        optionalString(st.MinValue),
+		MaxValue:        optionalString(st.MaxValue),
+		EnumValues:      enumValues,
+		PendingRestart:  st.PendingRestart,
+		RequiresRestart: st.RequiresRestart(),
+		ReadOnly:        st.ReadOnly(),
+	}
+}
+
+func newACLResponse(entries []connection.ACLEntry) []contract.ACLEntry {
+	resp := make([]contract.ACLEntry, len(entries))
+	for i, entry := range entries {
+		grantee := entry.Grantee
+		if grantee == "" {
+			grantee = "PUBLIC"
+		}
+		privileges := make([]contract.GrantedPrivilege, len(entry.Privileges))
+		for j, p := range entry.Privileges {
+			privileges[j] = contract.GrantedPrivilege{Privilege: string(p.Privilege), Grantable: p.Grantable}
+		}
+		resp[i] = contract.ACLEntry{Grantee: grantee, Grantor: entry.Grantor, Privileges: privileges}
+	}
+	return resp
+}
+
+func newDatabasePrivilegesResponse(p *connection.DatabasePrivileges) contract.DatabasePrivileges {
+	resp := contract.DatabasePrivileges{
+		Objects:  make([]contract.ObjectPrivileges, len(p.Objects)),
+		Defaults: make([]contract.DefaultPrivileges, len(p.Defaults)),
+	}
+	for i, o := range p.Objects {
+		object := contract.ObjectPrivileges{
+			ObjectType: contract.PrivilegeObjectType(o.ObjectType),
+			Schema:     optionalString(o.Schema),
+			Name:       o.Name,
+			Owner:      o.Owner,
+			Acl:        newACLResponse(o.ACL),
+		}
+		// Functions without arguments still report "", it is part of their signature.
+		if o.ObjectType == connection.PrivilegeOnFunction {
+			arguments := o.Arguments
+			object.Arguments = &arguments
+		}
+		resp.Objects[i] = object
+	}
+	for i, d := range p.Defaults {
+		resp.Defaults[i] = contract.DefaultPrivileges{
+			Role:       d.Role,
+			Schema:     optionalString(d.Schema),
+			ObjectType: contract.PrivilegeObjectType(d.ObjectType),
+			Acl:        newACLResponse(d.ACL),
+		}
+	}
+	return resp
+}
+
+func newEffectivePrivilegesResponse(m *connection.EffectivePrivileges) contract.EffectivePrivileges {
+	columns := make([]string, len(connection.TablePrivileges))
+	for i, p := range connection.TablePrivileges {
+		columns[i] = string(p)
+	}
+
+	resp := contract.EffectivePrivileges{
+		Role:       m.Role,
+		Privileges: columns,
+		Tables:     make([]contract.TableAccess, len(m.Tables)),
+	}
+	for i, t := range m.Tables {
+		granted := make(map[string]bool, len(columns))
+		for _, column := range columns {
+			granted[column] = false
+		}
+		for _, p := range t.Privileges {
+			granted[string(p)] = true
+		}
+		resp.Tables[i] = contract.TableAccess{
+			Schema:      t.Schema,
+			Table:       t.Table,
+			Owner:       t.Owner,
+			SchemaUsage: t.SchemaUsage,
+			Granted:     granted,
+		}
+	}
+	return resp
+}
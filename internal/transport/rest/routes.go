@@ -9,8 +9,13 @@ func (s *Server) RegisterRoutes() {
 	s.router.Route("/api", func(r chi.Router) {
 		r.Get("/health", s.healthCheck)
 
-		contract.HandlerFromMux(s, r)
+		contract.HandlerWithOptions(s, contract.ChiServerOptions{
+			BaseRouter:  r,
+			Middlewares: []contract.MiddlewareFunc{s.tagConnection, s.confirmConnection},
+		})
 	})
 
+	s.router.Handle("/metrics", s.metrics.Handler())
+
 	s.router.Get("/*", s.webHandler)
 }
@@ -4,28 +4,31 @@ package rest
 import (
 	"context"
 	"fmt"
-	"log"
+	"log/slog"
 	"net/http"
 	"time"
 
 	"github.com/felipemalacarne/mesa/internal/application"
+	"github.com/felipemalacarne/mesa/internal/infrastructure/telemetry"
 	"github.com/felipemalacarne/mesa/internal/transport/rest/contract"
 	"github.com/go-chi/chi/v5"
 )
 
 type Server struct {
-	app    application.App
-	router chi.Router
-	server *http.Server
+	app     application.App
+	metrics *telemetry.Metrics
+	router  chi.Router
+	server  *http.Server
 }
 
 // Ensure strict implementation of the interface
 var _ contract.ServerInterface = (*Server)(nil)
 
-func NewServer(app application.App) *Server {
+func NewServer(app application.App, metrics *telemetry.Metrics) *Server {
 	return &Server{
-		app:    app,
-		router: chi.NewRouter(),
+		app:     app,
+		metrics: metrics,
+		router:  chi.NewRouter(),
 	}
 }
 
@@ -45,6 +48,6 @@ func (s *Server) Start(addr string) error {
 }
 
 func (s *Server) Stop(ctx context.Context) error {
-	log.Println("Shutting down API server...")
+	slog.Info("shutting down API server")
 	return s.server.Shutdown(ctx)
 }
@@ -2,8 +2,11 @@ package rest
 
 import (
 	"encoding/json"
-	"log"
+	"errors"
+	"log/slog"
 	"net/http"
+
+	"github.com/felipemalacarne/mesa/internal/application/protection"
 )
 
 func (s *Server) respondJSON(w http.ResponseWriter, status int, data any) {
@@ -11,7 +14,7 @@ func (s *Server) respondJSON(w http.ResponseWriter, status int, data any) {
 	w.WriteHeader(status)
 	if data != nil {
 		if err := json.NewEncoder(w).Encode(data); err != nil {
-			log.Printf("respondJSON encode response: %v", err)
+			slog.Warn("respondJSON encode response", "error", err)
 		}
 	}
 }
@@ -22,6 +25,20 @@ func (s *Server) respondError(w http.ResponseWriter, status int, message string)
 	response := map[string]string{"message": message}
 
 	if err := json.NewEncoder(w).Encode(response); err != nil {
-		log.Printf("respondError encode response: %v", err)
+		slog.Warn("respondError encode response", "error", err)
+	}
+}
+
+// respondGuardError answers the errors of the connection protection guards, shared by every
+// command that changes a server, and reports whether err was one of them.
+func (s *Server) respondGuardError(w http.ResponseWriter, err error) bool {
+	switch {
+	case errors.Is(err, protection.ErrConfirmationRequired):
+		s.respondError(w, http.StatusPreconditionRequired, err.Error())
+	case errors.Is(err, protection.ErrReadOnlyConnection):
+		s.respondError(w, http.StatusForbidden, err.Error())
+	default:
+		return false
 	}
+	return true
 }